@@ -0,0 +1,71 @@
+// Package aoc has the generic stuff from the advent of code template.go (slice mappers, string joiners, number constraints, etc.).
+//
+// The rest of the template is split into these sub-packages:
+//
+//   - params: The CLI params (GetParams, Params), env vars, and running a solution (Main).
+//   - parse: Input parsing (ReadFile, SplitParseInts, ParseGridOfInts, etc.).
+//   - points: Points and directions (Point, XY, Direction, PathString, etc.).
+//   - grids: 2-d matrix stuff, including the node grid (MakeGrid, AsNodeGrid, CreateIndexedGridString, etc.).
+//   - logging: Output and timing (Stdoutf, Debugf, Verbosef, FuncStarting, FuncEnding, etc.).
+package aoc
+
+// Version is the version of this module. It should be updated whenever there's a change.
+// This module isn't published; it's only used through a replace directive, so this is just a record of the API.
+// Since each day's solution is built against whatever is in this directory, only add things; don't change or remove them.
+const Version = "v0.2.0"
+
+const (
+	MIN_INT8  = int8(-128)
+	MAX_INT8  = int8(127)
+	MIN_INT16 = int16(-32_768)
+	MAX_INT16 = int16(32_767)
+	MIN_INT32 = int32(-2_147_483_648)
+	MAX_INT32 = int32(2_147_483_647)
+	MIN_INT64 = int64(-9_223_372_036_854_775_808)
+	MAX_INT64 = int64(9_223_372_036_854_775_807)
+	MIN_INT   = -9_223_372_036_854_775_808
+	MAX_INT   = 9_223_372_036_854_775_807
+
+	MAX_UINT8  = uint8(255)
+	MAX_UINT16 = uint16(65_535)
+	MAX_UINT32 = uint32(4_294_967_295)
+	MAX_UINT64 = uint64(18_446_744_073_709_551_615)
+	MAX_UINT   = uint(18_446_744_073_709_551_615)
+
+	NilStr = "<nil>"
+)
+
+// Signed is a constraint of signed integer types. Same as golang.org/x/exp/constraints.Signed.
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// Unsigned is a constraint of unsigned integer types. Same as golang.org/x/exp/constraints.Unsigned.
+type Unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Integer is a constraint of integer types. Same as golang.org/x/exp/constraints.Integer.
+type Integer interface {
+	Signed | Unsigned
+}
+
+// Float is a constraint of float types. Same as golang.org/x/exp/constraints.Float.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Ordered is a constraint for types that can be compared using > etc. Same as golang.org/x/exp/constraints.Ordered.
+type Ordered interface {
+	Integer | Float | ~string
+}
+
+// Complex is a constraint of complex types. Same as golang.org/x/exp/constraints.Complex.
+type Complex interface {
+	~complex64 | ~complex128
+}
+
+// Number is a constraint of integers and floats.
+type Number interface {
+	Integer | Float
+}
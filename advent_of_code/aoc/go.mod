@@ -0,0 +1,3 @@
+module github.com/SpicyLemon/aoc
+
+go 1.23.0
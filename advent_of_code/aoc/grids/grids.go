@@ -0,0 +1,126 @@
+// Package grids has the 2-d matrix and node grid stuff from the advent of code template.go and node-grid.go.
+package grids
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/SpicyLemon/aoc"
+	"github.com/SpicyLemon/aoc/points"
+)
+
+// MakeZeroGrid creates a 2-d matrix with the given width and height, each entry having the zero value for the type.
+// Usage: grid := MakeZeroGrid[byte](10, 10)
+func MakeZeroGrid[V any](width, height int) [][]V {
+	rv := make([][]V, height)
+	for y := range rv {
+		rv[y] = make([]V, width)
+	}
+	return rv
+}
+
+// MakeGrid creates a new 2-d matrix with the given width and height, each entry having the provided value.
+func MakeGrid[V any](width, height int, value V) [][]V {
+	rv := make([][]V, height)
+	for y := range rv {
+		rv[y] = make([]V, width)
+		for x := range rv[y] {
+			rv[y][x] = value
+		}
+	}
+	return rv
+}
+
+// MapGrid creates a new map by running the provided mapper on each element of the provided grid.
+func MapGrid[G ~[][]E, E any, R any](grid G, mapper func(E) R) [][]R {
+	if grid == nil {
+		return nil
+	}
+	rv := make([][]R, len(grid))
+	for y := range grid {
+		rv[y] = make([]R, len(grid[y]))
+		for x := range rv[y] {
+			rv[y][x] = mapper(grid[y][x])
+		}
+	}
+	return rv
+}
+
+// GetAdjacent gets the elemnts adjacent to the provided point in the provided grid.
+func GetAdjacent[V any](grid [][]V, p points.XY) map[points.Direction]V {
+	rv := make(map[points.Direction]V)
+	for _, dir := range points.Dirs {
+		if v, ok := GetB(grid, points.AddXYs(p, points.DDirs[dir])); ok {
+			rv[dir] = v
+		}
+	}
+	return rv
+}
+
+// IsIn returns true if the provided point exists in the provided grid.
+func IsIn[E any](grid [][]E, p points.XY) bool {
+	if p == nil {
+		return false
+	}
+	x, y := p.GetXY()
+	return y >= 0 && y < len(grid) && x >= 0 && x < len(grid[y])
+}
+
+// Get will safely get the element of the grid at the provided point.
+// If the point is outside the grid, the zero-value is returned.
+func Get[E any](grid [][]E, p points.XY) E {
+	rv, _ := GetB(grid, p)
+	return rv
+}
+
+// GetB will safely get the element of the grid at the provided point and whether it is in the grid.
+// If the point is outside the grid, the zero-value and false is returned.
+func GetB[E any](grid [][]E, p points.XY) (E, bool) {
+	if IsIn(grid, p) {
+		return grid[p.GetY()][p.GetX()], true
+	}
+	var rv E
+	return rv, false
+}
+
+// -----------------------------------------------------------------------------
+// ---------------------------  By-Value Map Stuff  ----------------------------
+// -----------------------------------------------------------------------------
+
+// GroupByValue will create a map of value to nodes with that value.
+func GroupByValue[V comparable](vals [][]*Node[V]) map[V][]*Node[V] {
+	rv := make(map[V][]*Node[V])
+	for y := range vals {
+		for x := range vals[y] {
+			rv[vals[y][x].Value] = append(rv[vals[y][x].Value], vals[y][x])
+		}
+	}
+	return rv
+}
+
+// CreateByValueMapString creates a multi-line string, one line per key.
+func CreateByValueMapString[V cmp.Ordered](vals map[V][]*Node[V]) string {
+	keys := slices.Sorted(maps.Keys(vals))
+	keyStrs := aoc.ToEqualLengthStrings(keys)
+
+	lines := make([]string, len(keys))
+	for i, k := range keys {
+		lines[i] = fmt.Sprintf("[%s]: %s", keyStrs[i], points.PathString(vals[k]))
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// CreateEnhancedByValueMapString creates a multi-line string, one line per key and colors and highlights the points as provided.
+func CreateEnhancedByValueMapString[K cmp.Ordered, V ~[]W, W points.XY, S ~[]E, E points.XY](vals map[K]V, colorPoints, highlightPoints S) string {
+	keys := slices.Sorted(maps.Keys(vals))
+	keyStrs := aoc.ToEqualLengthStrings(keys)
+
+	lines := make([]string, len(keys))
+	for i, k := range keys {
+		lines[i] = fmt.Sprintf("[%s]: %s", keyStrs[i], points.EnhancedPathString(vals[k], colorPoints, highlightPoints))
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
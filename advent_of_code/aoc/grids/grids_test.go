@@ -0,0 +1,91 @@
+package grids
+
+import (
+	"testing"
+
+	"github.com/SpicyLemon/aoc/points"
+)
+
+func TestGet(t *testing.T) {
+	grid := [][]byte{[]byte("ab"), []byte("cd")}
+	tests := []struct {
+		p   *points.Point
+		exp byte
+		ok  bool
+	}{
+		{p: points.NewPoint(0, 0), exp: 'a', ok: true},
+		{p: points.NewPoint(1, 1), exp: 'd', ok: true},
+		{p: points.NewPoint(2, 0), exp: 0, ok: false},
+		{p: points.NewPoint(0, -1), exp: 0, ok: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.p.String(), func(t *testing.T) {
+			act, ok := GetB(grid, tc.p)
+			if act != tc.exp || ok != tc.ok {
+				t.Errorf("GetB(%s) = (%q, %t), expected (%q, %t)", tc.p, act, ok, tc.exp, tc.ok)
+			}
+		})
+	}
+}
+
+func TestAsNodeGrid(t *testing.T) {
+	grid := AsNodeGrid([][]int{{1, 2, 3}, {4, 5, 6}})
+	corner := grid[0][2]
+	if act, exp := corner.FullString(), "(2,0)=3:[ D L]"; act != exp {
+		t.Errorf("corner.FullString()\nExpected: %q\n  Actual: %q", exp, act)
+	}
+	if act := corner.GetDown().GetLeft().GetValue(); act != 5 {
+		t.Errorf("corner down then left = %d, expected 5", act)
+	}
+	if corner.CanGo(points.Up) {
+		t.Errorf("corner.CanGo(Up) = true, expected false")
+	}
+
+	center := grid[1][1]
+	center.Unlink()
+	if grid[0][1].CanGo(points.Down) {
+		t.Errorf("after Unlink, the node above can still go down")
+	}
+	if len(center.Next) != 0 {
+		t.Errorf("after Unlink, center still has %d next nodes", len(center.Next))
+	}
+
+	var nilNode *Node[int]
+	if act := nilNode.GetUp(); act != nil {
+		t.Errorf("nil.GetUp() = %s, expected nil", act)
+	}
+}
+
+func TestGroupByValue(t *testing.T) {
+	grid := AsNodeGrid([][]byte{[]byte("ab"), []byte("ba")})
+	act := CreateByValueMapString(GroupByValue(grid))
+	exp := "[a]: {2}[0:(0,0);1:(1,1)]\n[b]: {2}[0:(1,0);1:(0,1)]\n"
+	if act != exp {
+		t.Errorf("CreateByValueMapString\nExpected: %q\n  Actual: %q", exp, act)
+	}
+}
+
+func TestCreateIndexedGridString(t *testing.T) {
+	grid := [][]string{{"a", "b"}, {"c", "d"}}
+	act := CreateIndexedGridString(grid, []*points.Point{points.NewPoint(1, 0)}, []*points.Point{points.NewPoint(0, 1)})
+	exp := "  01\n  --\n0:a\033[94mb\033[0m\n1:\033[7mc\033[0md\n"
+	if act != exp {
+		t.Errorf("CreateIndexedGridString\nExpected: %q\n  Actual: %q", exp, act)
+	}
+
+	act = CreateIndexedGridStringNums([][]int{{1, 10}}, []*points.Point{}, nil)
+	exp = "    0  1\n  ------\n0:  1 10\n"
+	if act != exp {
+		t.Errorf("CreateIndexedGridStringNums\nExpected: %q\n  Actual: %q", exp, act)
+	}
+}
+
+func TestCreateIndexedGridStringStringer(t *testing.T) {
+	grid := [][]*points.Point{{points.NewPoint(0, 0)}}
+	act := CreateIndexedGridStringStringer(grid, []*points.Point{}, nil)
+	exp := "       0\n  ------\n0: (0,0)\n"
+	if act != exp {
+		t.Errorf("CreateIndexedGridStringStringer\nExpected: %q\n  Actual: %q", exp, act)
+	}
+}
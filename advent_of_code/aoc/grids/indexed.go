@@ -0,0 +1,138 @@
+package grids
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/SpicyLemon/aoc"
+	"github.com/SpicyLemon/aoc/points"
+)
+
+// CreateIndexedGridStringBz creates a string of the provided bytes matrix.
+// The result will have row and column indexes and the desired cells will be colored and/or highlighted.
+func CreateIndexedGridStringBz[M ~[][]B, B byte | rune, S ~[]E, E points.XY](vals M, colorPoints S, highlightPoints S) string {
+	return CreateIndexedGridStringFunc(vals, func(val B) string { return string(val) }, colorPoints, highlightPoints)
+}
+
+// CreateIndexedGridStringNums creates a string of the provided numbers matrix.
+// The result will have row and column indexes and the desired cells will be colored and/or highlighted.
+func CreateIndexedGridStringNums[M ~[][]N, N aoc.Integer, S ~[]E, E points.XY](vals M, colorPoints S, highlightPoints S) string {
+	return CreateIndexedGridStringFunc(vals, func(val N) string { return fmt.Sprintf("%d", val) }, colorPoints, highlightPoints)
+}
+
+// CreateIndexedGridStringStringer creates a string of the provided matrix, using each cell's String() value.
+// The result will have row and column indexes and the desired cells will be colored and/or highlighted.
+func CreateIndexedGridStringStringer[M ~[][]G, G fmt.Stringer, S ~[]E, E points.XY](vals M, colorPoints S, highlightPoints S) string {
+	return CreateIndexedGridStringFunc(vals, G.String, colorPoints, highlightPoints)
+}
+
+// CreateIndexedGridStringFunc creates a string of the provided matrix.
+// The converter should take in a cell's value and output the string to use for that cell.
+// The result will have row and column indexes and the desired cells will be colored and/or highlighted.
+func CreateIndexedGridStringFunc[M ~[][]G, G any, S ~[]E, E points.XY](vals M, converter func(G) string, colorPoints S, highlightPoints S) string {
+	return CreateIndexedGridString(MapGrid(vals, converter), colorPoints, highlightPoints)
+}
+
+// CreateIndexedGridString creates a string of the provided strings matrix.
+// The result will have row and column indexes and the desired cells will be colored and/or highlighted.
+func CreateIndexedGridString[S ~[]E, E points.XY](vals [][]string, colorPoints S, highlightPoints S) string {
+	// Get the height. If it's zero, there's nothing to return.
+	height := len(vals)
+	if height == 0 {
+		return ""
+	}
+
+	// Get the max cell length and the max row width.
+	cellLen := 0
+	width := len(vals[0])
+	for _, r := range vals {
+		if len(r) > width {
+			width = len(r)
+		}
+		for _, c := range r {
+			if l := utf8.RuneCountInString(c); l > cellLen {
+				cellLen = l
+			}
+		}
+	}
+	// Add an extra space if there's two or more characters per cell.
+	if cellLen > 1 {
+		cellLen++
+	}
+
+	// Define the format that each line will start with and for each cell.
+	leadFmt := fmt.Sprintf("%%%dd:", len(fmt.Sprintf("%d", height)))
+	blankLead := strings.Repeat(" ", len(fmt.Sprintf(leadFmt, 0)))
+	cellFmt := fmt.Sprintf("%%%ds", cellLen)
+
+	// If none of the rows have anything, just print out the row numbers.
+	if width == 0 {
+		lines := make([]string, len(vals))
+		for y := range vals {
+			lines[y] = fmt.Sprintf(leadFmt, y)
+		}
+		return strings.Join(lines, "\n")
+	}
+
+	// Create the index numbers across the top.
+	dCount := len(fmt.Sprintf("%d", width-1))
+	dLen := width * cellLen
+	digits := []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "0"}
+	topIndexLines := make([]string, dCount+1)
+	topIndexLines[dCount] = strings.Repeat("-", dLen)
+	rep := 1
+	for l := 1; l <= dCount; l++ {
+		first := " "
+		if l == 1 {
+			first = "0"
+		}
+		first = strings.Repeat(fmt.Sprintf(cellFmt, first), rep)
+
+		var sb strings.Builder
+		for _, s := range digits {
+			if len(first)+sb.Len() >= dLen {
+				break
+			}
+			sb.WriteString(strings.Repeat(fmt.Sprintf(cellFmt, s), rep))
+		}
+
+		rep *= 10
+		line := first + strings.Repeat(sb.String(), 1+width/rep)
+		topIndexLines[dCount-l] = line[:dLen]
+	}
+
+	// Create a matrix indicating desired text formats.
+	textFmt := MakeZeroGrid[int](width, height)
+	for _, p := range colorPoints {
+		if p.GetY() < height && p.GetX() < width {
+			textFmt[p.GetY()][p.GetX()] = 1
+		}
+	}
+	for _, p := range highlightPoints {
+		if p.GetY() < height && p.GetX() < width && textFmt[p.GetY()][p.GetX()] <= 1 {
+			textFmt[p.GetY()][p.GetX()] += 2
+		}
+	}
+
+	// Start with the top index lines shifted right a bit to account for row indexes in the lines to follow.
+	var rv strings.Builder
+	for _, l := range topIndexLines {
+		rv.WriteString(fmt.Sprintf("%s%s\n", blankLead, l))
+	}
+
+	// Add all the line numbers, and cells (with the desired coloring/marking).
+	for y, r := range vals {
+		rv.WriteString(fmt.Sprintf(leadFmt, y))
+		for x := 0; x < width; x++ {
+			v := ""
+			if x < len(r) {
+				v = r[x]
+			}
+			rv.WriteString(points.FormatCell(fmt.Sprintf(cellFmt, v), textFmt[y][x]))
+		}
+		rv.WriteByte('\n')
+	}
+
+	return rv.String()
+}
@@ -0,0 +1,140 @@
+package grids
+
+import (
+	"fmt"
+
+	"github.com/SpicyLemon/aoc"
+	"github.com/SpicyLemon/aoc/logging"
+	"github.com/SpicyLemon/aoc/points"
+)
+
+// Node[V] has an x,y position, value, and knows its neighbors in a 2d grid.
+type Node[V any] struct {
+	points.Point
+	Value V
+	Next  map[points.Direction]*Node[V]
+}
+
+// NewNode creates a new Node at the given point with the given value (and no neighbors).
+func NewNode[V any](x, y int, value V) *Node[V] {
+	return &Node[V]{Point: points.Point{X: x, Y: y}, Value: value, Next: make(map[points.Direction]*Node[V])}
+}
+
+// AsNodeGrid creates a grid of nodes with the provide values, all the nodes are linked up with their neighbors.
+func AsNodeGrid[V any](vals [][]V) [][]*Node[V] {
+	rv := make([][]*Node[V], len(vals))
+	for y := range vals {
+		rv[y] = make([]*Node[V], len(vals[y]))
+		for x := range vals[y] {
+			rv[y][x] = NewNode(x, y, vals[y][x])
+		}
+	}
+	LinkNodes(rv)
+	return rv
+}
+
+// LinkNodes creates all of the Next maps linking nodes to their neighbors.
+func LinkNodes[V any](grid [][]*Node[V]) {
+	for y := range grid {
+		for x := range grid[y] {
+			cur := grid[y][x]
+			if cur != nil {
+				cur.Next = GetAdjacent(grid, cur)
+			}
+		}
+	}
+}
+
+// String gets a string of this node.
+func (n *Node[V]) String() string {
+	if logging.Debug {
+		return n.FullString()
+	}
+	return n.ShortString()
+}
+
+// String gets a string of this node that contains the point and value.
+func (n *Node[V]) ShortString() string {
+	if n == nil {
+		return aoc.NilStr
+	}
+	return fmt.Sprintf("%s=%s", n.Point, aoc.GenericValueString(n.Value))
+}
+
+// FullString converts this node into a string with the format "(<x>,<y>)=<value>:[<neighbor flags>]".
+// If a node has all four neighbors, the <neighbor flags> will be "UDLR".
+// Any neighbor directions the node does NOT have are replaced with a space in that string.
+// E.g the node in the upper right corner of the grid only has neighbors to the right and down, so it's " D R".
+func (n *Node[V]) FullString() string {
+	if n == nil {
+		return aoc.NilStr
+	}
+	dirs := aoc.Ternary(n.Next[points.Up] != nil, "U", " ") +
+		aoc.Ternary(n.Next[points.Down] != nil, "D", " ") +
+		aoc.Ternary(n.Next[points.Right] != nil, "R", " ") +
+		aoc.Ternary(n.Next[points.Left] != nil, "L", " ")
+	return fmt.Sprintf("%s=%s:[%s]", n.Point, aoc.GenericValueString(n.Value), dirs)
+}
+
+// PointString returns the "(<x>,<y>)" for this node.
+func (n *Node[V]) PointString() string {
+	if n == nil {
+		return aoc.NilStr
+	}
+	return n.Point.String()
+}
+
+// GetValue is a nil-safe way to get this node's value.
+func (n *Node[V]) GetValue() V {
+	if n != nil {
+		return n.Value
+	}
+	var rv V
+	return rv
+}
+
+// GetUp is a nil-safe way to get the node up from this one.
+func (n *Node[V]) GetUp() *Node[V] {
+	return n.Go(points.Up)
+}
+
+// GetDown is a nil-safe way to get the node down from this one.
+func (n *Node[V]) GetDown() *Node[V] {
+	return n.Go(points.Down)
+}
+
+// GetLeft is a nil-safe way to get the node to the left of this one.
+func (n *Node[V]) GetLeft() *Node[V] {
+	return n.Go(points.Left)
+}
+
+// GetRight is a nil-safe way to get the node to the right of this one.
+func (n *Node[V]) GetRight() *Node[V] {
+	return n.Go(points.Right)
+}
+
+// Go gets the node in the requested direction from this one.
+func (n *Node[V]) Go(dir points.Direction) *Node[V] {
+	if n == nil {
+		return nil
+	}
+	return n.Next[dir]
+}
+
+// CanGo returns true if you can go the given direction from this node.
+func (n *Node[V]) CanGo(dir points.Direction) bool {
+	return n != nil && n.Next[dir] != nil
+}
+
+// Unlink will go through all next nodes and make them not point at this node, then remove all next entries in this node.
+func (n *Node[V]) Unlink() {
+	if n == nil {
+		return
+	}
+	for dir, node := range n.Next {
+		if node != nil {
+			delete(node.Next, points.DirOpposites[dir])
+		}
+	}
+	n.Next = make(map[points.Direction]*Node[V])
+}
@@ -0,0 +1,209 @@
+package aoc
+
+import (
+	"fmt"
+	"strings"
+)
+
+// StringJoin maps the slice to strings and joins them.
+func StringJoin[S ~[]E, E fmt.Stringer](slice S, sep string) string {
+	return strings.Join(MapSlice(slice, E.String), sep)
+}
+
+// StringNumberJoin maps the slice to strings, numbers them, and joins them.
+func StringNumberJoin[S ~[]E, E fmt.Stringer](slice S, startAt int, sep string) string {
+	return strings.Join(AddLineNumbers(MapSlice(slice, E.String), startAt), sep)
+}
+
+// StringNumberJoinFunc maps the slice to strings using the provided stringer, numbers them, and joins them.
+func StringNumberJoinFunc[S ~[]E, E any](slice S, stringer func(E) string, startAt int, sep string) string {
+	return strings.Join(AddLineNumbers(MapSlice(slice, stringer), startAt), sep)
+}
+
+// SliceToStrings runs String() on each entry of the provided slice.
+func SliceToStrings[S ~[]E, E fmt.Stringer](slice S) []string {
+	return MapSlice(slice, E.String)
+}
+
+// AddLineNumbers adds line numbers to each string.
+func AddLineNumbers(lines []string, startAt int) []string {
+	if len(lines) == 0 {
+		return []string{}
+	}
+	lineFmt := DigitFormatForMax(len(lines)-1+startAt) + ": %s"
+	rv := make([]string, len(lines))
+	for i, line := range lines {
+		rv[i] = fmt.Sprintf(lineFmt, i+startAt, line)
+	}
+	return rv
+}
+
+// DigitFormatForMax returns a format string of the length of the provided maximum number.
+// E.g. DigitFormatForMax(10) returns "%2d".
+// DigitFormatForMax(382920) returns "%6d".
+func DigitFormatForMax(maximum int) string {
+	return fmt.Sprintf("%%%dd", len(fmt.Sprintf("%d", maximum)))
+}
+
+// PrefixLines splits each provided string on \n then adds a prefix to each line, then puts it all back together.
+func PrefixLines(pre string, strs ...string) string {
+	var rv strings.Builder
+	lastI := len(strs) - 1
+	for i, str := range strs {
+		lines := strings.Split(str, "\n")
+		lastJ := len(lines) - 1
+		for j, line := range lines {
+			rv.WriteString(pre)
+			rv.WriteString(line)
+			if i != lastI || j != lastJ {
+				rv.WriteByte('\n')
+			}
+		}
+	}
+	return rv.String()
+}
+
+// MapSlice returns a new slice with each element run through the provided mapper function.
+// Use MapSlice if the slice and mapper are either both concrete or both pointers.
+// Use MapPSlice if the slice is pointers, but the mapper takes in a concrete E.
+// Use MapSliceP if the slice is concrete, but the mapper takes in a pointer to E.
+func MapSlice[S ~[]E, E any, R any](slice S, mapper func(E) R) []R {
+	if slice == nil {
+		return nil
+	}
+	rv := make([]R, len(slice))
+	for i, e := range slice {
+		rv[i] = mapper(e)
+	}
+	return rv
+}
+
+// MapPSlice returns a new slice with each element run through the provided mapper function.
+// Use MapSlice if the slice and mapper are either both concrete or both pointers.
+// Use MapPSlice if the slice is pointers, but the mapper takes in a concrete E.
+// Use MapSliceP if the slice is concrete, but the mapper takes in a pointer to E.
+func MapPSlice[S ~[]*E, E any, R any](slice S, mapper func(E) R) []R {
+	if slice == nil {
+		return nil
+	}
+	rv := make([]R, len(slice))
+	for i, e := range slice {
+		rv[i] = mapper(*e)
+	}
+	return rv
+}
+
+// MapSliceP returns a new slice with each element run through the provided mapper function.
+// Use MapSlice if the slice and mapper are either both concrete or both pointers.
+// Use MapPSlice if the slice is pointers, but the mapper takes in a concrete E.
+// Use MapSliceP if the slice is concrete, but the mapper takes in a pointer to E.
+func MapSliceP[S ~[]E, E any, R any](slice S, mapper func(*E) R) []R {
+	if slice == nil {
+		return nil
+	}
+	rv := make([]R, len(slice))
+	for i, e := range slice {
+		rv[i] = mapper(&e)
+	}
+	return rv
+}
+
+// Abs returns the absolute value of the provided number.
+func Abs[V Number](v V) V {
+	var zero V
+	if v < zero {
+		return zero - v
+	}
+	return v
+}
+
+// Ternary returns ifTrue if test == true, otherwise, returns ifFalse.
+func Ternary[E any](test bool, ifTrue, ifFalse E) E {
+	if test {
+		return ifTrue
+	}
+	return ifFalse
+}
+
+// CopyAppend returns a copy of s with the other provided entries appended.
+func CopyAppend[S ~[]E, E any](s S, es ...E) S {
+	rv := make(S, len(s)+len(es))
+	copy(rv, s)
+	copy(rv[len(s):], es)
+	return rv
+}
+
+// Alternates: ©®¬ÆæØøÞþ
+
+// ConversionRunes are some chars used to represent numbers for smaller output. See also: GetRune.
+var ConversionRunes = []rune("0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz~-_+=|¦:;!@#$¢£¥%^&*()[]{}<>«»/?¿÷°§¶¤")
+
+// GetRune returns the rune used to represent the provided number for smaller output.
+// The runes will repeat every 100. E.g. GetRune(3) returns the same as GetRune(103).
+func GetRune(i int) rune {
+	return ConversionRunes[i%len(ConversionRunes)]
+}
+
+// ToEqualLengthStrings converts each val to a string using GenericValueString(val) and pads them to the same length.
+// Numbers are left-padded, everything else is right-padded.
+// The longest ones won't have any padding.
+func ToEqualLengthStrings[E any](vals []E) []string {
+	if vals == nil {
+		return nil
+	}
+	if len(vals) == 0 {
+		return []string{}
+	}
+	rv := make([]string, len(vals))
+	maxLen := 0
+	for i, val := range vals {
+		rv[i] = GenericValueString(val)
+		if len(rv[i]) > maxLen {
+			maxLen = len(rv[i])
+		}
+	}
+	padder := PadRight
+	switch any(vals[0]).(type) {
+	// Both byte and uint8 will match either of those. Same with rune and int32.
+	// There's no easy way to identify when its one or the other, though.
+	// And since I use byte and rune way more than uint8 or int32, we'll still pad the right side for those.
+	case int, int8, int16, int32, int64, uint, uint16, uint64:
+		padder = PadLeft
+	}
+	for i, str := range rv {
+		if len(str) < maxLen {
+			rv[i] = padder(rv[i], maxLen)
+		}
+	}
+	return rv
+}
+
+// PadLeft will return a string with spaces added to the left of the provided one up to the provided length.
+func PadLeft(str string, length int) string {
+	if len(str) >= length {
+		return str
+	}
+	return strings.Repeat(" ", length-len(str)) + str
+}
+
+// PadRight will return a string with spaces added to the right of the provided one up to the provided length.
+func PadRight(str string, length int) string {
+	if len(str) >= length {
+		return str
+	}
+	return str + strings.Repeat(" ", length-len(str))
+}
+
+// GenericValueString returns a string representation of the provided value that's a little better than just fmt.Sprintf("%v", value).
+// Specifically, byte and rune types are converted to their character instead of just their number value.
+func GenericValueString[T any](value T) string {
+	switch v := any(value).(type) {
+	case string:
+		return v
+	case byte, rune:
+		return fmt.Sprintf("%c", v)
+	case fmt.Stringer:
+		return v.String()
+	}
+	return fmt.Sprintf("%v", value)
+}
@@ -0,0 +1,79 @@
+package aoc
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAddLineNumbers(t *testing.T) {
+	tests := []struct {
+		name    string
+		lines   []string
+		startAt int
+		exp     []string
+	}{
+		{name: "nil", lines: nil, startAt: 0, exp: []string{}},
+		{name: "one line", lines: []string{"a"}, startAt: 0, exp: []string{"0: a"}},
+		{name: "pads to widest", lines: []string{"a", "b", "c"}, startAt: 8, exp: []string{" 8: a", " 9: b", "10: c"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			act := AddLineNumbers(tc.lines, tc.startAt)
+			if !reflect.DeepEqual(tc.exp, act) {
+				t.Errorf("AddLineNumbers(%q, %d)\nExpected: %q\n  Actual: %q", tc.lines, tc.startAt, tc.exp, act)
+			}
+		})
+	}
+}
+
+func TestPrefixLines(t *testing.T) {
+	act := PrefixLines("> ", "a\nb", "c")
+	exp := "> a\n> b\n> c"
+	if act != exp {
+		t.Errorf("PrefixLines\nExpected: %q\n  Actual: %q", exp, act)
+	}
+}
+
+func TestCopyAppend(t *testing.T) {
+	orig := make([]int, 2, 10)
+	orig[0], orig[1] = 1, 2
+	act := CopyAppend(orig, 3, 4)
+	act[0] = 9
+	if exp := []int{9, 2, 3, 4}; !reflect.DeepEqual(exp, act) {
+		t.Errorf("CopyAppend result\nExpected: %v\n  Actual: %v", exp, act)
+	}
+	if exp := []int{1, 2}; !reflect.DeepEqual(exp, orig) {
+		t.Errorf("CopyAppend original\nExpected: %v\n  Actual: %v", exp, orig)
+	}
+}
+
+func TestAbs(t *testing.T) {
+	if act := Abs(-3); act != 3 {
+		t.Errorf("Abs(-3) = %d, expected 3", act)
+	}
+	if act := Abs(2.5); act != 2.5 {
+		t.Errorf("Abs(2.5) = %f, expected 2.5", act)
+	}
+}
+
+func TestToEqualLengthStrings(t *testing.T) {
+	tests := []struct {
+		name string
+		act  []string
+		exp  []string
+	}{
+		{name: "ints are left padded", act: ToEqualLengthStrings([]int{1, 22, 333}), exp: []string{"  1", " 22", "333"}},
+		{name: "strings are right padded", act: ToEqualLengthStrings([]string{"a", "bb", "ccc"}), exp: []string{"a  ", "bb ", "ccc"}},
+		{name: "bytes are chars", act: ToEqualLengthStrings([]byte{'x', 'y'}), exp: []string{"x", "y"}},
+		{name: "nil", act: ToEqualLengthStrings[int](nil), exp: nil},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tc.exp, tc.act) {
+				t.Errorf("ToEqualLengthStrings\nExpected: %q\n  Actual: %q", tc.exp, tc.act)
+			}
+		})
+	}
+}
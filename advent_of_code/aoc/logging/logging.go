@@ -0,0 +1,291 @@
+// Package logging has the output and function timing stuff from the advent of code template.go.
+//
+// Don't use the fmt.Print stuff. Use Stdoutf(...) or Stderrf(...) so you also get func and timing info.
+//
+//	Debugf(...) prints stuff if running with debug (see Debug).
+//	Verbosef(...) prints stuff if running verbosely (or with debug) (see Verbose).
+//	defer FuncEnding(FuncStarting())               outputs func start/stop messages ONLY when in debug mode.
+//	defer FuncEndingAlways(FuncStartingAlways())   outputs func start/stop messages always (even when not in debug mode).
+//
+// If any args to Debugf or Verbosef are function calls/results, wrap it in an if Debug or if Verbose block.
+// That way, it's only making the function calls (which are often expensive) in debug or verbose mode.
+package logging
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+)
+
+var (
+	// Debug is a flag for whether or not debug messages should be displayed.
+	Debug bool
+	// Verbose is a flag for whether or not verbose messages should be displayed.
+	Verbose bool
+)
+
+// startTime is the time when the program started.
+var startTime = time.Now()
+
+// funcDepth is a global counter keeping track of function depth by the starting/ending function functions.
+var funcDepth = -1
+
+// ResetStartTime sets the time that all the output durations are relative to, to now.
+// The start time is initially when this package is loaded, so this usually isn't needed.
+func ResetStartTime() {
+	startTime = time.Now()
+}
+
+// -------------------------------------------------------------------------------------------------
+// ------------------------------  Function start/stop timing stuff  -------------------------------
+// -------------------------------------------------------------------------------------------------
+
+// If all you want is starting/ending messages when debug is on, use:
+//    defer FuncEnding(FuncStarting())
+// If, when debug is on, you want starting/ending messages,
+// but when debug is off, you still want the function duration, then use:
+//    defer FuncEndingAlways(FuncStarting())
+
+// FuncStarting outputs that a function is starting (if Debug is true).
+// It returns the params needed by FuncEnding or FuncEndingAlways.
+//
+// Arguments provided will be converted to stings using %v and included as part of the function name.
+// Only provide minimal values needed to differentiate start/stop output lines.
+// Long strings and complex structs should be avoided as args.
+//
+// Example 1: In a function named "foo", you have this:
+//
+//	  FuncStarting()
+//	The printed message will note that "foo" is starting.
+//	That same string will also be returned as the 2nd return paremeter.
+//
+// Example 2: In a function named "bar", you have this:
+//
+//	  FuncStarting(3 * time.Second)
+//	The printed message will note that "bar: 3s" is starting.
+//	That same string will also be returned as the 2nd return paremeter.
+//
+// Example 3:
+//
+//	  func sum(ints ...int) {
+//	      FuncStarting(ints...)
+//	  }
+//	  sum(1, 2, 3, 4, 20, 21, 22)
+//	The printed message will note that "sum: 1, 2, 3, 4, 20, 21, 22" is starting.
+//	That same string will also be returned as the 2nd return paremeter.
+//
+// Standard Usage: defer FuncEnding(FuncStarting())
+//
+//	Or: defer FuncEndingAlways(FuncStarting())
+func FuncStarting(a ...interface{}) (time.Time, string) {
+	funcDepth++
+	name := GetFuncName(1, a...)
+	DebugAsf(name, "Starting.")
+	return time.Now(), name
+}
+
+// FuncStartingAlways is the same as FuncStarting except if Debug is off, output will go to stdout.
+//
+// This differs from FuncStarting in that this will always do the output (regardless of debug state).
+//
+// Usage: defer FuncEndingAlways(FuncStartingAlways())
+func FuncStartingAlways(a ...interface{}) (time.Time, string) {
+	funcDepth++
+	name := GetFuncName(1, a...)
+	DebugAlwaysAsf(name, "Starting.")
+	return time.Now(), name
+}
+
+const DONE_FMT = "Done. Duration: [%s]."
+
+var panicPrinted bool
+
+// FuncEnding decrements the function depth and, if Debug is on, outputs to stderr how long a function took.
+// Args will usually come from FuncStarting().
+//
+// This differs from FuncEndingAlways in that this only outputs something if debugging is turned on.
+//
+// Usage: defer FuncEnding(FuncStarting())
+func FuncEnding(start time.Time, name string) {
+	if !panicPrinted {
+		if r := recover(); r != nil {
+			DebugAlwaysAsf(name, "PANIC")
+			panicPrinted = true
+			defer func() {
+				panic(r)
+			}()
+		}
+	}
+	if !panicPrinted {
+		DebugAsf(name, DONE_FMT, time.Since(start))
+	}
+	if funcDepth > -1 {
+		funcDepth--
+	}
+}
+
+// FuncEndingAlways is the same as FuncEnding except if Debug is off, output will go to stdout.
+//
+// This differs from FuncEnding in that this will always do the output (regardless of debug state).
+//
+// Usage: defer FuncEndingAlways(FuncStarting())
+func FuncEndingAlways(start time.Time, name string) {
+	if !panicPrinted {
+		if r := recover(); r != nil {
+			DebugAlwaysAsf(name, "PANIC")
+			panicPrinted = true
+			defer func() {
+				panic(r)
+			}()
+		}
+	}
+	if !panicPrinted {
+		DebugAlwaysAsf(name, DONE_FMT, time.Since(start))
+	}
+	if funcDepth > -1 {
+		funcDepth--
+	}
+}
+
+// DurClock converts a duration to a string in minimal clock notation with nanosecond precision.
+//
+// - If one or more hours, format is "H:MM:SS.NNNNNNNNNs", e.g. "12:01:02.000000000".
+// - If less than one hour, format is "M:SS.NNNNNNNNNs",   e.g. "34:00.000000789".
+// - If less than one minute, format is "S.NNNNNNNNNs",    e.g. "56.000456000".
+// - If less than one second, format is "0.NNNNNNNNNs",    e.g. "0.123000000".
+func DurClock(d time.Duration) string {
+	h := int(d.Hours())
+	m := int(d.Minutes())
+	s := int(d.Seconds())
+	n := int(d.Nanoseconds()) - 1000000000*s
+	s -= 60 * m
+	m -= 60 * h
+	switch {
+	case h > 0:
+		return fmt.Sprintf("%d:%02d:%02d.%09d", h, m, s, n)
+	case m > 0:
+		return fmt.Sprintf("%d:%02d.%09d", m, s, n)
+	default:
+		return fmt.Sprintf("%d.%09d", s, n)
+	}
+}
+
+// GetFuncName gets the name of the function at the given depth.
+//
+// Depth 0 = the function calling GetFuncName.
+// Depth 1 = the function calling the function calling GetFuncName.
+// Etc.
+//
+// Functions in the main package don't have a package in the name, e.g. "Solve".
+// Functions in other packages only have the last part of their package, e.g. "params.Run".
+//
+// Extra arguments provided will be converted to stings using %v and included as part of the function name.
+// Only values needed to differentiate start/stop output lines should be provided.
+// Long strings and complex structs should be avoided.
+func GetFuncName(depth int, a ...interface{}) string {
+	pc := make([]uintptr, 10)
+	n := runtime.Callers(2, pc)
+	frames := runtime.CallersFrames(pc[:n])
+	frame, more := frames.Next()
+	for more && depth > 0 {
+		frame, more = frames.Next()
+		depth--
+	}
+	name := frame.Function
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	name = strings.TrimPrefix(name, "main.")
+	// Using a switch to prevent calling strings.Join for small (common) use cases. Saves a little mem and processing.
+	switch len(a) {
+	case 0:
+		// do nothing
+	case 1:
+		name += fmt.Sprintf(": %v", a[0])
+	case 2:
+		name += fmt.Sprintf(": %v, %v", a[0], a[1])
+	case 3:
+		name += fmt.Sprintf(": %v, %v, %v", a[0], a[1], a[2])
+	default:
+		args := make([]string, len(a))
+		for i, arg := range a {
+			args[i] = fmt.Sprintf("%v", arg)
+		}
+		name += fmt.Sprintf(": %s", strings.Join(args, ", "))
+	}
+	return name
+}
+
+// -------------------------------------------------------------------------------------------------
+// ---------------------------------------  Output wrappers  ---------------------------------------
+// -------------------------------------------------------------------------------------------------
+
+// GetOutputPrefix gets the prefix to add to all output.
+func GetOutputPrefix(funcName string) string {
+	tabs := ""
+	if Debug && funcDepth > 0 {
+		tabs = strings.Repeat("  ", funcDepth)
+	}
+	return fmt.Sprintf("(%14s) %s[%s] ", DurClock(time.Since(startTime)), tabs, funcName)
+}
+
+// Stdoutf outputs to stdout with a prefixed run duration and automatic function name.
+func Stdoutf(format string, a ...interface{}) {
+	fmt.Printf(GetOutputPrefix(GetFuncName(1))+format+"\n", a...)
+}
+
+// Stderrf outputs to stderr with a prefixed run duration and automatic function name.
+func Stderrf(format string, a ...interface{}) {
+	fmt.Fprintf(os.Stderr, GetOutputPrefix(GetFuncName(1))+format+"\n", a...)
+}
+
+// StdoutAsf outputs to stdout with a prefixed run duration and provided function name.
+func StdoutAsf(funcName, format string, a ...interface{}) {
+	fmt.Printf(GetOutputPrefix(funcName)+format+"\n", a...)
+}
+
+// StderrAsf outputs to stderr with a prefixed run duration and provided function name.
+func StderrAsf(funcName, format string, a ...interface{}) {
+	fmt.Fprintf(os.Stderr, GetOutputPrefix(funcName)+format+"\n", a...)
+}
+
+// Debugf is like Stderrf if the Debug flag is set; otherwise it does nothing.
+func Debugf(format string, a ...interface{}) {
+	if Debug {
+		StderrAsf(GetFuncName(1), format, a...)
+	}
+}
+
+// DebugAsf is like StderrAsf if the Debug flag is set; otherwise it does nothing.
+func DebugAsf(funcName, format string, a ...interface{}) {
+	if Debug {
+		StderrAsf(funcName, format, a...)
+	}
+}
+
+// DebugAlwaysf is like Stderrf if the Debug (or Verbose) flag is set; otherwise it's like Stdoutf.
+func DebugAlwaysf(format string, a ...interface{}) {
+	if Debug || Verbose {
+		StderrAsf(GetFuncName(1), format, a...)
+	} else {
+		StdoutAsf(GetFuncName(1), format, a...)
+	}
+}
+
+// DebugAlwaysAsf is like StderrAsf if the Debug (or Verbose) flag is set; otherwise it's like StdoutAsf.
+func DebugAlwaysAsf(funcName, format string, a ...interface{}) {
+	if Debug || Verbose {
+		StderrAsf(funcName, format, a...)
+	} else {
+		StdoutAsf(funcName, format, a...)
+	}
+}
+
+// Verbosef outputs to Stderr if the Verbose flag was provided. Does nothing otherwise.
+func Verbosef(format string, a ...interface{}) {
+	if Verbose {
+		StderrAsf(GetFuncName(1), format, a...)
+	}
+}
@@ -0,0 +1,35 @@
+package logging
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDurClock(t *testing.T) {
+	tests := []struct {
+		d   time.Duration
+		exp string
+	}{
+		{d: 123 * time.Millisecond, exp: "0.123000000"},
+		{d: 56*time.Second + 456*time.Microsecond, exp: "56.000456000"},
+		{d: 34*time.Minute + 789, exp: "34:00.000000789"},
+		{d: 12*time.Hour + time.Minute + 2*time.Second, exp: "12:01:02.000000000"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.exp, func(t *testing.T) {
+			if act := DurClock(tc.d); act != tc.exp {
+				t.Errorf("DurClock(%s) = %q, expected %q", tc.d, act, tc.exp)
+			}
+		})
+	}
+}
+
+func TestGetFuncName(t *testing.T) {
+	if act, exp := GetFuncName(0), "logging.TestGetFuncName"; act != exp {
+		t.Errorf("GetFuncName(0) = %q, expected %q", act, exp)
+	}
+	if act, exp := GetFuncName(0, 1, "two"), "logging.TestGetFuncName: 1, two"; act != exp {
+		t.Errorf("GetFuncName(0, 1, \"two\") = %q, expected %q", act, exp)
+	}
+}
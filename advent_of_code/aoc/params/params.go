@@ -0,0 +1,393 @@
+// Package params has the CLI params, environment variable, and program running stuff from the advent of code template.go.
+//
+// A day's main function should usually just be:
+//
+//	func main() {
+//		params.Main(Solve)
+//	}
+package params
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/SpicyLemon/aoc"
+	"github.com/SpicyLemon/aoc/logging"
+	"github.com/SpicyLemon/aoc/parse"
+)
+
+// DefaultCount is the Count value used when one isn't provided. Set it in an init() func to change it.
+var DefaultCount = 0
+
+// SolveFunc is the function that finds the solution. The string it returns should be (or include) the answer.
+type SolveFunc func(params *Params) (string, error)
+
+// Params contains anything that might be provided via command-line arguments.
+type Params struct {
+	// HelpPrinted is whether or not the help message was printed.
+	HelpPrinted bool
+	// Errors is a list of errors encountered while parsing the arguments.
+	Errors []error
+	// Count is just a generic int that can be provided.
+	Count int
+	// Option is another generic int that can be provided.
+	Option int
+	// InputFile is the file that contains the puzzle data to solve.
+	InputFile string
+	// Input is the contents of the input file split on newlines.
+	Input []string
+	// Custom is a set of custom strings to provide as input.
+	Custom []string
+}
+
+// String creates a multi-line string representing this Params.
+func (p Params) String() string {
+	nameFmt := "%10s: "
+	lines := []string{
+		fmt.Sprintf(nameFmt+"%t", "Debug", logging.Debug),
+		fmt.Sprintf(nameFmt+"%t", "Verbose", logging.Verbose),
+		fmt.Sprintf(nameFmt+"%d", "Errors", len(p.Errors)),
+		fmt.Sprintf(nameFmt+"%d", "Count", p.Count),
+		fmt.Sprintf(nameFmt+"%d", "Option", p.Option),
+		fmt.Sprintf(nameFmt+"%s", "Input File", p.InputFile),
+		fmt.Sprintf(nameFmt+"%d lines", "Input", len(p.Input)),
+		fmt.Sprintf(nameFmt+"%d lines", "Custom", len(p.Custom)),
+	}
+	if len(p.Errors) > 0 {
+		lines = append(lines, fmt.Sprintf("Errors (%d):", len(p.Errors)))
+		errors := make([]string, len(p.Errors))
+		for i, err := range p.Errors {
+			errors[i] = err.Error()
+		}
+		lines = append(lines, aoc.AddLineNumbers(errors, 1)...)
+	}
+	if len(p.Input) > 0 {
+		lines = append(lines, fmt.Sprintf("Input (%d):", len(p.Input)))
+		lines = append(lines, aoc.AddLineNumbers(p.Input, 0)...)
+	}
+	if len(p.Custom) > 0 {
+		lines = append(lines, fmt.Sprintf("Custom Input (%d):", len(p.Custom)))
+		lines = append(lines, aoc.AddLineNumbers(p.Custom, 0)...)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// DEFAULT_INPUT_FILE is the default input filename.
+const DEFAULT_INPUT_FILE = "example.input"
+
+// GetParams parses the provided args into the command's params.
+func GetParams(args []string) *Params {
+	defer logging.FuncEnding(logging.FuncStarting())
+	var err error
+	rv := Params{}
+	countGiven := false
+	verboseGiven := false
+	for i := 0; i < len(args); i++ {
+		switch {
+		// Flag cases go first.
+		case IsOneOfStrFold(args[i], "--help", "-h", "help"):
+			logging.Debugf("Help flag found: [%s].", args[i])
+			lines := []string{
+				fmt.Sprintf("Usage: %s [<input file>] [<flags>]", GetMyExe()),
+				fmt.Sprintf("Default <input file> is %s", DEFAULT_INPUT_FILE),
+				"Flags:",
+				"  --debug       Turns on debugging.",
+				"  --verbose|-v  Turns on verbose output.",
+				"",
+				"Single Options:",
+				"  Providing these multiple times will overwrite the previously provided value.",
+				"  --input|-i <input file>  An option to define the input file.",
+				"  --count|-n <number>      Defines a count.",
+				"  --option|-o <number>     Defines an option value.",
+				"",
+				"Repeatable Options:",
+				"  Providing these multiple times will add to previously provided values.",
+				"  Values are read until the next one starts with a dash.",
+				"  To provide entries that start with a dash, you can use --flag='<value>' syntax.",
+				"  --lines|-l <value 1> [<value 2> ...]  Defines custom input lines.",
+				"",
+			}
+			// Not using Stdoutf() here because the extra formatting is annoying with help text.
+			fmt.Println(strings.Join(lines, "\n"))
+			rv.HelpPrinted = true
+		case HasOneOfPrefixesFold(args[i], "--debug", "-vv"):
+			logging.Debugf("Debug option found: [%s], args left: %q.", args[i], args[i:])
+			var extraI int
+			oldDebug := logging.Debug
+			logging.Debug, extraI, err = ParseFlagBool(args[i:])
+			i += extraI
+			rv.AppendError(err)
+			if err == nil {
+				switch {
+				case !oldDebug && logging.Debug:
+					logging.Stderrf("Debugging enabled by CLI arguments.")
+				case oldDebug && !logging.Debug:
+					logging.Stderrf("Debugging disabled by CLI arguments.")
+				}
+			}
+		case HasOneOfPrefixesFold(args[i], "--verbose", "-v"):
+			logging.Debugf("Verbose option found: [%s], args after: %q.", args[i], args[i:])
+			var extraI int
+			logging.Verbose, extraI, err = ParseFlagBool(args[i:])
+			i += extraI
+			rv.AppendError(err)
+			verboseGiven = true
+		case HasOneOfPrefixesFold(args[i], "--input", "--input-file"):
+			logging.Debugf("Input file option found: [%s], args after: %q.", args[i], args[i:])
+			var extraI int
+			rv.InputFile, extraI, err = ParseFlagString(args[i:])
+			i += extraI
+			rv.AppendError(err)
+		case HasOneOfPrefixesFold(args[i], "--count", "-c", "-n"):
+			logging.Debugf("Count option found: [%s], args after: %q.", args[i], args[i:])
+			var extraI int
+			rv.Count, extraI, err = ParseFlagInt(args[i:])
+			i += extraI
+			rv.AppendError(err)
+			countGiven = true
+		case HasOneOfPrefixesFold(args[i], "--option", "--opt", "-o"):
+			logging.Debugf("Option option found: [%s], args after: %q.", args[i], args[i:])
+			var extraI int
+			rv.Option, extraI, err = ParseFlagInt(args[i:])
+			i += extraI
+			rv.AppendError(err)
+		case HasOneOfPrefixesFold(args[i], "--line", "--lines", "-l", "--custom", "--val"):
+			logging.Debugf("Custom option found: [%s], args after: %q.", args[i], args[i:])
+			var extraI int
+			var vals []string
+			vals, extraI, err = ParseRepeatedFlagString(args[i:])
+			rv.Custom = append(rv.Custom, vals...)
+			i += extraI
+			rv.AppendError(err)
+
+		// Positional args go last in the order they're expected.
+		case len(rv.InputFile) == 0 && len(args[i]) > 0 && args[i][0] != '-':
+			logging.Debugf("Input File argument: [%s], args after: %q", args[i], args[i:])
+			rv.InputFile = args[i]
+		default:
+			logging.Debugf("Unknown argument found: [%s], args after: %q.", args[i], args[i:])
+			rv.AppendError(fmt.Errorf("unknown argument %d: [%s]", i+1, args[i]))
+		}
+	}
+	if len(rv.InputFile) == 0 {
+		rv.InputFile = DEFAULT_INPUT_FILE
+	}
+	if !verboseGiven {
+		logging.Verbose = logging.Debug
+	}
+	if !countGiven {
+		rv.Count = DefaultCount
+	}
+	return &rv
+}
+
+// AppendError adds an error to this Params as long as the error is not nil.
+func (p *Params) AppendError(err error) {
+	if err != nil {
+		p.Errors = append(p.Errors, err)
+	}
+}
+
+// HasError returns true if this Params has one or more errors.
+func (p Params) HasError() bool {
+	return len(p.Errors) != 0
+}
+
+// GetError flattens the Errors slice into a single error.
+func (p Params) GetError() error {
+	switch len(p.Errors) {
+	case 0:
+		return nil
+	case 1:
+		return p.Errors[0]
+	default:
+		errs := make([]error, 1, 1+len(p.Errors))
+		errs[0] = fmt.Errorf("Found %d errors:", len(p.Errors)) //nolint:stylecheck,revive // punct okay here.
+		for i, err := range p.Errors {
+			errs = append(errs, fmt.Errorf("  %d: %w", i+1, err))
+		}
+		return errors.Join(errs...)
+	}
+}
+
+// HasCustom returns true if the provided string was given as a custom arg.
+func (p Params) HasCustom(str string) bool {
+	for _, cust := range p.Custom {
+		if cust == str {
+			return true
+		}
+	}
+	return false
+}
+
+// IsOneOfStrFold tests if the given string is equal (ignoring case) to one of the given options.
+func IsOneOfStrFold(str string, opts ...string) bool {
+	for _, opt := range opts {
+		if strings.EqualFold(str, opt) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasPrefixFold tests if the given string starts with the given prefix (ignoring case).
+func HasPrefixFold(str, prefix string) bool {
+	return len(str) >= len(prefix) && strings.EqualFold(str[0:len(prefix)], prefix)
+}
+
+// HasOneOfPrefixesFold tests if the given string has one of the given prefixes.
+func HasOneOfPrefixesFold(str string, prefixes ...string) bool {
+	for _, pre := range prefixes {
+		if HasPrefixFold(str, pre) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseFlagString parses a string flag from arguments.
+//
+// The flag in question should be in args[0].
+// If args[0] contains "=" or " " then the desired value will be extracted from that string and returned.
+// Otherwise, if args[1] exists, that is returned.
+// Otherwise, an error is given.
+//
+// The first return value is the flag's string value.
+// The second return value is the number of extra arguments used.
+// The third return value is any error encountered.
+func ParseFlagString(args []string) (string, int, error) {
+	if strings.ContainsAny(args[0], "= ") {
+		parts := strings.SplitN(args[0], "=", 2)
+		if len(parts) == 1 {
+			parts = strings.SplitN(args[0], " ", 2)
+		}
+		if len(parts) == 2 {
+			if len(parts[1]) > 1 {
+				for _, c := range []string{`'`, `"`} {
+					if parts[1][:1] == c && parts[1][len(parts[1])-1:] == c {
+						return parts[1][1 : len(parts[1])-1], 0, nil
+					}
+				}
+			}
+			return parts[1], 0, nil
+		}
+		return "", 0, fmt.Errorf("unable to split flag and value from string: [%s]", args[0])
+	}
+	if len(args) > 1 {
+		return args[1], 1, nil
+	}
+	return "", 0, fmt.Errorf("no value provided after %s flag", args[0])
+}
+
+// ParseRepeatedFlagString parses a flag that allows providing multiple strings.
+//
+// The flag in question should be in args[0].
+// If args[0] contains "=" or " " then the desired value will be extracted from that string and returned.
+// Otherwise, all of the following args up to the next one that starts with a dash are returned.
+// If there aren't any of those, an error is given.
+//
+// The first return value is the flag's string values.
+// The second return value is the number of extra arguments used.
+// The third return value is any error encountered.
+func ParseRepeatedFlagString(args []string) ([]string, int, error) {
+	if strings.ContainsAny(args[0], "= ") {
+		parts := strings.SplitN(args[0], "=", 2)
+		if len(parts) == 1 {
+			parts = strings.SplitN(args[0], " ", 2)
+		}
+		if len(parts) != 2 {
+			return []string{}, 0, fmt.Errorf("unable to split flag and value from string: [%s]", args[0])
+		}
+		if len(parts[1]) > 1 {
+			for _, c := range []string{`'`, `"`} {
+				if parts[1][:1] == c && parts[1][len(parts[1])-1:] == c {
+					parts[1] = parts[1][1 : len(parts[1])-1]
+				}
+			}
+		}
+		return parts[1:], 0, nil
+	}
+	rv := []string{}
+	for _, arg := range args[1:] {
+		if len(arg) > 0 && arg[0] == '-' {
+			return rv, len(rv), nil
+		}
+		rv = append(rv, arg)
+	}
+	if len(rv) > 0 {
+		return rv, len(rv), nil
+	}
+	return rv, 0, fmt.Errorf("no values provided after %s flag", args[0])
+}
+
+// ParseFlagBool parses a boolean flag from arguments.
+//
+// The flag in question should be in args[0].
+// If args[0] contains "=" or " " then the desired value will be extracted from that string and parsed.
+// Otherwise, if args[1] is a boolean string value, that is parsed.
+// Otherwise, the flag defaults to true.
+//
+// The first return value is the parsed boolean value.
+// The second return value is the number of extra arguments used.
+// The third return value is any error encountered.
+func ParseFlagBool(args []string) (bool, int, error) {
+	if strings.ContainsAny(args[0], "= ") {
+		parts := strings.SplitN(args[0], "=", 2)
+		if len(parts) == 1 {
+			parts = strings.SplitN(args[0], " ", 2)
+		}
+		if len(parts) == 2 {
+			val, isBool := parse.ParseBool(parts[1])
+			if !isBool {
+				return false, 0, fmt.Errorf("invalid %s bool value: [%s]", parts[0], parts[1])
+			}
+			return val, 0, nil
+		}
+		return false, 0, fmt.Errorf("unable to split flag and value from string: [%s]", args[0])
+	}
+	if len(args) > 1 {
+		val, isBool := parse.ParseBool(args[1])
+		if isBool {
+			return val, 1, nil
+		}
+	}
+	return true, 0, nil
+}
+
+// ParseFlagInt parses an int flag from arguments.
+//
+// The flag in question should be in args[0].
+// If args[0] contains "=" or " " then the desired value will be extracted from that string and returned.
+// Otherwise, if args[1] exists, that is returned.
+// Otherwise, an error is given.
+//
+// The first return value is the flag's int value.
+// The second return value is the number of extra arguments used.
+// The third return value is any error encountered.
+func ParseFlagInt(args []string) (int, int, error) {
+	rvStr, used, err := ParseFlagString(args)
+	if err != nil {
+		return 0, used, err
+	}
+	var rv int
+	rv, err = strconv.Atoi(rvStr)
+	if err != nil {
+		return 0, used, err
+	}
+	return rv, used, nil
+}
+
+// GetMyExe returns how to execute this program by parsing os.Args[0].
+func GetMyExe() string {
+	_, name := filepath.Split(os.Args[0])
+	if i := strings.Index(os.Args[0], "/go-build"); i == -1 {
+		name = "./" + name
+	} else {
+		name = fmt.Sprintf("go run %s.go", name)
+	}
+	return name
+}
@@ -0,0 +1,92 @@
+package params
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseFlagString(t *testing.T) {
+	tests := []struct {
+		name   string
+		args   []string
+		exp    string
+		extra  int
+		expErr string
+	}{
+		{name: "next arg", args: []string{"--input", "foo.input", "bar"}, exp: "foo.input", extra: 1},
+		{name: "equals", args: []string{"--input=foo.input", "bar"}, exp: "foo.input", extra: 0},
+		{name: "quoted", args: []string{`--input="foo bar"`}, exp: "foo bar", extra: 0},
+		{name: "missing", args: []string{"--input"}, expErr: "no value provided after --input flag"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			act, extra, err := ParseFlagString(tc.args)
+			if tc.expErr != "" {
+				if err == nil || err.Error() != tc.expErr {
+					t.Errorf("ParseFlagString error\nExpected: %s\n  Actual: %v", tc.expErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseFlagString error: %v", err)
+			}
+			if act != tc.exp || extra != tc.extra {
+				t.Errorf("ParseFlagString = (%q, %d), expected (%q, %d)", act, extra, tc.exp, tc.extra)
+			}
+		})
+	}
+}
+
+func TestParseFlagBool(t *testing.T) {
+	tests := []struct {
+		name  string
+		args  []string
+		exp   bool
+		extra int
+		isErr bool
+	}{
+		{name: "alone", args: []string{"--debug"}, exp: true},
+		{name: "next arg is bool", args: []string{"--debug", "off"}, exp: false, extra: 1},
+		{name: "next arg is not bool", args: []string{"--debug", "foo.input"}, exp: true},
+		{name: "equals", args: []string{"--debug=no"}, exp: false},
+		{name: "bad equals", args: []string{"--debug=maybe"}, isErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			act, extra, err := ParseFlagBool(tc.args)
+			if tc.isErr != (err != nil) {
+				t.Fatalf("ParseFlagBool error = %v, expected error: %t", err, tc.isErr)
+			}
+			if act != tc.exp || extra != tc.extra {
+				t.Errorf("ParseFlagBool = (%t, %d), expected (%t, %d)", act, extra, tc.exp, tc.extra)
+			}
+		})
+	}
+}
+
+func TestGetParams(t *testing.T) {
+	origDefault := DefaultCount
+	defer func() { DefaultCount = origDefault }()
+	DefaultCount = 7
+
+	act := GetParams([]string{"my.input", "-o", "3", "--lines", "a", "b", "--count=2", "-l=c"})
+	if act.HasError() {
+		t.Fatalf("GetParams error: %v", act.GetError())
+	}
+	if act.InputFile != "my.input" || act.Option != 3 || act.Count != 2 {
+		t.Errorf("GetParams = (%q, option %d, count %d), expected (%q, option 3, count 2)", act.InputFile, act.Option, act.Count, "my.input")
+	}
+	if exp := []string{"a", "b", "c"}; !reflect.DeepEqual(exp, act.Custom) {
+		t.Errorf("GetParams Custom\nExpected: %q\n  Actual: %q", exp, act.Custom)
+	}
+
+	act = GetParams([]string{"--bogus"})
+	if act.InputFile != DEFAULT_INPUT_FILE || act.Count != 7 {
+		t.Errorf("GetParams defaults = (%q, count %d), expected (%q, count 7)", act.InputFile, act.Count, DEFAULT_INPUT_FILE)
+	}
+	if err := act.GetError(); err == nil || err.Error() != "unknown argument 1: [--bogus]" {
+		t.Errorf("GetParams error = %v, expected unknown argument error", err)
+	}
+}
@@ -0,0 +1,77 @@
+package params
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/SpicyLemon/aoc/logging"
+	"github.com/SpicyLemon/aoc/parse"
+)
+
+// Main is what a day's main() func should call. It runs everything and exits non-zero if there's an error.
+func Main(solve SolveFunc) {
+	logging.ResetStartTime()
+	// Handle the env vars before calling into Run().
+	// That way, if debug is on, we will get the start message for Run().
+	err := HandleEnvVars()
+	if err == nil {
+		err = Run(solve, os.Args[1:])
+	}
+	if err != nil {
+		// Not using Stderrf(...) here because I don't want the time and function prefix on this.
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// Run does all the primary coordination for a day's program.
+// It's basically a replacement for main() that returns an error.
+func Run(solve SolveFunc, args []string) error {
+	defer logging.FuncEndingAlways(logging.FuncStarting())
+	params := GetParams(args)
+	if params.HelpPrinted {
+		return nil
+	}
+	if !params.HasError() {
+		var err error
+		params.Input, err = parse.ReadFile(params.InputFile)
+		params.AppendError(err)
+	}
+	logging.Debugf("Params:\n%s", params)
+	if params.HasError() {
+		return params.GetError()
+	}
+	answer, err := solve(params)
+	if err != nil {
+		return err
+	}
+	logging.Stdoutf("Answer: %s", answer)
+	return nil
+}
+
+// -------------------------------------------------------------------------------------------------
+// --------------------------------  Environment Variable Handling  --------------------------------
+// -------------------------------------------------------------------------------------------------
+
+// HandleEnvVars looks at specific environment variables and sets the logging flags appropriately.
+func HandleEnvVars() error {
+	var err error
+	logging.Debug, err = GetEnvVarBool("DEBUG")
+	if logging.Debug {
+		logging.Stderrf("Debugging enabled via environment variable.")
+	}
+	return err
+}
+
+// GetEnvVarBool gets the environment variable with the given name and converts it to a bool.
+func GetEnvVarBool(name string) (bool, error) {
+	str := os.Getenv(name)
+	if len(str) == 0 {
+		return false, nil
+	}
+	val, isBool := parse.ParseBool(str)
+	if !isBool {
+		return false, fmt.Errorf("invalid %s env var boolean value: [%s]", name, str)
+	}
+	return val, nil
+}
@@ -0,0 +1,106 @@
+// Package parse has the input parsing stuff from the advent of code template.go.
+package parse
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/SpicyLemon/aoc/logging"
+)
+
+// ReadFile reads a file and splits it into lines.
+// Trailing empty lines are removed.
+func ReadFile(filename string) ([]string, error) {
+	defer logging.FuncEndingAlways(logging.FuncStarting(filename))
+	logging.DebugAlwaysf("Reading file: %s", filename)
+	dat, err := os.ReadFile(filename)
+	if err != nil {
+		logging.Stderrf("error reading file: %v", err)
+		return []string{}, err
+	}
+	rv := strings.Split(string(dat), "\n")
+	for len(rv) > 0 && len(rv[len(rv)-1]) == 0 {
+		rv = rv[:len(rv)-1]
+	}
+	return rv, nil
+}
+
+// ParseGridOfStrings parses multiple lines into a grid of 1-char strings.
+func ParseGridOfStrings(lines []string) [][]string {
+	rv := make([][]string, len(lines))
+	for y, line := range lines {
+		rv[y] = make([]string, 0, len(line))
+		for _, r := range line {
+			rv[y] = append(rv[y], string(r))
+		}
+	}
+	return rv
+}
+
+// ParseGridOfInts parses multiple lines into a grid of 1-digit numbers.
+func ParseGridOfInts(lines []string) ([][]int, error) {
+	rv := make([][]int, len(lines))
+	var err error
+	for y, line := range lines {
+		rv[y] = make([]int, len(line))
+		for x, r := range line {
+			rv[y][x], err = strconv.Atoi(string(r))
+			if err != nil {
+				return nil, fmt.Errorf("could not parse (%d, %d) = %q as int: %w", x, y, string(r), err)
+			}
+		}
+	}
+	return rv, nil
+}
+
+// SplitParseInts splits a string on whitespace and converts each part into an int.
+// Uses strings.Fields(s) for the splitting and strconv.Atoi to parse it to an int.
+// Leading and trailing whitespace on each entry are ignored.
+func SplitParseInts(s string) ([]int, error) {
+	rv := []int{}
+	for _, entry := range strings.Fields(s) {
+		if len(entry) > 0 {
+			i, err := strconv.Atoi(strings.TrimSpace(entry))
+			if err != nil {
+				return rv, err
+			}
+			rv = append(rv, i)
+		}
+	}
+	return rv, nil
+}
+
+// SplitParseIntsD splits a string on the provided delimiter and converts each part into an int.
+// Uses strings.Split(s, d) for the splitting and strconv.Atoi to parse it to an int.
+// Leading and trailing whitespace on each entry are ignored.
+func SplitParseIntsD(s, d string) ([]int, error) {
+	rv := []int{}
+	for _, entry := range strings.Split(s, d) {
+		if len(entry) > 0 {
+			i, err := strconv.Atoi(strings.TrimSpace(entry))
+			if err != nil {
+				return rv, err
+			}
+			rv = append(rv, i)
+		}
+	}
+	return rv, nil
+}
+
+// ParseBool converts a string into a bool.
+// First return bool is the parsed value.
+// Second return bool is whether or not the parsing was successful.
+func ParseBool(str string) (val bool, isBool bool) {
+	// Note: Not using strconv.ParseBool because I want it a bit looser (any casing) and to allow yes/no/off/on values.
+	lstr := strings.ToLower(strings.TrimSpace(str))
+	switch lstr {
+	case "false", "f", "0", "no", "n", "off":
+		isBool = true
+	case "true", "t", "1", "yes", "y", "on":
+		val = true
+		isBool = true
+	}
+	return
+}
@@ -0,0 +1,94 @@
+package parse
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestReadFile(t *testing.T) {
+	dir := t.TempDir()
+	tests := []struct {
+		name     string
+		contents string
+		exp      []string
+	}{
+		{name: "trailing newlines removed", contents: "a\nb\n\n\n", exp: []string{"a", "b"}},
+		{name: "inner blank lines kept", contents: "a\n\nb", exp: []string{"a", "", "b"}},
+		{name: "empty file", contents: "", exp: []string{}},
+	}
+
+	for i, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fn := filepath.Join(dir, string(rune('a'+i))+".input")
+			if err := os.WriteFile(fn, []byte(tc.contents), 0o644); err != nil {
+				t.Fatalf("could not write test file: %v", err)
+			}
+			act, err := ReadFile(fn)
+			if err != nil {
+				t.Fatalf("ReadFile error: %v", err)
+			}
+			if !reflect.DeepEqual(tc.exp, act) {
+				t.Errorf("ReadFile\nExpected: %q\n  Actual: %q", tc.exp, act)
+			}
+		})
+	}
+}
+
+func TestParseGridOfInts(t *testing.T) {
+	act, err := ParseGridOfInts([]string{"123", "456"})
+	if err != nil {
+		t.Fatalf("ParseGridOfInts error: %v", err)
+	}
+	if exp := [][]int{{1, 2, 3}, {4, 5, 6}}; !reflect.DeepEqual(exp, act) {
+		t.Errorf("ParseGridOfInts\nExpected: %v\n  Actual: %v", exp, act)
+	}
+
+	_, err = ParseGridOfInts([]string{"12", "3x"})
+	if exp := `could not parse (1, 1) = "x" as int`; err == nil || len(err.Error()) < len(exp) || err.Error()[:len(exp)] != exp {
+		t.Errorf("ParseGridOfInts error\nExpected: %s...\n  Actual: %v", exp, err)
+	}
+}
+
+func TestSplitParseInts(t *testing.T) {
+	act, err := SplitParseInts("  1 -2\t3  ")
+	if err != nil {
+		t.Fatalf("SplitParseInts error: %v", err)
+	}
+	if exp := []int{1, -2, 3}; !reflect.DeepEqual(exp, act) {
+		t.Errorf("SplitParseInts\nExpected: %v\n  Actual: %v", exp, act)
+	}
+
+	act, err = SplitParseIntsD("4, 5,,6", ",")
+	if err != nil {
+		t.Fatalf("SplitParseIntsD error: %v", err)
+	}
+	if exp := []int{4, 5, 6}; !reflect.DeepEqual(exp, act) {
+		t.Errorf("SplitParseIntsD\nExpected: %v\n  Actual: %v", exp, act)
+	}
+}
+
+func TestParseBool(t *testing.T) {
+	tests := []struct {
+		str    string
+		val    bool
+		isBool bool
+	}{
+		{str: "YES", val: true, isBool: true},
+		{str: " on ", val: true, isBool: true},
+		{str: "f", val: false, isBool: true},
+		{str: "0", val: false, isBool: true},
+		{str: "maybe", val: false, isBool: false},
+		{str: "", val: false, isBool: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.str, func(t *testing.T) {
+			val, isBool := ParseBool(tc.str)
+			if val != tc.val || isBool != tc.isBool {
+				t.Errorf("ParseBool(%q) = (%t, %t), expected (%t, %t)", tc.str, val, isBool, tc.val, tc.isBool)
+			}
+		})
+	}
+}
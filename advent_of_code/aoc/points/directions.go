@@ -0,0 +1,55 @@
+package points
+
+// Direction is a typed byte used to indicate a direction of travel.
+type Direction byte
+
+const (
+	Up    = Direction('^')
+	Down  = Direction('v')
+	Left  = Direction('<')
+	Right = Direction('>')
+)
+
+var (
+	// Dirs are all of the directions available.
+	Dirs = []Direction{Up, Down, Left, Right}
+	// DirNames are a map of direction to a string naming it.
+	DirNames = map[Direction]string{
+		Up:    "Up",
+		Down:  "Down",
+		Left:  "Left",
+		Right: "Right",
+	}
+	// DirOpposites is a map of direction to the direction going the other way.
+	DirOpposites = map[Direction]Direction{
+		Up:    Down,
+		Down:  Up,
+		Left:  Right,
+		Right: Left,
+	}
+
+	DUp    = NewPoint(0, -1)
+	DDown  = NewPoint(0, 1)
+	DLeft  = NewPoint(-1, 0)
+	DRight = NewPoint(1, 0)
+
+	// DDirs is a map of direction to a Point that, when added to another Point, will move in that direction.
+	DDirs = map[Direction]*Point{
+		Up:    DUp,
+		Down:  DDown,
+		Left:  DLeft,
+		Right: DRight,
+	}
+)
+
+// GetAdjacentPoints gets the points that are adjacent to the given one.
+func GetAdjacentPoints(p *Point) map[Direction]*Point {
+	rv := make(map[Direction]*Point)
+	if p == nil {
+		return rv
+	}
+	for _, dir := range Dirs {
+		rv[dir] = AddPoints(p, DDirs[dir])
+	}
+	return rv
+}
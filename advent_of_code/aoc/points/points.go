@@ -0,0 +1,186 @@
+// Package points has the coordinate stuff from the advent of code template.go and node-grid.go.
+package points
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/SpicyLemon/aoc"
+)
+
+// XY is something that has an X and Y value.
+type XY interface {
+	GetX() int
+	GetY() int
+	GetXY() (int, int)
+}
+
+// A Point contains an X and Y value.
+type Point struct {
+	X int
+	Y int
+}
+
+// NewPoint creates a new Point with the given coordinates.
+func NewPoint(x, y int) *Point {
+	return &Point{X: x, Y: y}
+}
+
+// String returns a string of this point in the format "(x,y)".
+func (p Point) String() string {
+	return fmt.Sprintf("(%d,%d)", p.X, p.Y)
+}
+
+// GetX gets this Point's X value.
+func (p Point) GetX() int {
+	return p.X
+}
+
+// GetY gets this Point's Y value.
+func (p Point) GetY() int {
+	return p.Y
+}
+
+// GetXY gets this Point's (X, Y) values.
+func (p Point) GetXY() (int, int) {
+	return p.X, p.Y
+}
+
+// ParsePoint parses a string of the format "<x>,<y>" into a Point.
+func ParsePoint(str string) (Point, error) {
+	parts := strings.Split(str, ",")
+	if len(parts) != 2 {
+		return Point{}, fmt.Errorf("unable to parse point %q: expected format \"<x>,<y>\"", str)
+	}
+	x, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return Point{}, fmt.Errorf("unable to parse point %q: invalid <x>: %w", str, err)
+	}
+	y, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+	if err != nil {
+		return Point{}, fmt.Errorf("unable to parse point %q: invalid <y>: %w", str, err)
+	}
+	return Point{x, y}, nil
+}
+
+// AddPoints returns a new point that is the sum of the provided points.
+// See also: AddXYs, SumXYs.
+func AddPoints(points ...*Point) *Point {
+	rv := NewPoint(0, 0)
+	for _, p := range points {
+		rv.X += p.X
+		rv.Y += p.Y
+	}
+	return rv
+}
+
+// AddXYs returns a new point that is the sum of the provided points.
+// See also: AddPoints, SumXYs.
+func AddXYs(points ...XY) *Point {
+	return SumXYs(points)
+}
+
+// SumXYs returns a new point that is the sum of the provided points.
+// See also: AddXYs, AddPoints.
+func SumXYs[S ~[]E, E XY](points S) *Point {
+	rv := NewPoint(0, 0)
+	for _, p := range points {
+		rv.X += p.GetX()
+		rv.Y += p.GetY()
+	}
+	return rv
+}
+
+// IsSameXY returns true if a and b have the same x and y.
+func IsSameXY(a, b XY) bool {
+	return a.GetX() == b.GetX() && a.GetY() == b.GetY()
+}
+
+// AsPoints converts a slice of something with an X and Y into a slice of points.
+func AsPoints[S ~[]E, E XY](vals S) []*Point {
+	rv := make([]*Point, len(vals))
+	for i, val := range vals {
+		rv[i] = NewPoint(val.GetX(), val.GetY())
+	}
+	return rv
+}
+
+// HasPoint returns true if there's a point in path with the same (x,y) as the point provided.
+func HasPoint[S ~[]E, E XY](path S, point XY) bool {
+	x, y := point.GetXY()
+	for _, p := range path {
+		if x == p.GetX() && y == p.GetY() {
+			return true
+		}
+	}
+	return false
+}
+
+// -----------------------------------------------------------------------------
+// ------------------------------  String Makers  ------------------------------
+// -----------------------------------------------------------------------------
+
+// PointsString is simpler than PathString.
+// EnhancedPathString is a similar signature as grids.CreateIndexedGridString.
+
+// PointString returns the "(%d,%d)" string for the provided XY.
+// The generic here might seem silly, but without it, its use in PointsString gives a syntax error.
+func PointString[V XY](p V) string {
+	return fmt.Sprintf("(%d,%d)", p.GetX(), p.GetY())
+}
+
+// PointsString creates a string of the provided points, e.g. "(0,0) (0,1) (1,1)".
+func PointsString[S ~[]E, E XY](points S) string {
+	return strings.Join(aoc.MapSlice(points, PointString), " ")
+}
+
+// PathString returns a one-line string containing all the points in the provided slice of XY. E.g. "{3}[0:(0,0);1:(0,1);2:(1,1)]".
+func PathString[S ~[]E, E XY](path S) string {
+	lines := aoc.MapSlice(path, PointString)
+	for i, line := range lines {
+		lines[i] = strconv.Itoa(i) + ":" + line
+	}
+	return fmt.Sprintf("{%d}[%s]", len(path), strings.Join(lines, ";"))
+}
+
+// EnhancedPathString creates a string of the provided path, coloring and/or highlighting points as provided.
+func EnhancedPathString[P ~[]Q, Q XY, S ~[]E, E XY](path P, colorPoints, highlightPoints S) string {
+	fmts := make([]int, len(path))
+	for i, point := range path {
+		if HasPoint(colorPoints, point) {
+			fmts[i] = 1
+		}
+		if HasPoint(highlightPoints, point) {
+			fmts[i] += 2
+		}
+	}
+
+	var rv strings.Builder
+	for i, point := range path {
+		if i != 0 {
+			rv.WriteByte(';')
+		}
+		rv.WriteString(FormatCell(fmt.Sprintf("%d:(%d,%d)", i, point.GetX(), point.GetY()), fmts[i]))
+	}
+
+	return fmt.Sprintf("{%d}[%s]", len(path), rv.String())
+}
+
+// FormatCell adds the terminal color codes to the provided string for the provided format.
+// The format is 0 for the default look, 1 to color it, 2 to highlight it, or 3 to color and highlight it.
+// Any other format makes it ugly (so that it's obvious).
+func FormatCell(cell string, format int) string {
+	switch format {
+	case 0: // default look.
+		return cell
+	case 1: // color only.
+		return "\033[94m" + cell + "\033[0m" // Light-blue text.
+	case 2: // highlight only
+		return "\033[7m" + cell + "\033[0m" // Foreground<->Background Reversed.
+	case 3: // color and highlight
+		return "\033[94;7m" + cell + "\033[0m" // Light-blue background after fg<->bg reversed.
+	default: // Unknown, make it ugly.
+		return "\033[93;41m" + cell + "\033[0m" // Bright yellow text on a red background.
+	}
+}
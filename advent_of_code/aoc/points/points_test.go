@@ -0,0 +1,88 @@
+package points
+
+import "testing"
+
+func TestAddPoints(t *testing.T) {
+	act := AddPoints(NewPoint(1, 2), DDown, DRight, NewPoint(-5, 0))
+	if exp := NewPoint(-3, 3); !IsSameXY(exp, act) {
+		t.Errorf("AddPoints = %s, expected %s", act, exp)
+	}
+}
+
+func TestGetAdjacentPoints(t *testing.T) {
+	act := GetAdjacentPoints(NewPoint(3, 3))
+	exp := map[Direction]*Point{
+		Up:    NewPoint(3, 2),
+		Down:  NewPoint(3, 4),
+		Left:  NewPoint(2, 3),
+		Right: NewPoint(4, 3),
+	}
+	if len(act) != len(exp) {
+		t.Fatalf("GetAdjacentPoints returned %d points, expected %d", len(act), len(exp))
+	}
+	for dir, p := range exp {
+		if !IsSameXY(p, act[dir]) {
+			t.Errorf("GetAdjacentPoints[%s] = %s, expected %s", DirNames[dir], act[dir], p)
+		}
+	}
+
+	if act = GetAdjacentPoints(nil); len(act) != 0 {
+		t.Errorf("GetAdjacentPoints(nil) returned %d points, expected 0", len(act))
+	}
+}
+
+func TestDirOpposites(t *testing.T) {
+	for _, dir := range Dirs {
+		if act := AddPoints(DDirs[dir], DDirs[DirOpposites[dir]]); !IsSameXY(NewPoint(0, 0), act) {
+			t.Errorf("%s + its opposite = %s, expected (0,0)", DirNames[dir], act)
+		}
+	}
+}
+
+func TestPathStrings(t *testing.T) {
+	path := []*Point{NewPoint(0, 0), NewPoint(0, 1), NewPoint(1, 1)}
+	if act, exp := PointsString(path), "(0,0) (0,1) (1,1)"; act != exp {
+		t.Errorf("PointsString\nExpected: %q\n  Actual: %q", exp, act)
+	}
+	if act, exp := PathString(path), "{3}[0:(0,0);1:(0,1);2:(1,1)]"; act != exp {
+		t.Errorf("PathString\nExpected: %q\n  Actual: %q", exp, act)
+	}
+	act := EnhancedPathString(path, path[1:2], path[2:])
+	exp := "{3}[0:(0,0);\033[94m1:(0,1)\033[0m;\033[7m2:(1,1)\033[0m]"
+	if act != exp {
+		t.Errorf("EnhancedPathString\nExpected: %q\n  Actual: %q", exp, act)
+	}
+}
+
+func TestParsePoint(t *testing.T) {
+	tests := []struct {
+		str    string
+		exp    Point
+		expErr string
+	}{
+		{str: "3,4", exp: Point{X: 3, Y: 4}},
+		{str: " -1 , 12 ", exp: Point{X: -1, Y: 12}},
+		{str: "3", expErr: `unable to parse point "3": expected format "<x>,<y>"`},
+		{str: "1,2,3", expErr: `unable to parse point "1,2,3": expected format "<x>,<y>"`},
+		{str: "a,4", expErr: `unable to parse point "a,4": invalid <x>: strconv.Atoi: parsing "a": invalid syntax`},
+		{str: "3,b", expErr: `unable to parse point "3,b": invalid <y>: strconv.Atoi: parsing "b": invalid syntax`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.str, func(t *testing.T) {
+			act, err := ParsePoint(tc.str)
+			if len(tc.expErr) > 0 {
+				if err == nil || err.Error() != tc.expErr {
+					t.Fatalf("ParsePoint(%q) error = %v, expected %q", tc.str, err, tc.expErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParsePoint(%q) unexpected error: %v", tc.str, err)
+			}
+			if act != tc.exp {
+				t.Errorf("ParsePoint(%q) = %s, expected %s", tc.str, act, tc.exp)
+			}
+		})
+	}
+}
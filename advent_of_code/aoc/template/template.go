@@ -0,0 +1,57 @@
+// This is the starting point for a day's solution that uses the aoc module instead of having everything copied in.
+// Copy it to day-##a/day-##a.go (the year's newday.sh does this if it's the year's template.go).
+package main
+
+import (
+	"fmt"
+
+	"github.com/SpicyLemon/aoc/logging"
+	"github.com/SpicyLemon/aoc/params"
+)
+
+// Solve is the main entry point to finding a solution.
+// The string it returns should be (or include) the answer.
+func Solve(p *params.Params) (string, error) {
+	defer logging.FuncEndingAlways(logging.FuncStartingAlways())
+	input, err := ParseInput(p.Input)
+	if err != nil {
+		return "", err
+	}
+	logging.Debugf("Parsed Input:\n%s", input)
+	// TODO: Solve the problem!
+	answer := -999999999999999999
+	return fmt.Sprintf("%d", answer), nil
+}
+
+type Input struct {
+	// TODO: Put things in here pertaining to the puzzle input
+}
+
+func (i Input) String() string {
+	// aoc.StringJoin(slice, sep)
+	// aoc.StringNumberJoin(slice, startAt, sep) string
+	// aoc.StringNumberJoinFunc(slice, stringer, startAt, sep) string
+	// aoc.SliceToStrings(slice) []string
+	// aoc.AddLineNumbers(lines, startAt) []string
+	// aoc.MapSlice(slice, mapper) slice  or  aoc.MapPSlice  or  aoc.MapSliceP
+	// grids.CreateIndexedGridString(grid, color, highlight) string  or  grids.CreateIndexedGridStringBz  or  grids.CreateIndexedGridStringNums
+	// grids.CreateIndexedGridStringFunc(grid, converter, color, highlight)
+	return "TODO"
+}
+
+func ParseInput(lines []string) (*Input, error) {
+	defer logging.FuncEnding(logging.FuncStarting())
+	rv := Input{}
+	// TODO: Update this to parse the lines and create the puzzle input.
+	// parse.ParseGridOfStrings(lines) or parse.ParseGridOfInts(lines)
+	for i, line := range lines {
+		// parse.SplitParseInts(line) []int  or  parse.SplitParseIntsD(line, ",")
+		_, _ = i, line
+	}
+	return &rv, nil
+}
+
+func main() {
+	// To have a Count other than zero when one isn't provided, set params.DefaultCount here first.
+	params.Main(Solve)
+}
@@ -0,0 +1,101 @@
+// Package cards has the Dominion card data created by tables/parse_tables.go, and ways to load it.
+package cards
+
+import (
+	"regexp"
+	"strings"
+)
+
+// SchemaVersion is the version of the JSON created by the parse_tables.go parser.
+// It should be incremented whenever fields are added, removed, or change meaning.
+//
+// Version 1: Cards have "name", "types", "cost", "description".
+// Version 2: Card sets have "schemaVersion". Cards also have "costInfo", "expansion", "category".
+const SchemaVersion = 2
+
+// Card categories.
+const (
+	// CategoryKingdom is for cards that can be one of the kingdom piles.
+	CategoryKingdom = "kingdom"
+	// CategoryLandscape is for Events, Landmarks, Projects, Ways and Traits.
+	// They aren't part of the supply, but can be randomly chosen along with the kingdom.
+	CategoryLandscape = "landscape"
+	// CategoryOther is for everything else, e.g. Prizes, Ruins, Boons, Heirlooms.
+	CategoryOther = "other"
+)
+
+// IsKingdomCardSet returns true if the card set with the provided key has kingdom cards.
+func IsKingdomCardSet(cardSetKey string) bool {
+	return kingdomCardSets[cardSetKey]
+}
+
+// kingdomCardSets are the keys of the card sets that have kingdom cards.
+var kingdomCardSets = map[string]bool{
+	"kingdom":        true,
+	"in-1st-not-2nd": true,
+	"in-2nd-not-1st": true,
+}
+
+// landscapeTypes are the card types of the landscape cards.
+var landscapeTypes = map[string]bool{
+	"Event":    true,
+	"Landmark": true,
+	"Project":  true,
+	"Way":      true,
+	"Trait":    true,
+}
+
+// Card is a single Dominion card.
+type Card struct {
+	Name        string   `json:"name"`
+	Types       []string `json:"types"`
+	Cost        string   `json:"cost"`
+	CostInfo    *Cost    `json:"costInfo"`
+	Description string   `json:"description"`
+	Expansion   string   `json:"expansion"`
+	Category    string   `json:"category"`
+}
+
+// Cost is the structured version of a card's cost string.
+type Cost struct {
+	// Coins is the number of dollars, e.g. 4 for "$4", "$4+", "$4◉" or "$4+3Debt".
+	Coins int `json:"coins"`
+	// Potion is true if the card also costs a potion, e.g. "$4◉".
+	Potion bool `json:"potion"`
+	// Debt is the amount of Debt, e.g. 4 for "4Debt", or 3 for "$4+3Debt".
+	Debt int `json:"debt"`
+	// Overpay is true if the card allows for overpaying, e.g. "$4+".
+	Overpay bool `json:"overpay"`
+	// Reducible is true if the card can cost less than shown, e.g. "$8-".
+	Reducible bool `json:"reducible"`
+	// NonSupply is true if the card has a cost but cannot be purchased, e.g. "$4*".
+	NonSupply bool `json:"nonSupply"`
+}
+
+// CardSet is a group of cards from an expansion, e.g. "Kingdom" or "Events".
+type CardSet struct {
+	SchemaVersion int     `json:"schemaVersion"`
+	Name          string  `json:"name"`
+	Info          string  `json:"info"`
+	Cards         []*Card `json:"cards"`
+}
+
+var cleanRx = regexp.MustCompile(`[^a-zA-Z0-9.]+`)
+
+// Key gets the key used for this CardSet in the JSON, e.g. "in-1st-not-2nd".
+func (s CardSet) Key() string {
+	return cleanRx.ReplaceAllString(strings.ToLower(s.Name), "-")
+}
+
+// GetCategory determines the category of a card in the card set with the provided key.
+func GetCategory(cardSetKey string, card *Card) string {
+	for _, t := range card.Types {
+		if landscapeTypes[t] {
+			return CategoryLandscape
+		}
+	}
+	if kingdomCardSets[cardSetKey] {
+		return CategoryKingdom
+	}
+	return CategoryOther
+}
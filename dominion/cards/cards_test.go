@@ -0,0 +1,36 @@
+package cards
+
+import (
+	"testing"
+)
+
+func TestGetCategory(t *testing.T) {
+	tests := []struct {
+		name   string
+		setKey string
+		types  []string
+		exp    string
+	}{
+		{name: "kingdom action", setKey: "kingdom", types: []string{"Action"}, exp: CategoryKingdom},
+		{name: "1st edition", setKey: "in-1st-not-2nd", types: []string{"Action", "Attack"}, exp: CategoryKingdom},
+		{name: "2nd edition", setKey: "in-2nd-not-1st", types: []string{"Treasure"}, exp: CategoryKingdom},
+		{name: "event in kingdom", setKey: "kingdom", types: []string{"Event"}, exp: CategoryLandscape},
+		{name: "events", setKey: "events", types: []string{"Event"}, exp: CategoryLandscape},
+		{name: "landmark", setKey: "landmarks", types: []string{"Landmark"}, exp: CategoryLandscape},
+		{name: "project", setKey: "projects", types: []string{"Project"}, exp: CategoryLandscape},
+		{name: "way", setKey: "ways", types: []string{"Way"}, exp: CategoryLandscape},
+		{name: "trait", setKey: "traits", types: []string{"Trait"}, exp: CategoryLandscape},
+		{name: "prize", setKey: "prizes", types: []string{"Action", "Prize"}, exp: CategoryOther},
+		{name: "ally", setKey: "allies", types: []string{"Ally"}, exp: CategoryOther},
+		{name: "other", setKey: "other", types: []string{"Treasure"}, exp: CategoryOther},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			act := GetCategory(tc.setKey, &Card{Types: tc.types})
+			if act != tc.exp {
+				t.Errorf("GetCategory(%q, %q) = %q, expected %q", tc.setKey, tc.types, act, tc.exp)
+			}
+		})
+	}
+}
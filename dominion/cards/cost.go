@@ -0,0 +1,60 @@
+package cards
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// define some regex for extracting cost info from a cost string.
+var (
+	coinsRx     = regexp.MustCompile(`^\$(\d+)([-+*◉]?)$`)
+	debtRx      = regexp.MustCompile(`^(\d+)Debt$`)
+	coinsDebtRx = regexp.MustCompile(`^\$(\d+)\+(\d+)Debt$`)
+)
+
+// ParseCost converts a cost string into a Cost.
+// An empty cost string (e.g. a Landmark) results in nil.
+// See dominion-maintenance.txt for the cost formats handled.
+func ParseCost(cost string) (*Cost, error) {
+	if cost == "" {
+		return nil, nil
+	}
+	if m := coinsRx.FindStringSubmatch(cost); m != nil {
+		coins, err := strconv.Atoi(m[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid cost %q: %w", cost, err)
+		}
+		rv := &Cost{Coins: coins}
+		switch m[2] {
+		case "+":
+			rv.Overpay = true
+		case "-":
+			rv.Reducible = true
+		case "*":
+			rv.NonSupply = true
+		case "◉":
+			rv.Potion = true
+		}
+		return rv, nil
+	}
+	if m := debtRx.FindStringSubmatch(cost); m != nil {
+		debt, err := strconv.Atoi(m[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid cost %q: %w", cost, err)
+		}
+		return &Cost{Debt: debt}, nil
+	}
+	if m := coinsDebtRx.FindStringSubmatch(cost); m != nil {
+		coins, err := strconv.Atoi(m[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid cost %q: %w", cost, err)
+		}
+		debt, err := strconv.Atoi(m[2])
+		if err != nil {
+			return nil, fmt.Errorf("invalid cost %q: %w", cost, err)
+		}
+		return &Cost{Coins: coins, Debt: debt}, nil
+	}
+	return nil, fmt.Errorf("unknown cost format: %q", cost)
+}
@@ -0,0 +1,52 @@
+package cards
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseCost(t *testing.T) {
+	tests := []struct {
+		cost   string
+		exp    *Cost
+		expErr string
+	}{
+		{cost: "", exp: nil},
+		{cost: "$0", exp: &Cost{}},
+		{cost: "$4", exp: &Cost{Coins: 4}},
+		{cost: "$14", exp: &Cost{Coins: 14}},
+		{cost: "$4+", exp: &Cost{Coins: 4, Overpay: true}},
+		{cost: "$8-", exp: &Cost{Coins: 8, Reducible: true}},
+		{cost: "$4◉", exp: &Cost{Coins: 4, Potion: true}},
+		{cost: "$0◉", exp: &Cost{Potion: true}},
+		{cost: "$4*", exp: &Cost{Coins: 4, NonSupply: true}},
+		{cost: "4Debt", exp: &Cost{Debt: 4}},
+		{cost: "$4+3Debt", exp: &Cost{Coins: 4, Debt: 3}},
+		{cost: "4", expErr: `unknown cost format: "4"`},
+		{cost: "$4 ", expErr: `unknown cost format: "$4 "`},
+		{cost: "$4+*", expErr: `unknown cost format: "$4+*"`},
+		{cost: "4 Debt", expErr: `unknown cost format: "4 Debt"`},
+		{cost: "$4+3", expErr: `unknown cost format: "$4+3"`},
+		{cost: "$99999999999999999999", expErr: `invalid cost "$99999999999999999999": strconv.Atoi: parsing "99999999999999999999": value out of range`},
+		{cost: "99999999999999999999Debt", expErr: `invalid cost "99999999999999999999Debt": strconv.Atoi: parsing "99999999999999999999": value out of range`},
+		{cost: "$4+99999999999999999999Debt", expErr: `invalid cost "$4+99999999999999999999Debt": strconv.Atoi: parsing "99999999999999999999": value out of range`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.cost, func(t *testing.T) {
+			cost, err := ParseCost(tc.cost)
+			if len(tc.expErr) > 0 {
+				if err == nil || err.Error() != tc.expErr {
+					t.Fatalf("ParseCost(%q) error = %v, expected %q", tc.cost, err, tc.expErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseCost(%q) unexpected error: %v", tc.cost, err)
+			}
+			if !reflect.DeepEqual(tc.exp, cost) {
+				t.Errorf("ParseCost(%q) = %+v, expected %+v", tc.cost, cost, tc.exp)
+			}
+		})
+	}
+}
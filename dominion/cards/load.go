@@ -0,0 +1,98 @@
+package cards
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+)
+
+// All has all the cards, the same as the all.json file.
+// The keys are the expansion keys (e.g. "dark-ages") and the values are
+// that expansion's card sets, keyed by card set key (e.g. "kingdom").
+type All map[string]map[string]*CardSet
+
+// LoadAll reads and parses the all.json file with the provided name.
+// An error is returned if any of the card sets have a different SchemaVersion.
+func LoadAll(filename string) (All, error) {
+	contents, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var rv All
+	if err = json.Unmarshal(contents, &rv); err != nil {
+		return nil, fmt.Errorf("could not parse %q: %w", filename, err)
+	}
+	for _, expansion := range rv.Expansions() {
+		for _, key := range rv.CardSetKeys(expansion) {
+			if v := rv[expansion][key].SchemaVersion; v != SchemaVersion {
+				return nil, fmt.Errorf("card set %s.%s in %q has schema version %d, expected %d",
+					expansion, key, filename, v, SchemaVersion)
+			}
+		}
+	}
+	return rv, nil
+}
+
+// Expansions gets the keys of all the expansions, sorted.
+func (a All) Expansions() []string {
+	rv := make([]string, 0, len(a))
+	for expansion := range a {
+		rv = append(rv, expansion)
+	}
+	sort.Strings(rv)
+	return rv
+}
+
+// CardSetKeys gets the keys of all the card sets in the provided expansion, sorted.
+func (a All) CardSetKeys(expansion string) []string {
+	rv := make([]string, 0, len(a[expansion]))
+	for key := range a[expansion] {
+		rv = append(rv, key)
+	}
+	sort.Strings(rv)
+	return rv
+}
+
+// Cards gets all of the cards.
+// They are ordered by expansion key, then card set key, then their order in the card set.
+func (a All) Cards() []*Card {
+	var rv []*Card
+	for _, expansion := range a.Expansions() {
+		for _, key := range a.CardSetKeys(expansion) {
+			rv = append(rv, a[expansion][key].Cards...)
+		}
+	}
+	return rv
+}
+
+// SubCards gets the cards that make up the pile of the provided placeholder card, e.g. the Dame and Sir cards for "Knights".
+// These are the cards of the non-kingdom card set (in the same expansion) that has the same name as the provided card.
+// Returns nil if the card isn't a kingdom card, or doesn't have any sub-cards.
+func (a All) SubCards(card *Card) []*Card {
+	if card.Category != CategoryKingdom {
+		return nil
+	}
+	key := CardSet{Name: card.Name}.Key()
+	if IsKingdomCardSet(key) {
+		return nil
+	}
+	if cardSet, ok := a[card.Expansion][key]; ok {
+		return cardSet.Cards
+	}
+	return nil
+}
+
+// LoadCardLists reads and parses a file like include_if.json or extra_cards.json.
+// They are an object where the keys are card names and the values are lists of card names.
+func LoadCardLists(filename string) (map[string][]string, error) {
+	contents, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var rv map[string][]string
+	if err = json.Unmarshal(contents, &rv); err != nil {
+		return nil, fmt.Errorf("could not parse %q: %w", filename, err)
+	}
+	return rv, nil
+}
@@ -0,0 +1,169 @@
+package cards
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func writeTestFile(t *testing.T, name, contents string) string {
+	t.Helper()
+	filename := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(filename, []byte(contents), 0644); err != nil {
+		t.Fatalf("could not write test file: %v", err)
+	}
+	return filename
+}
+
+func TestLoadAll(t *testing.T) {
+	filename := writeTestFile(t, "all.json", `{
+  "seaside": {"kingdom": {"schemaVersion": 2, "name": "Kingdom", "info": "", "cards": [
+    {"name": "Haven", "types": ["Action", "Duration"], "cost": "$2", "costInfo": {"coins": 2}, "expansion": "seaside", "category": "kingdom"}
+  ]}},
+  "base": {
+    "kingdom": {"schemaVersion": 2, "name": "Kingdom", "info": "", "cards": [
+      {"name": "Cellar", "types": ["Action"], "cost": "$2", "costInfo": {"coins": 2}, "expansion": "base", "category": "kingdom"},
+      {"name": "Moat", "types": ["Action", "Reaction"], "cost": "$2", "costInfo": {"coins": 2}, "expansion": "base", "category": "kingdom"}
+    ]},
+    "in-1st-not-2nd": {"schemaVersion": 2, "name": "In 1st Not 2nd", "info": "", "cards": [
+      {"name": "Chancellor", "types": ["Action"], "cost": "$3", "costInfo": {"coins": 3}, "expansion": "base", "category": "kingdom"}
+    ]}
+  }
+}`)
+
+	all, err := LoadAll(filename)
+	if err != nil {
+		t.Fatalf("LoadAll unexpected error: %v", err)
+	}
+	if exp, act := []string{"base", "seaside"}, all.Expansions(); !reflect.DeepEqual(exp, act) {
+		t.Errorf("Expansions() = %q, expected %q", act, exp)
+	}
+	if exp, act := []string{"in-1st-not-2nd", "kingdom"}, all.CardSetKeys("base"); !reflect.DeepEqual(exp, act) {
+		t.Errorf("CardSetKeys(\"base\") = %q, expected %q", act, exp)
+	}
+	var names []string
+	for _, card := range all.Cards() {
+		names = append(names, card.Name)
+	}
+	if exp := []string{"Chancellor", "Cellar", "Moat", "Haven"}; !reflect.DeepEqual(exp, names) {
+		t.Errorf("Cards() names = %q, expected %q", names, exp)
+	}
+	if exp, act := (&Cost{Coins: 2}), all["seaside"]["kingdom"].Cards[0].CostInfo; !reflect.DeepEqual(exp, act) {
+		t.Errorf("Haven CostInfo = %+v, expected %+v", act, exp)
+	}
+}
+
+func TestLoadAllErrors(t *testing.T) {
+	t.Run("wrong schema version", func(t *testing.T) {
+		filename := writeTestFile(t, "all.json", `{"base": {"kingdom": {"name": "Kingdom", "cards": []}}}`)
+		_, err := LoadAll(filename)
+		exp := "card set base.kingdom in \"" + filename + "\" has schema version 0, expected 2"
+		if err == nil || err.Error() != exp {
+			t.Errorf("LoadAll error = %v, expected %q", err, exp)
+		}
+	})
+
+	t.Run("not json", func(t *testing.T) {
+		filename := writeTestFile(t, "all.json", `nope`)
+		_, err := LoadAll(filename)
+		if err == nil || !strings.HasPrefix(err.Error(), "could not parse \""+filename+"\": ") {
+			t.Errorf("LoadAll error = %v, expected a parse error", err)
+		}
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := LoadAll(filepath.Join(t.TempDir(), "all.json"))
+		if !errors.Is(err, os.ErrNotExist) {
+			t.Errorf("LoadAll error = %v, expected os.ErrNotExist", err)
+		}
+	})
+}
+
+func TestLoadAllRepoData(t *testing.T) {
+	all, err := LoadAll(filepath.Join("..", "json", "all.json"))
+	if err != nil {
+		t.Fatalf("LoadAll unexpected error: %v", err)
+	}
+	names := make(map[string]bool)
+	for _, card := range all.Cards() {
+		if names[card.Name] {
+			t.Errorf("card %q is defined more than once", card.Name)
+		}
+		names[card.Name] = true
+		if len(card.Expansion) == 0 || len(card.Category) == 0 {
+			t.Errorf("card %q is missing its expansion (%q) or category (%q)", card.Name, card.Expansion, card.Category)
+		}
+	}
+	if len(all["base"]["kingdom"].Cards) == 0 {
+		t.Errorf("no base kingdom cards found")
+	}
+}
+
+func TestSubCards(t *testing.T) {
+	all, err := LoadAll(filepath.Join("..", "json", "all.json"))
+	if err != nil {
+		t.Fatalf("LoadAll unexpected error: %v", err)
+	}
+	byName := make(map[string]*Card)
+	for _, card := range all.Cards() {
+		byName[card.Name] = card
+	}
+
+	tests := []struct {
+		name   string
+		exp    []string
+		expLen int
+	}{
+		{name: "Knights", expLen: 10},
+		{name: "Augurs", exp: []string{"Herb Gatherer", "Acolyte", "Sorceress", "Sibyl"}},
+		{name: "Castles", expLen: 8},
+		{name: "Village", expLen: 0},
+		{name: "Sir Martin", expLen: 0},
+		{name: "Ruined Village", expLen: 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			card := byName[tc.name]
+			if card == nil {
+				t.Fatalf("card %q not found", tc.name)
+			}
+			var names []string
+			for _, sub := range all.SubCards(card) {
+				names = append(names, sub.Name)
+			}
+			if tc.exp != nil && !reflect.DeepEqual(tc.exp, names) {
+				t.Errorf("SubCards(%q) = %q, expected %q", tc.name, names, tc.exp)
+			}
+			if tc.exp == nil && len(names) != tc.expLen {
+				t.Errorf("SubCards(%q) = %q, expected %d cards", tc.name, names, tc.expLen)
+			}
+		})
+	}
+}
+
+func TestLoadCardLists(t *testing.T) {
+	filename := writeTestFile(t, "include_if.json", `{
+  "Ruins": ["Cultist", "Death Cart", "Marauder"],
+  "Spoils": ["Bandit Camp"]
+}`)
+	exp := map[string][]string{
+		"Ruins":  {"Cultist", "Death Cart", "Marauder"},
+		"Spoils": {"Bandit Camp"},
+	}
+	act, err := LoadCardLists(filename)
+	if err != nil {
+		t.Fatalf("LoadCardLists unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(exp, act) {
+		t.Errorf("LoadCardLists = %v, expected %v", act, exp)
+	}
+
+	filename = writeTestFile(t, "bad.json", `{"Ruins": "Cultist"}`)
+	_, err = LoadCardLists(filename)
+	if err == nil || !strings.HasPrefix(err.Error(), "could not parse \""+filename+"\": ") {
+		t.Errorf("LoadCardLists error = %v, expected a parse error", err)
+	}
+}
@@ -0,0 +1,229 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SpicyLemon/dominion/cards"
+	"github.com/SpicyLemon/dominion/randomizer"
+)
+
+// PrintUsage outputs a multi-line string with info on how to run this program.
+func PrintUsage(stdout io.Writer) {
+	fmt.Fprintf(stdout, `randomizer: Pick a random Dominion kingdom.
+
+Usage: randomizer [--data <dir>] [--seed <seed>] [--size <count>] [--expansion <key>[=<weight>] ...]
+                  [--edition <edition>] [--cost <costs>:<count> ...] [--no-cost-limits]
+                  [--keep <card name> ...] [--landscapes <count>|random] [--platinum <percent>] [--json]
+
+The --data flag is the directory with all.json, extra_cards.json and include_if.json. Default is json.
+The --seed flag makes the results reproducible. Default is the current time. The seed used is always output.
+The --size flag is the number of kingdom cards to pick. Default is %[1]d.
+The --expansion flag limits cards to the provided expansion(s), e.g. --expansion base --expansion dark-ages.
+  An optional weight changes how likely cards from that expansion are to be picked, e.g. --expansion seaside=2.
+  Default is all expansions with a weight of 1.
+The --edition flag picks which cards to use from expansions with two editions: 1st, 2nd, or both. Default is 2nd.
+The --cost flag limits the number of kingdom cards with some costs. Each part is a number, a range, or a number and up.
+  E.g. --cost '$2-3:1+' means at least one card costing $2 or $3, and --cost '6+:0-2' means at most two costing $6 or more.
+  Default limits are based on the --size. If any --cost flags are provided, the default limits aren't used.
+The --no-cost-limits flag turns off all cost limits.
+The --keep flag is the name of a card that must be in the kingdom, e.g. --keep "Young Witch".
+The --landscapes flag is the number of Events, Landmarks, Projects, Ways and Traits to pick. Default is random (usually 1 or 2).
+The --platinum flag is the percent chance of including Platinum and Colony. Default is 50.
+The --json flag outputs the results as JSON.
+`, randomizer.DefaultKingdomSize)
+}
+
+// randomizerParams are the things defined by the command-line arguments.
+type randomizerParams struct {
+	DataDir string
+	Options randomizer.Options
+	JSON    bool
+}
+
+// processFlags parses the provided args into the params.
+// Returns the params, whether processing should stop (e.g. usage was printed), and any error.
+func processFlags(argsIn []string, stdout io.Writer) (*randomizerParams, bool, error) {
+	rv := &randomizerParams{
+		DataDir: "json",
+		Options: randomizer.Options{
+			Seed:           time.Now().UnixNano(),
+			Landscapes:     randomizer.RandomLandscapes,
+			PlatinumChance: 50,
+		},
+	}
+	noCostLimits := false
+	for i := 0; i < len(argsIn); i++ {
+		arg := strings.TrimSpace(argsIn[i])
+		switch {
+		case equalFoldOneOf(arg, "--help", "-h", "help"):
+			PrintUsage(stdout)
+			return nil, true, nil
+		case equalFoldOneOf(arg, "--json"):
+			rv.JSON = true
+		case equalFoldOneOf(arg, "--no-cost-limits"):
+			noCostLimits = true
+		case equalFoldOneOf(arg, "--data", "--seed", "--size", "--expansion", "--edition", "--cost", "--keep", "--landscapes", "--platinum"):
+			if i+1 >= len(argsIn) {
+				return nil, true, fmt.Errorf("no value provided after %s", arg)
+			}
+			i++
+			if err := rv.setValue(strings.ToLower(arg), strings.TrimSpace(argsIn[i])); err != nil {
+				return nil, true, err
+			}
+		default:
+			return nil, true, fmt.Errorf("unknown argument %q", arg)
+		}
+	}
+
+	if noCostLimits {
+		if len(rv.Options.Costs) > 0 {
+			return nil, true, fmt.Errorf("the --cost and --no-cost-limits flags cannot be used together")
+		}
+		rv.Options.Costs = []randomizer.CostConstraint{}
+	}
+	return rv, false, nil
+}
+
+// setValue sets the value of the provided flag (that requires a value) in these params.
+func (p *randomizerParams) setValue(flag, val string) error {
+	var err error
+	switch flag {
+	case "--data":
+		p.DataDir = val
+	case "--seed":
+		p.Options.Seed, err = strconv.ParseInt(val, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid seed %q: must be a whole number", val)
+		}
+	case "--size":
+		p.Options.KingdomSize, err = strconv.Atoi(val)
+		if err != nil || p.Options.KingdomSize <= 0 {
+			return fmt.Errorf("invalid size %q: must be a positive whole number", val)
+		}
+	case "--expansion":
+		expansion, weightStr, hasWeight := strings.Cut(val, "=")
+		weight := 1.0
+		if hasWeight {
+			weight, err = strconv.ParseFloat(weightStr, 64)
+			if err != nil || weight < 0 {
+				return fmt.Errorf("invalid weight %q for expansion %q: must be a non-negative number", weightStr, expansion)
+			}
+		}
+		if p.Options.Weights == nil {
+			p.Options.Weights = make(map[string]float64)
+		}
+		p.Options.Weights[strings.ToLower(expansion)] = weight
+	case "--edition":
+		p.Options.Edition, err = randomizer.ParseEdition(val)
+		return err
+	case "--cost":
+		c, err := randomizer.ParseCostConstraint(val)
+		if err != nil {
+			return err
+		}
+		p.Options.Costs = append(p.Options.Costs, c)
+	case "--keep":
+		p.Options.Keep = append(p.Options.Keep, val)
+	case "--landscapes":
+		if strings.EqualFold(val, "random") {
+			p.Options.Landscapes = randomizer.RandomLandscapes
+			return nil
+		}
+		p.Options.Landscapes, err = strconv.Atoi(val)
+		if err != nil || p.Options.Landscapes < 0 {
+			return fmt.Errorf("invalid landscapes %q: must be a non-negative whole number or \"random\"", val)
+		}
+	case "--platinum":
+		p.Options.PlatinumChance, err = strconv.Atoi(strings.TrimSuffix(val, "%"))
+		if err != nil || p.Options.PlatinumChance < 0 || p.Options.PlatinumChance > 100 {
+			return fmt.Errorf("invalid platinum chance %q: must be a whole number from 0 to 100", val)
+		}
+	}
+	return nil
+}
+
+// mainE is the main program logic, returning any error encountered.
+func mainE(argsIn []string, stdout io.Writer) error {
+	params, stopNow, err := processFlags(argsIn, stdout)
+	if stopNow || err != nil {
+		return err
+	}
+
+	pool, err := randomizer.LoadPool(params.DataDir)
+	if err != nil {
+		return err
+	}
+	for expansion := range params.Options.Weights {
+		if _, known := pool.All[expansion]; !known {
+			return fmt.Errorf("unknown expansion %q: expected one of %s", expansion, strings.Join(pool.All.Expansions(), ", "))
+		}
+	}
+	kingdom, err := pool.Generate(params.Options)
+	if err != nil {
+		return err
+	}
+
+	if params.JSON {
+		bz, err := json.MarshalIndent(kingdom, "", "  ")
+		if err != nil {
+			return fmt.Errorf("error creating JSON: %w", err)
+		}
+		fmt.Fprintln(stdout, string(bz))
+		return nil
+	}
+	printKingdom(stdout, kingdom)
+	return nil
+}
+
+// printKingdom outputs the provided Kingdom in a human-readable format.
+func printKingdom(stdout io.Writer, kingdom *randomizer.Kingdom) {
+	fmt.Fprintf(stdout, "Seed: %d\n", kingdom.Seed)
+	fmt.Fprintf(stdout, "Kingdom:\n")
+	for _, card := range kingdom.Cards {
+		printCard(stdout, card)
+	}
+	if kingdom.Bane != nil {
+		fmt.Fprintf(stdout, "Bane:\n")
+		printCard(stdout, kingdom.Bane)
+	}
+	if len(kingdom.Landscapes) > 0 {
+		fmt.Fprintf(stdout, "Events, Landmarks, Projects, Ways and Traits:\n")
+		for _, card := range kingdom.Landscapes {
+			printCard(stdout, card)
+		}
+	}
+	if kingdom.PlatinumColony {
+		fmt.Fprintf(stdout, "Include Platinum and Colony.\n")
+	}
+	if len(kingdom.AlsoNeed) > 0 {
+		fmt.Fprintf(stdout, "You will also need: %s\n", strings.Join(kingdom.AlsoNeed, ", "))
+	}
+}
+
+// printCard outputs a single card line with its cost, name, expansion and types.
+func printCard(stdout io.Writer, card *cards.Card) {
+	fmt.Fprintf(stdout, "  %-8s %-20s %-12s %s\n", card.Cost, card.Name, card.Expansion, strings.Join(card.Types, " - "))
+}
+
+// equalFoldOneOf returns true if the arg is equal to any of the provided options (case insensitive).
+func equalFoldOneOf(arg string, options ...string) bool {
+	for _, opt := range options {
+		if strings.EqualFold(arg, opt) {
+			return true
+		}
+	}
+	return false
+}
+
+func main() {
+	if err := mainE(os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
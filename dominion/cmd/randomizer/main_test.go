@@ -0,0 +1,171 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/SpicyLemon/dominion/randomizer"
+)
+
+func TestPrintUsage(t *testing.T) {
+	var w bytes.Buffer
+	PrintUsage(&w)
+	if !strings.Contains(w.String(), "randomizer") {
+		t.Errorf("usage message should contain \"randomizer\": %q", w.String())
+	}
+}
+
+func TestProcessFlags(t *testing.T) {
+	tests := []struct {
+		name    string
+		argsIn  []string
+		check   func(t *testing.T, params *randomizerParams)
+		expStop bool
+		expErr  string
+	}{
+		{name: "help", argsIn: []string{"--help"}, expStop: true},
+		{
+			name:   "defaults",
+			argsIn: nil,
+			check: func(t *testing.T, params *randomizerParams) {
+				if params.DataDir != "json" || params.JSON {
+					t.Errorf("DataDir = %q, JSON = %t", params.DataDir, params.JSON)
+				}
+				opts := params.Options
+				if opts.Seed == 0 || opts.KingdomSize != 0 || opts.Costs != nil || opts.Landscapes != randomizer.RandomLandscapes || opts.PlatinumChance != 50 {
+					t.Errorf("Options = %+v", opts)
+				}
+			},
+		},
+		{
+			name: "everything",
+			argsIn: []string{
+				"--data", "dir", "--seed", "-5", "--size", "8", "--expansion", "Base", "--expansion", "seaside=2.5",
+				"--edition", "both", "--cost", "$2-3:1+", "--cost", "6+:0-1", "--keep", "Young Witch", "--keep", "Alms",
+				"--landscapes", "2", "--platinum", "25%", "--json",
+			},
+			check: func(t *testing.T, params *randomizerParams) {
+				exp := &randomizerParams{
+					DataDir: "dir",
+					Options: randomizer.Options{
+						Seed:        -5,
+						KingdomSize: 8,
+						Weights:     map[string]float64{"base": 1, "seaside": 2.5},
+						Edition:     randomizer.EditionBoth,
+						Costs: []randomizer.CostConstraint{
+							{Low: 2, High: 3, Min: 1, Max: randomizer.NoLimit},
+							{Low: 6, High: randomizer.NoLimit, Min: 0, Max: 1},
+						},
+						Keep:           []string{"Young Witch", "Alms"},
+						Landscapes:     2,
+						PlatinumChance: 25,
+					},
+					JSON: true,
+				}
+				if !reflect.DeepEqual(exp, params) {
+					t.Errorf("params = %+v, expected %+v", params, exp)
+				}
+			},
+		},
+		{
+			name:   "no cost limits",
+			argsIn: []string{"--no-cost-limits", "--landscapes", "random"},
+			check: func(t *testing.T, params *randomizerParams) {
+				if params.Options.Costs == nil || len(params.Options.Costs) != 0 {
+					t.Errorf("Costs = %#v, expected an empty slice", params.Options.Costs)
+				}
+			},
+		},
+		{name: "missing value", argsIn: []string{"--seed"}, expStop: true, expErr: "no value provided after --seed"},
+		{name: "unknown arg", argsIn: []string{"--nope"}, expStop: true, expErr: `unknown argument "--nope"`},
+		{name: "bad seed", argsIn: []string{"--seed", "x"}, expStop: true, expErr: `invalid seed "x": must be a whole number`},
+		{name: "bad size", argsIn: []string{"--size", "0"}, expStop: true, expErr: `invalid size "0": must be a positive whole number`},
+		{
+			name: "bad weight", argsIn: []string{"--expansion", "base=-1"}, expStop: true,
+			expErr: `invalid weight "-1" for expansion "base": must be a non-negative number`,
+		},
+		{
+			name: "bad edition", argsIn: []string{"--edition", "3rd"}, expStop: true,
+			expErr: `unknown edition "3rd": expected one of "1st", "2nd" or "both"`,
+		},
+		{
+			name: "bad cost", argsIn: []string{"--cost", "$2"}, expStop: true,
+			expErr: `invalid cost constraint "$2": expected format <costs>:<count>, e.g. "$2-3:1+"`,
+		},
+		{
+			name: "bad landscapes", argsIn: []string{"--landscapes", "some"}, expStop: true,
+			expErr: `invalid landscapes "some": must be a non-negative whole number or "random"`,
+		},
+		{
+			name: "bad platinum", argsIn: []string{"--platinum", "101"}, expStop: true,
+			expErr: `invalid platinum chance "101": must be a whole number from 0 to 100`,
+		},
+		{
+			name: "cost and no cost limits", argsIn: []string{"--cost", "2:1", "--no-cost-limits"}, expStop: true,
+			expErr: "the --cost and --no-cost-limits flags cannot be used together",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			params, stop, err := processFlags(tc.argsIn, &stdout)
+			if len(tc.expErr) > 0 {
+				if err == nil || err.Error() != tc.expErr {
+					t.Fatalf("processFlags error = %v, expected %q", err, tc.expErr)
+				}
+			} else if err != nil {
+				t.Fatalf("processFlags unexpected error: %v", err)
+			}
+			if stop != tc.expStop {
+				t.Errorf("processFlags stop = %t, expected %t", stop, tc.expStop)
+			}
+			if tc.check != nil {
+				tc.check(t, params)
+			}
+		})
+	}
+}
+
+func TestMainE(t *testing.T) {
+	args := []string{"--data", "../../json", "--seed", "8", "--keep", "Secret Cave", "--keep", "Young Witch", "--landscapes", "1", "--platinum", "100"}
+
+	var stdout bytes.Buffer
+	if err := mainE(args, &stdout); err != nil {
+		t.Fatalf("mainE unexpected error: %v", err)
+	}
+	out := stdout.String()
+	for _, exp := range []string{"Seed: 8\n", "Kingdom:\n", "Secret Cave", "Bane:\n", "Include Platinum and Colony.\n", "You will also need: "} {
+		if !strings.Contains(out, exp) {
+			t.Errorf("output does not contain %q:\n%s", exp, out)
+		}
+	}
+
+	var again bytes.Buffer
+	if err := mainE(args, &again); err != nil {
+		t.Fatalf("mainE unexpected error: %v", err)
+	}
+	if again.String() != out {
+		t.Errorf("output with the same seed is different:\n%s\n%s", out, again.String())
+	}
+
+	var jsonOut bytes.Buffer
+	if err := mainE(append(args, "--json"), &jsonOut); err != nil {
+		t.Fatalf("mainE --json unexpected error: %v", err)
+	}
+	var kingdom randomizer.Kingdom
+	if err := json.Unmarshal(jsonOut.Bytes(), &kingdom); err != nil {
+		t.Fatalf("could not parse --json output: %v\n%s", err, jsonOut.String())
+	}
+	if kingdom.Seed != 8 || len(kingdom.Cards) != randomizer.DefaultKingdomSize || kingdom.Bane == nil || len(kingdom.Landscapes) != 1 {
+		t.Errorf("unexpected --json output:\n%s", jsonOut.String())
+	}
+
+	err := mainE([]string{"--data", "../../json", "--expansion", "nope"}, &stdout)
+	if err == nil || !strings.HasPrefix(err.Error(), `unknown expansion "nope": expected one of adventures, alchemy, `) {
+		t.Errorf("mainE with unknown expansion error = %v", err)
+	}
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/SpicyLemon/dominion/cards"
+	"github.com/SpicyLemon/dominion/randomizer"
+	"github.com/SpicyLemon/dominion/search"
+)
+
+// PrintUsage outputs a multi-line string with info on how to run this program.
+func PrintUsage(stdout io.Writer) {
+	fmt.Fprint(stdout, `search: Find Dominion cards.
+
+Usage: search [--data <dir>] [--expand] [--json] <query>
+
+The --data flag is the directory with all.json, extra_cards.json and include_if.json. Default is json.
+The --expand flag also lists the cards in each pile of mixed cards, e.g. the Dame and Sir cards for Knights.
+The --json flag outputs the results as JSON.
+
+The <query> is any number of terms (all args are joined with spaces). A card must match all the terms.
+Terms are separated by whitespace. Use double quotes for values that have whitespace, e.g. 'text:"+2 Actions"'.
+Each term is <field><op><value>:
+  name      The card's name. With : the name only has to contain the value. With = it must be equal.
+  type      One of the card's types, e.g. type:Attack. Alias: types.
+  cost      The card's cost (Debt counts as dollars). Can also use <, <=, > and >=, e.g. cost<=4.
+  set       The card's expansion, e.g. set:seaside or set:dark-ages. Alias: expansion.
+  text      The card's description. With : it only has to contain the value. With = it must be equal.
+  category  The card's category: kingdom, landscape or other.
+A term without a field is a name, e.g. village is the same as name:village.
+Prefix a term with - to find cards that do NOT match it, e.g. -type:Attack.
+Case is ignored. An empty query finds all the cards.
+
+Example: search 'types:Attack cost<=4 set:seaside'
+`)
+}
+
+// searchParams are the things defined by the command-line arguments.
+type searchParams struct {
+	DataDir string
+	Expand  bool
+	JSON    bool
+	Query   search.Query
+}
+
+// processFlags parses the provided args into the params.
+// Returns the params, whether processing should stop (e.g. usage was printed), and any error.
+func processFlags(argsIn []string, stdout io.Writer) (*searchParams, bool, error) {
+	rv := &searchParams{DataDir: "json"}
+	var queryArgs []string
+	for i := 0; i < len(argsIn); i++ {
+		arg := strings.TrimSpace(argsIn[i])
+		switch {
+		case equalFoldOneOf(arg, "--help", "-h", "help"):
+			PrintUsage(stdout)
+			return nil, true, nil
+		case equalFoldOneOf(arg, "--json"):
+			rv.JSON = true
+		case equalFoldOneOf(arg, "--expand"):
+			rv.Expand = true
+		case equalFoldOneOf(arg, "--data"):
+			if i+1 >= len(argsIn) {
+				return nil, true, fmt.Errorf("no value provided after %s", arg)
+			}
+			i++
+			rv.DataDir = strings.TrimSpace(argsIn[i])
+		case strings.HasPrefix(arg, "--"):
+			return nil, true, fmt.Errorf("unknown argument %q", arg)
+		default:
+			queryArgs = append(queryArgs, arg)
+		}
+	}
+
+	var err error
+	rv.Query, err = search.ParseQuery(strings.Join(queryArgs, " "))
+	if err != nil {
+		return nil, true, err
+	}
+	return rv, false, nil
+}
+
+// mainE is the main program logic, returning any error encountered.
+func mainE(argsIn []string, stdout io.Writer) error {
+	params, stopNow, err := processFlags(argsIn, stdout)
+	if stopNow || err != nil {
+		return err
+	}
+
+	pool, err := randomizer.LoadPool(params.DataDir)
+	if err != nil {
+		return err
+	}
+	results := search.Find(pool, params.Query, params.Expand)
+
+	if params.JSON {
+		bz, err := json.MarshalIndent(results, "", "  ")
+		if err != nil {
+			return fmt.Errorf("error creating JSON: %w", err)
+		}
+		fmt.Fprintln(stdout, string(bz))
+		return nil
+	}
+	return printResults(stdout, results)
+}
+
+// printResults outputs the provided results as a table.
+func printResults(stdout io.Writer, results []*search.Result) error {
+	if len(results) == 0 {
+		fmt.Fprintln(stdout, "No cards found.")
+		return nil
+	}
+	var table bytes.Buffer
+	w := tabwriter.NewWriter(&table, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "COST\tNAME\tEXPANSION\tTYPES\tALSO NEED")
+	for _, result := range results {
+		printRow(w, result.Card, "", result.Needs)
+		for _, sub := range result.SubCards {
+			printRow(w, sub, "  ", nil)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	// The padding makes trailing whitespace when the last column is empty, so it's removed.
+	for _, line := range strings.Split(strings.TrimSuffix(table.String(), "\n"), "\n") {
+		fmt.Fprintln(stdout, strings.TrimRight(line, " "))
+	}
+	if len(results) == 1 {
+		fmt.Fprintln(stdout, "Found 1 card.")
+	} else {
+		fmt.Fprintf(stdout, "Found %d cards.\n", len(results))
+	}
+	return nil
+}
+
+// printRow outputs a single table row for the provided card. The indent goes before the card's name.
+func printRow(w io.Writer, card *cards.Card, indent string, needs []string) {
+	fmt.Fprintf(w, "%s\t%s%s\t%s\t%s\t%s\n", card.Cost, indent, card.Name, card.Expansion,
+		strings.Join(card.Types, " - "), strings.Join(needs, ", "))
+}
+
+// equalFoldOneOf returns true if the arg is equal to any of the provided options (case insensitive).
+func equalFoldOneOf(arg string, options ...string) bool {
+	for _, opt := range options {
+		if strings.EqualFold(arg, opt) {
+			return true
+		}
+	}
+	return false
+}
+
+func main() {
+	if err := mainE(os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
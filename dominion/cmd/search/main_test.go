@@ -0,0 +1,106 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/SpicyLemon/dominion/search"
+)
+
+func TestPrintUsage(t *testing.T) {
+	var w bytes.Buffer
+	PrintUsage(&w)
+	if !strings.Contains(w.String(), "search") {
+		t.Errorf("usage message should contain \"search\": %q", w.String())
+	}
+}
+
+func TestProcessFlags(t *testing.T) {
+	tests := []struct {
+		name     string
+		argsIn   []string
+		exp      *searchParams
+		expQuery string
+		expStop  bool
+		expErr   string
+	}{
+		{name: "help", argsIn: []string{"--help"}, expStop: true},
+		{name: "defaults", argsIn: nil, exp: &searchParams{DataDir: "json", Query: search.Query{}}},
+		{
+			name:     "everything",
+			argsIn:   []string{"--data", "dir", "types:Attack", "--expand", `text:"+2 Actions"`, "--JSON", "-set:seaside"},
+			exp:      &searchParams{DataDir: "dir", Expand: true, JSON: true},
+			expQuery: `type:Attack text:"+2 Actions" -set:seaside`,
+		},
+		{name: "one query arg", argsIn: []string{"types:Attack cost<=4"}, exp: &searchParams{DataDir: "json"}, expQuery: "type:Attack cost<=4"},
+		{name: "no data dir", argsIn: []string{"--data"}, expStop: true, expErr: "no value provided after --data"},
+		{name: "unknown flag", argsIn: []string{"--nope"}, expStop: true, expErr: `unknown argument "--nope"`},
+		{name: "bad query", argsIn: []string{"color:red"}, expStop: true, expErr: `unknown field "color" in "color:red": expected one of name, type, cost, set, text, category`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			params, stop, err := processFlags(tc.argsIn, &stdout)
+			if len(tc.expErr) > 0 {
+				if err == nil || err.Error() != tc.expErr {
+					t.Fatalf("processFlags error = %v, expected %q", err, tc.expErr)
+				}
+			} else if err != nil {
+				t.Fatalf("processFlags unexpected error: %v", err)
+			}
+			if stop != tc.expStop {
+				t.Errorf("processFlags stop = %t, expected %t", stop, tc.expStop)
+			}
+			if tc.exp == nil {
+				return
+			}
+			if params.DataDir != tc.exp.DataDir || params.Expand != tc.exp.Expand || params.JSON != tc.exp.JSON {
+				t.Errorf("processFlags = %+v, expected %+v", params, tc.exp)
+			}
+			if act := params.Query.String(); act != tc.expQuery {
+				t.Errorf("processFlags query = %q, expected %q", act, tc.expQuery)
+			}
+		})
+	}
+}
+
+func TestMainE(t *testing.T) {
+	var stdout bytes.Buffer
+	if err := mainE([]string{"--data", "../../json", "--expand", "name=knights"}, &stdout); err != nil {
+		t.Fatalf("mainE unexpected error: %v", err)
+	}
+	out := stdout.String()
+	for _, exp := range []string{"COST  NAME", "\n$5    Knights ", "\n$4      Sir Martin ", "Found 1 card.\n"} {
+		if !strings.Contains(out, exp) {
+			t.Errorf("output does not contain %q:\n%s", exp, out)
+		}
+	}
+	for i, line := range strings.Split(out, "\n") {
+		if strings.TrimRight(line, " ") != line {
+			t.Errorf("output line %d has trailing whitespace: %q", i+1, line)
+		}
+	}
+
+	stdout.Reset()
+	if err := mainE([]string{"--data", "../../json", "--json", "set:dark-ages", "cultist"}, &stdout); err != nil {
+		t.Fatalf("mainE --json unexpected error: %v", err)
+	}
+	var results []*search.Result
+	if err := json.Unmarshal(stdout.Bytes(), &results); err != nil {
+		t.Fatalf("could not parse --json output: %v\n%s", err, stdout.String())
+	}
+	if len(results) != 1 || results[0].Name != "Cultist" || strings.Join(results[0].Needs, ",") != "Ruins" {
+		t.Errorf("unexpected --json output:\n%s", stdout.String())
+	}
+
+	stdout.Reset()
+	if err := mainE([]string{"--data", "../../json", "set:nope"}, &stdout); err != nil {
+		t.Fatalf("mainE unexpected error: %v", err)
+	}
+	if exp := "No cards found.\n"; stdout.String() != exp {
+		t.Errorf("output = %q, expected %q", stdout.String(), exp)
+	}
+}
@@ -0,0 +1,5 @@
+module github.com/SpicyLemon/dominion
+
+go 1.23.0
+
+require golang.org/x/net v0.43.0
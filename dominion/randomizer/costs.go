@@ -0,0 +1,140 @@
+package randomizer
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/SpicyLemon/dominion/cards"
+)
+
+// NoLimit is used for the High cost or Max count of a CostConstraint that doesn't have an upper limit.
+const NoLimit = -1
+
+// CostConstraint limits how many of the kingdom cards can have a cost in a range.
+type CostConstraint struct {
+	// Low is the lowest cost (inclusive) that this constraint applies to.
+	Low int `json:"low"`
+	// High is the highest cost (inclusive) that this constraint applies to, or NoLimit.
+	High int `json:"high"`
+	// Min is the fewest number of cards allowed with a cost in the range.
+	Min int `json:"min"`
+	// Max is the most number of cards allowed with a cost in the range, or NoLimit.
+	Max int `json:"max"`
+}
+
+// Contains returns true if the provided cost is in the range of this CostConstraint.
+func (c CostConstraint) Contains(cost int) bool {
+	return cost >= c.Low && (c.High == NoLimit || cost <= c.High)
+}
+
+// Costs gets a string of the cost range of this CostConstraint, e.g. "$2-3" or "$5+".
+func (c CostConstraint) Costs() string {
+	return "$" + rangeString(c.Low, c.High)
+}
+
+// String returns this CostConstraint in the format that ParseCostConstraint takes, e.g. "$2-3:1+".
+func (c CostConstraint) String() string {
+	return c.Costs() + ":" + rangeString(c.Min, c.Max)
+}
+
+// rangeString creates a string of an inclusive range, e.g. "2-3", "5+" or just "4".
+func rangeString(low, high int) string {
+	switch {
+	case high == NoLimit:
+		return fmt.Sprintf("%d+", low)
+	case low == high:
+		return strconv.Itoa(low)
+	}
+	return fmt.Sprintf("%d-%d", low, high)
+}
+
+// ParseCostConstraint parses a string in the format "<costs>:<count>" into a CostConstraint.
+// Both parts can be a single number (e.g. "4"), a range (e.g. "2-3") or a number and up (e.g. "5+").
+// The <costs> can also have a leading $.
+//
+// E.g. "$2-3:1+" means at least one card costing $2 or $3, and "6+:0-2" means at most two cards costing $6 or more.
+func ParseCostConstraint(str string) (CostConstraint, error) {
+	costs, count, ok := strings.Cut(str, ":")
+	if !ok {
+		return CostConstraint{}, fmt.Errorf("invalid cost constraint %q: expected format <costs>:<count>, e.g. \"$2-3:1+\"", str)
+	}
+	low, high, err := parseRange(strings.TrimPrefix(costs, "$"))
+	if err != nil {
+		return CostConstraint{}, fmt.Errorf("invalid cost constraint %q costs: %w", str, err)
+	}
+	min, max, err := parseRange(count)
+	if err != nil {
+		return CostConstraint{}, fmt.Errorf("invalid cost constraint %q count: %w", str, err)
+	}
+	return CostConstraint{Low: low, High: high, Min: min, Max: max}, nil
+}
+
+// parseRange parses a string like "4", "2-3" or "5+" into the low and high of the range (high can be NoLimit).
+func parseRange(str string) (int, int, error) {
+	if strings.HasSuffix(str, "+") {
+		low, err := parseNonNegative(strings.TrimSuffix(str, "+"))
+		return low, NoLimit, err
+	}
+	lowStr, highStr, isRange := strings.Cut(str, "-")
+	low, err := parseNonNegative(lowStr)
+	if err != nil {
+		return 0, 0, err
+	}
+	if !isRange {
+		return low, low, nil
+	}
+	high, err := parseNonNegative(highStr)
+	if err != nil {
+		return 0, 0, err
+	}
+	if high < low {
+		return 0, 0, fmt.Errorf("range %q is backwards", str)
+	}
+	return low, high, nil
+}
+
+// parseNonNegative parses a string into an int that must not be negative.
+func parseNonNegative(str string) (int, error) {
+	rv, err := strconv.Atoi(str)
+	if err != nil || rv < 0 {
+		return 0, fmt.Errorf("%q is not a non-negative number", str)
+	}
+	return rv, nil
+}
+
+// defaultCostCounts are the min and max counts for the cost buckets $0-2, $3, $4, $5 and $6+ for each kingdom size.
+// These are the same defaults used in the randomizer page.
+var defaultCostCounts = [][2][2]int{
+	// {{min, max} for $0-2 and $6+}, {min, max} for each of $3, $4 and $5}
+	0: {{0, 1}, {0, 2}}, 1: {{0, 1}, {0, 2}}, 2: {{0, 1}, {0, 2}}, 3: {{0, 1}, {0, 2}}, 4: {{0, 1}, {0, 2}},
+	5: {{0, 1}, {0, 3}}, 6: {{0, 1}, {0, 3}}, 7: {{0, 1}, {0, 3}},
+	8: {{0, 2}, {0, 3}}, 9: {{0, 2}, {0, 3}},
+	10: {{0, 2}, {1, 4}}, 11: {{0, 2}, {1, 4}},
+	12: {{0, 2}, {1, 5}}, 13: {{0, 2}, {1, 5}},
+	14: {{1, 3}, {2, 5}}, 15: {{1, 3}, {2, 5}},
+}
+
+// DefaultCostConstraints gets the default CostConstraints for a kingdom of the provided size.
+// There aren't any for sizes larger than 15.
+func DefaultCostConstraints(size int) []CostConstraint {
+	if size < 0 || size >= len(defaultCostCounts) {
+		return nil
+	}
+	ends, mids := defaultCostCounts[size][0], defaultCostCounts[size][1]
+	rv := []CostConstraint{{Low: 0, High: 2, Min: ends[0], Max: ends[1]}}
+	for cost := 3; cost <= 5; cost++ {
+		rv = append(rv, CostConstraint{Low: cost, High: cost, Min: mids[0], Max: mids[1]})
+	}
+	return append(rv, CostConstraint{Low: 6, High: NoLimit, Min: ends[0], Max: ends[1]})
+}
+
+// CardCost gets the cost of a card for the purposes of randomization.
+// Debt is treated the same as dollars, and any potion, overpay, etc. is ignored.
+// Cards without a cost have a cost of 0.
+func CardCost(card *cards.Card) int {
+	if card.CostInfo == nil {
+		return 0
+	}
+	return card.CostInfo.Coins + card.CostInfo.Debt
+}
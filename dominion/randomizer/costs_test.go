@@ -0,0 +1,113 @@
+package randomizer
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/SpicyLemon/dominion/cards"
+)
+
+func TestParseCostConstraint(t *testing.T) {
+	tests := []struct {
+		str    string
+		exp    CostConstraint
+		expStr string
+		expErr string
+	}{
+		{str: "$2-3:1+", exp: CostConstraint{Low: 2, High: 3, Min: 1, Max: NoLimit}},
+		{str: "5+:1+", exp: CostConstraint{Low: 5, High: NoLimit, Min: 1, Max: NoLimit}, expStr: "$5+:1+"},
+		{str: "$6+:0-2", exp: CostConstraint{Low: 6, High: NoLimit, Min: 0, Max: 2}},
+		{str: "$4:2", exp: CostConstraint{Low: 4, High: 4, Min: 2, Max: 2}},
+		{str: "$0-2:0-1", exp: CostConstraint{Low: 0, High: 2, Min: 0, Max: 1}},
+		{str: "$3-3:1-1", exp: CostConstraint{Low: 3, High: 3, Min: 1, Max: 1}, expStr: "$3:1"},
+		{str: "$2-3", expErr: `invalid cost constraint "$2-3": expected format <costs>:<count>, e.g. "$2-3:1+"`},
+		{str: "$x:1", expErr: `invalid cost constraint "$x:1" costs: "x" is not a non-negative number`},
+		{str: "$3-2:1", expErr: `invalid cost constraint "$3-2:1" costs: range "3-2" is backwards`},
+		{str: "$2:-1", expErr: `invalid cost constraint "$2:-1" count: "" is not a non-negative number`},
+		{str: "$2:1-", expErr: `invalid cost constraint "$2:1-" count: "" is not a non-negative number`},
+		{str: "$2:+", expErr: `invalid cost constraint "$2:+" count: "" is not a non-negative number`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.str, func(t *testing.T) {
+			act, err := ParseCostConstraint(tc.str)
+			if len(tc.expErr) > 0 {
+				if err == nil || err.Error() != tc.expErr {
+					t.Fatalf("ParseCostConstraint(%q) error = %v, expected %q", tc.str, err, tc.expErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseCostConstraint(%q) unexpected error: %v", tc.str, err)
+			}
+			if act != tc.exp {
+				t.Errorf("ParseCostConstraint(%q) = %+v, expected %+v", tc.str, act, tc.exp)
+			}
+			expStr := tc.expStr
+			if len(expStr) == 0 {
+				expStr = tc.str
+			}
+			if str := act.String(); str != expStr {
+				t.Errorf("String() = %q, expected %q", str, expStr)
+			}
+		})
+	}
+}
+
+func TestCostConstraintContains(t *testing.T) {
+	between := CostConstraint{Low: 2, High: 3}
+	andUp := CostConstraint{Low: 5, High: NoLimit}
+	for cost, exp := range map[int][2]bool{0: {false, false}, 2: {true, false}, 3: {true, false}, 4: {false, false}, 5: {false, true}, 14: {false, true}} {
+		if act := between.Contains(cost); act != exp[0] {
+			t.Errorf("%s Contains(%d) = %t, expected %t", between.Costs(), cost, act, exp[0])
+		}
+		if act := andUp.Contains(cost); act != exp[1] {
+			t.Errorf("%s Contains(%d) = %t, expected %t", andUp.Costs(), cost, act, exp[1])
+		}
+	}
+}
+
+func TestDefaultCostConstraints(t *testing.T) {
+	exp := []CostConstraint{
+		{Low: 0, High: 2, Min: 0, Max: 2},
+		{Low: 3, High: 3, Min: 1, Max: 4},
+		{Low: 4, High: 4, Min: 1, Max: 4},
+		{Low: 5, High: 5, Min: 1, Max: 4},
+		{Low: 6, High: NoLimit, Min: 0, Max: 2},
+	}
+	if act := DefaultCostConstraints(10); !reflect.DeepEqual(exp, act) {
+		t.Errorf("DefaultCostConstraints(10) = %+v, expected %+v", act, exp)
+	}
+	for size := 0; size <= 15; size++ {
+		minTotal, maxTotal := 0, 0
+		for _, c := range DefaultCostConstraints(size) {
+			minTotal += c.Min
+			maxTotal += c.Max
+		}
+		if minTotal > size || (size > 0 && maxTotal < size) {
+			t.Errorf("DefaultCostConstraints(%d) has min total %d and max total %d", size, minTotal, maxTotal)
+		}
+	}
+	if act := DefaultCostConstraints(16); act != nil {
+		t.Errorf("DefaultCostConstraints(16) = %+v, expected nil", act)
+	}
+}
+
+func TestCardCost(t *testing.T) {
+	tests := []struct {
+		cost *cards.Cost
+		exp  int
+	}{
+		{cost: nil, exp: 0},
+		{cost: &cards.Cost{Coins: 4}, exp: 4},
+		{cost: &cards.Cost{Coins: 4, Potion: true}, exp: 4},
+		{cost: &cards.Cost{Debt: 8}, exp: 8},
+		{cost: &cards.Cost{Coins: 4, Debt: 3}, exp: 7},
+		{cost: &cards.Cost{Coins: 8, Reducible: true}, exp: 8},
+	}
+	for _, tc := range tests {
+		if act := CardCost(&cards.Card{CostInfo: tc.cost}); act != tc.exp {
+			t.Errorf("CardCost(%+v) = %d, expected %d", tc.cost, act, tc.exp)
+		}
+	}
+}
@@ -0,0 +1,430 @@
+// Package randomizer picks random Dominion kingdoms from the card data in the json directory.
+package randomizer
+
+import (
+	"fmt"
+	"math/rand"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/SpicyLemon/dominion/cards"
+)
+
+// DefaultKingdomSize is the number of kingdom cards picked when the Options don't say otherwise.
+const DefaultKingdomSize = 10
+
+// RandomLandscapes is used for Options.Landscapes to pick a random number of them.
+// It's usually 1 or 2, but is sometimes 0 or 3.
+const RandomLandscapes = -1
+
+// BaneCard is the name of the card that needs an extra kingdom card to be its Bane.
+const BaneCard = "Young Witch"
+
+// An Edition identifies which version of the expansions with two editions to use.
+type Edition string
+
+const (
+	// EditionSecond uses the 2nd edition cards (i.e. "kingdom" and "in-2nd-not-1st").
+	EditionSecond Edition = "2nd"
+	// EditionFirst uses the 1st edition cards (i.e. "kingdom" and "in-1st-not-2nd").
+	EditionFirst Edition = "1st"
+	// EditionBoth uses the cards from both editions.
+	EditionBoth Edition = "both"
+)
+
+// Includes returns true if cards in the card set with the provided key are part of this Edition.
+// An empty Edition is the same as EditionSecond.
+func (e Edition) Includes(cardSetKey string) bool {
+	switch cardSetKey {
+	case "in-1st-not-2nd":
+		return e == EditionFirst || e == EditionBoth
+	case "in-2nd-not-1st":
+		return e != EditionFirst
+	}
+	return true
+}
+
+// ParseEdition converts a string into an Edition.
+func ParseEdition(str string) (Edition, error) {
+	switch strings.ToLower(str) {
+	case "2nd", "2", "second", "":
+		return EditionSecond, nil
+	case "1st", "1", "first":
+		return EditionFirst, nil
+	case "both", "all":
+		return EditionBoth, nil
+	}
+	return "", fmt.Errorf("unknown edition %q: expected one of \"1st\", \"2nd\" or \"both\"", str)
+}
+
+// Pool is all the cards that can be picked from, and what else is needed for them.
+type Pool struct {
+	// All is all of the cards.
+	All cards.All
+	// ByName has each of the cards, keyed by name.
+	ByName map[string]*cards.Card
+	// AlsoNeed has the names of the other cards needed when using a card, keyed by card name.
+	// It's a combination of extra_cards.json and include_if.json (reversed).
+	AlsoNeed map[string][]string
+
+	// cards are all of the cards, in a consistent order.
+	cards []*cards.Card
+	// cardSetKeys has the key of the card set that each card is in, keyed by card name.
+	cardSetKeys map[string]string
+}
+
+// NewPool creates a new Pool from the provided cards, extra cards (see extra_cards.json) and include-ifs (see include_if.json).
+func NewPool(all cards.All, extraCards, includeIf map[string][]string) (*Pool, error) {
+	rv := &Pool{
+		All:         all,
+		ByName:      make(map[string]*cards.Card),
+		AlsoNeed:    make(map[string][]string),
+		cardSetKeys: make(map[string]string),
+	}
+	for _, expansion := range all.Expansions() {
+		for _, key := range all.CardSetKeys(expansion) {
+			for _, card := range all[expansion][key].Cards {
+				if _, known := rv.ByName[card.Name]; known {
+					return nil, fmt.Errorf("card %q is defined more than once", card.Name)
+				}
+				rv.ByName[card.Name] = card
+				rv.cardSetKeys[card.Name] = key
+				rv.cards = append(rv.cards, card)
+			}
+		}
+	}
+
+	for name, needs := range extraCards {
+		rv.AlsoNeed[name] = append(rv.AlsoNeed[name], needs...)
+	}
+	for need, names := range includeIf {
+		for _, name := range names {
+			rv.AlsoNeed[name] = append(rv.AlsoNeed[name], need)
+		}
+	}
+	for name, needs := range rv.AlsoNeed {
+		rv.AlsoNeed[name] = sortedUnique(needs)
+	}
+	return rv, nil
+}
+
+// LoadPool loads all.json, extra_cards.json and include_if.json from the provided directory and creates a Pool from them.
+func LoadPool(dir string) (*Pool, error) {
+	all, err := cards.LoadAll(filepath.Join(dir, "all.json"))
+	if err != nil {
+		return nil, err
+	}
+	extraCards, err := cards.LoadCardLists(filepath.Join(dir, "extra_cards.json"))
+	if err != nil {
+		return nil, err
+	}
+	includeIf, err := cards.LoadCardLists(filepath.Join(dir, "include_if.json"))
+	if err != nil {
+		return nil, err
+	}
+	return NewPool(all, extraCards, includeIf)
+}
+
+// Options define how a kingdom is picked.
+type Options struct {
+	// Seed is the seed for the random number generator. The same Seed and Options always result in the same Kingdom.
+	Seed int64
+	// KingdomSize is the number of kingdom cards to pick. Zero means DefaultKingdomSize.
+	KingdomSize int
+	// Weights are the relative chances of picking cards from each expansion, keyed by expansion (e.g. "dark-ages").
+	// Cards from an expansion with a weight of 2 are twice as likely to be picked as cards from one with a weight of 1.
+	// Expansions not in here (or with a weight of 0) aren't used. If empty, all expansions have a weight of 1.
+	Weights map[string]float64
+	// Edition defines which cards to use from the expansions with two editions. Empty means EditionSecond.
+	Edition Edition
+	// Costs are limits on the number of kingdom cards with each cost.
+	// If nil, the DefaultCostConstraints are used. Use an empty slice for no limits.
+	Costs []CostConstraint
+	// Keep are the names of cards that must be in the kingdom, e.g. because someone really wants to play with them.
+	// They can be from any expansion. Landscape cards (e.g. Events) count toward the Landscapes.
+	Keep []string
+	// Landscapes is the number of Events, Landmarks, Projects, Ways and Traits to pick, or RandomLandscapes.
+	Landscapes int
+	// PlatinumChance is the percent chance (0 to 100) of including Platinum and Colony.
+	PlatinumChance int
+}
+
+// Kingdom is the result of randomly picking cards.
+type Kingdom struct {
+	// Seed is the seed that was used to pick this Kingdom.
+	Seed int64 `json:"seed"`
+	// Cards are the kingdom cards, sorted by name.
+	Cards []*cards.Card `json:"cards"`
+	// Bane is the extra kingdom card needed for the Young Witch, or nil if not needed.
+	Bane *cards.Card `json:"bane,omitempty"`
+	// Landscapes are the Events, Landmarks, Projects, Ways and Traits, sorted by name.
+	Landscapes []*cards.Card `json:"landscapes"`
+	// PlatinumColony is true if Platinum and Colony should be included.
+	PlatinumColony bool `json:"platinumColony"`
+	// AlsoNeed are the names of other cards (or groups of cards) needed with this Kingdom, sorted.
+	AlsoNeed []string `json:"alsoNeed"`
+}
+
+// Generate randomly picks a Kingdom from this Pool using the provided Options.
+func (p *Pool) Generate(opts Options) (*Kingdom, error) {
+	size := opts.KingdomSize
+	if size == 0 {
+		size = DefaultKingdomSize
+	}
+	if size < 0 {
+		return nil, fmt.Errorf("invalid kingdom size %d: cannot be negative", size)
+	}
+	costs := opts.Costs
+	if costs == nil {
+		costs = DefaultCostConstraints(size)
+	}
+	if opts.PlatinumChance < 0 || opts.PlatinumChance > 100 {
+		return nil, fmt.Errorf("invalid platinum chance %d: must be from 0 to 100", opts.PlatinumChance)
+	}
+
+	rng := rand.New(rand.NewSource(opts.Seed))
+	rv := &Kingdom{Seed: opts.Seed}
+
+	// Separate the kept cards into kingdom cards and landscapes.
+	kept := make(map[string]bool)
+	var keptLandscapes []*cards.Card
+	for _, name := range opts.Keep {
+		card, known := p.ByName[name]
+		switch {
+		case !known:
+			return nil, fmt.Errorf("unknown card %q to keep", name)
+		case kept[name]:
+			return nil, fmt.Errorf("card %q to keep was provided more than once", name)
+		case card.Category == cards.CategoryKingdom:
+			rv.Cards = append(rv.Cards, card)
+		case card.Category == cards.CategoryLandscape:
+			keptLandscapes = append(keptLandscapes, card)
+		default:
+			return nil, fmt.Errorf("card %q to keep is not a kingdom card or landscape", name)
+		}
+		kept[name] = true
+	}
+	if len(rv.Cards) > size {
+		return nil, fmt.Errorf("there are %d kingdom cards to keep, but only %d to pick", len(rv.Cards), size)
+	}
+	for _, c := range costs {
+		if count := countCosts(rv.Cards, c); c.Max != NoLimit && count > c.Max {
+			return nil, fmt.Errorf("there are %d kingdom cards to keep costing %s, but the max is %d", count, c.Costs(), c.Max)
+		}
+	}
+
+	kingdom := p.candidates(opts, cards.CategoryKingdom, kept)
+	allowed := func(card *cards.Card) bool {
+		for _, c := range costs {
+			if c.Max != NoLimit && c.Contains(CardCost(card)) && countCosts(rv.Cards, c) >= c.Max {
+				return false
+			}
+		}
+		return true
+	}
+
+	// Pick cards to fulfill the minimums, then fill up the rest.
+	for _, c := range costs {
+		for countCosts(rv.Cards, c) < c.Min {
+			if len(rv.Cards) >= size {
+				return nil, fmt.Errorf("cannot pick %d kingdom cards costing %s with only %d kingdom cards", c.Min, c.Costs(), size)
+			}
+			card := kingdom.pick(rng, func(card *cards.Card) bool {
+				return c.Contains(CardCost(card)) && allowed(card)
+			})
+			if card == nil {
+				return nil, fmt.Errorf("not enough kingdom cards costing %s to pick %d", c.Costs(), c.Min)
+			}
+			rv.Cards = append(rv.Cards, card)
+		}
+	}
+	for len(rv.Cards) < size {
+		card := kingdom.pick(rng, allowed)
+		if card == nil {
+			return nil, fmt.Errorf("not enough kingdom cards to pick %d (found %d) with the cost limits", size, len(rv.Cards))
+		}
+		rv.Cards = append(rv.Cards, card)
+	}
+
+	for _, card := range rv.Cards {
+		if card.Name == BaneCard {
+			rv.Bane = kingdom.pick(rng, func(card *cards.Card) bool {
+				cost := CardCost(card)
+				return cost == 2 || cost == 3
+			})
+			if rv.Bane == nil {
+				return nil, fmt.Errorf("no kingdom cards costing $2 or $3 left to be the Bane for %s", BaneCard)
+			}
+			break
+		}
+	}
+
+	landscapes := p.candidates(opts, cards.CategoryLandscape, kept)
+	rv.Landscapes = keptLandscapes
+	count := opts.Landscapes
+	if count == RandomLandscapes {
+		count = randomLandscapeCount(rng)
+		if max := len(keptLandscapes) + len(landscapes.cards); count > max {
+			count = max
+		}
+	}
+	for len(rv.Landscapes) < count {
+		card := landscapes.pick(rng, nil)
+		if card == nil {
+			return nil, fmt.Errorf("not enough Events, Landmarks, Projects, Ways and Traits to pick %d", count)
+		}
+		rv.Landscapes = append(rv.Landscapes, card)
+	}
+
+	rv.PlatinumColony = opts.PlatinumChance == 100 || (opts.PlatinumChance > 0 && rng.Intn(100) < opts.PlatinumChance)
+
+	sortCards(rv.Cards)
+	sortCards(rv.Landscapes)
+	rv.AlsoNeed = p.alsoNeed(rv)
+	return rv, nil
+}
+
+// randomLandscapeCount picks a random number of landscapes: 0 (5%), 1 (50%), 2 (44%) or 3 (1%).
+// These are the same chances used in the randomizer page.
+func randomLandscapeCount(rng *rand.Rand) int {
+	r := rng.Intn(100)
+	switch {
+	case r < 5:
+		return 0
+	case r < 55:
+		return 1
+	case r < 99:
+		return 2
+	}
+	return 3
+}
+
+// alsoNeed gets the sorted names of everything else needed for the provided Kingdom.
+func (p *Pool) alsoNeed(kingdom *Kingdom) []string {
+	names := make([]string, 0, len(kingdom.Cards)+len(kingdom.Landscapes)+1)
+	for _, card := range kingdom.Cards {
+		names = append(names, card.Name)
+	}
+	for _, card := range kingdom.Landscapes {
+		names = append(names, card.Name)
+	}
+	if kingdom.Bane != nil {
+		names = append(names, kingdom.Bane.Name)
+	}
+	return p.AlsoNeedFor(names...)
+}
+
+// AlsoNeedFor gets the sorted names of everything else needed when using the cards with the provided names.
+// This includes anything needed by the things that are needed, e.g. a Secret Cave needs a Magic Lamp, which needs Wishes.
+func (p *Pool) AlsoNeedFor(names ...string) []string {
+	have := make(map[string]bool)
+	toCheck := make([]string, 0, len(names))
+	for _, name := range names {
+		have[name] = true
+		toCheck = append(toCheck, name)
+	}
+	rv := make([]string, 0)
+	for len(toCheck) > 0 {
+		name := toCheck[0]
+		toCheck = toCheck[1:]
+		for _, need := range p.AlsoNeed[name] {
+			if !have[need] {
+				have[need] = true
+				rv = append(rv, need)
+				toCheck = append(toCheck, need)
+			}
+		}
+	}
+	sort.Strings(rv)
+	return rv
+}
+
+// candidates gets the cards in the provided category that can be picked using the provided options.
+// Cards in the exclude map are not included.
+func (p *Pool) candidates(opts Options, category string, exclude map[string]bool) *weightedCards {
+	rv := &weightedCards{}
+	for _, card := range p.cards {
+		if card.Category != category || exclude[card.Name] || !opts.Edition.Includes(p.cardSetKeys[card.Name]) {
+			continue
+		}
+		weight := 1.0
+		if len(opts.Weights) > 0 {
+			weight = opts.Weights[card.Expansion]
+		}
+		if weight > 0 {
+			rv.cards = append(rv.cards, card)
+			rv.weights = append(rv.weights, weight)
+		}
+	}
+	return rv
+}
+
+// weightedCards are cards that can be randomly picked, each with its own chance of being picked.
+type weightedCards struct {
+	cards   []*cards.Card
+	weights []float64
+}
+
+// pick randomly picks one of these cards (that the filter allows), and removes it so it can't be picked again.
+// A nil filter allows all cards. Returns nil if there aren't any cards to pick from.
+func (w *weightedCards) pick(rng *rand.Rand, filter func(card *cards.Card) bool) *cards.Card {
+	total := 0.0
+	for i, card := range w.cards {
+		if filter == nil || filter(card) {
+			total += w.weights[i]
+		}
+	}
+	if total <= 0 {
+		return nil
+	}
+	r := rng.Float64() * total
+	last := -1
+	for i, card := range w.cards {
+		if filter != nil && !filter(card) {
+			continue
+		}
+		last = i
+		r -= w.weights[i]
+		if r < 0 {
+			break
+		}
+	}
+	// Using the last allowed card protects against rounding problems.
+	rv := w.cards[last]
+	w.cards = append(w.cards[:last], w.cards[last+1:]...)
+	w.weights = append(w.weights[:last], w.weights[last+1:]...)
+	return rv
+}
+
+// countCosts counts the cards with a cost in the range of the provided CostConstraint.
+func countCosts(cardList []*cards.Card, c CostConstraint) int {
+	rv := 0
+	for _, card := range cardList {
+		if c.Contains(CardCost(card)) {
+			rv++
+		}
+	}
+	return rv
+}
+
+// sortCards sorts the provided cards by name.
+func sortCards(cardList []*cards.Card) {
+	sort.Slice(cardList, func(i, j int) bool {
+		return cardList[i].Name < cardList[j].Name
+	})
+}
+
+// sortedUnique returns a sorted copy of the provided strings without any duplicates.
+func sortedUnique(strs []string) []string {
+	rv := make([]string, 0, len(strs))
+	seen := make(map[string]bool)
+	for _, str := range strs {
+		if !seen[str] {
+			seen[str] = true
+			rv = append(rv, str)
+		}
+	}
+	sort.Strings(rv)
+	return rv
+}
@@ -0,0 +1,383 @@
+package randomizer
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/SpicyLemon/dominion/cards"
+)
+
+// newTestCard creates a card for testing.
+func newTestCard(name, expansion, category string, coins int) *cards.Card {
+	rv := &cards.Card{Name: name, Expansion: expansion, Category: category, CostInfo: &cards.Cost{Coins: coins}}
+	if category == cards.CategoryLandscape {
+		rv.Types = []string{"Event"}
+	}
+	return rv
+}
+
+// newTestPool creates a pool with two expansions, each with 12 kingdom cards costing $2 to $5 and two events.
+// Base also has a 1st edition card and a 2nd edition card.
+func newTestPool(t *testing.T) *Pool {
+	t.Helper()
+	all := cards.All{}
+	for expansion, title := range map[string]string{"base": "Base", "seaside": "Seaside"} {
+		kingdom := &cards.CardSet{Name: "Kingdom"}
+		for i := 0; i < 12; i++ {
+			name := fmt.Sprintf("%s %d", title, i+1)
+			kingdom.Cards = append(kingdom.Cards, newTestCard(name, expansion, cards.CategoryKingdom, 2+i%4))
+		}
+		events := &cards.CardSet{Name: "Events"}
+		for i := 0; i < 2; i++ {
+			name := fmt.Sprintf("%s Event %d", title, i+1)
+			events.Cards = append(events.Cards, newTestCard(name, expansion, cards.CategoryLandscape, 3))
+		}
+		all[expansion] = map[string]*cards.CardSet{"kingdom": kingdom, "events": events}
+	}
+	all["base"]["in-1st-not-2nd"] = &cards.CardSet{Cards: []*cards.Card{newTestCard("Old", "base", cards.CategoryKingdom, 6)}}
+	all["base"]["in-2nd-not-1st"] = &cards.CardSet{Cards: []*cards.Card{newTestCard("New", "base", cards.CategoryKingdom, 6)}}
+	all["base"]["kingdom"].Cards = append(all["base"]["kingdom"].Cards, newTestCard(BaneCard, "base", cards.CategoryKingdom, 4))
+	all["base"]["other"] = &cards.CardSet{Cards: []*cards.Card{newTestCard("Prize", "base", cards.CategoryOther, 0)}}
+
+	extraCards := map[string][]string{"Base 1": {"Prize"}, "Prize": {"Trophy"}}
+	includeIf := map[string][]string{"Ruins": {"Base 1", "Seaside 1"}, "Potion": {"Seaside 1"}}
+	pool, err := NewPool(all, extraCards, includeIf)
+	if err != nil {
+		t.Fatalf("NewPool unexpected error: %v", err)
+	}
+	return pool
+}
+
+// cardNames gets the names of the provided cards.
+func cardNames(cardList []*cards.Card) []string {
+	rv := make([]string, 0, len(cardList))
+	for _, card := range cardList {
+		rv = append(rv, card.Name)
+	}
+	return rv
+}
+
+func TestParseEdition(t *testing.T) {
+	for str, exp := range map[string]Edition{"": EditionSecond, "2nd": EditionSecond, "First": EditionFirst, "1": EditionFirst, "both": EditionBoth} {
+		act, err := ParseEdition(str)
+		if err != nil || act != exp {
+			t.Errorf("ParseEdition(%q) = (%q, %v), expected %q", str, act, err, exp)
+		}
+	}
+	_, err := ParseEdition("3rd")
+	if exp := `unknown edition "3rd": expected one of "1st", "2nd" or "both"`; err == nil || err.Error() != exp {
+		t.Errorf("ParseEdition(\"3rd\") error = %v, expected %q", err, exp)
+	}
+}
+
+func TestEditionIncludes(t *testing.T) {
+	tests := []struct {
+		edition Edition
+		exp     map[string]bool
+	}{
+		{edition: "", exp: map[string]bool{"kingdom": true, "in-1st-not-2nd": false, "in-2nd-not-1st": true}},
+		{edition: EditionSecond, exp: map[string]bool{"kingdom": true, "in-1st-not-2nd": false, "in-2nd-not-1st": true}},
+		{edition: EditionFirst, exp: map[string]bool{"kingdom": true, "in-1st-not-2nd": true, "in-2nd-not-1st": false}},
+		{edition: EditionBoth, exp: map[string]bool{"kingdom": true, "in-1st-not-2nd": true, "in-2nd-not-1st": true}},
+	}
+	for _, tc := range tests {
+		for key, exp := range tc.exp {
+			if act := tc.edition.Includes(key); act != exp {
+				t.Errorf("Edition(%q).Includes(%q) = %t, expected %t", tc.edition, key, act, exp)
+			}
+		}
+	}
+}
+
+func TestNewPool(t *testing.T) {
+	pool := newTestPool(t)
+	exp := map[string][]string{
+		"Base 1":    {"Prize", "Ruins"},
+		"Prize":     {"Trophy"},
+		"Seaside 1": {"Potion", "Ruins"},
+	}
+	if !reflect.DeepEqual(exp, pool.AlsoNeed) {
+		t.Errorf("AlsoNeed = %v, expected %v", pool.AlsoNeed, exp)
+	}
+	if card := pool.ByName["Seaside 3"]; card == nil || card.Expansion != "seaside" {
+		t.Errorf("ByName[\"Seaside 3\"] = %+v", card)
+	}
+
+	all := cards.All{
+		"base":    {"kingdom": {Cards: []*cards.Card{newTestCard("Cellar", "base", cards.CategoryKingdom, 2)}}},
+		"seaside": {"kingdom": {Cards: []*cards.Card{newTestCard("Cellar", "seaside", cards.CategoryKingdom, 2)}}},
+	}
+	_, err := NewPool(all, nil, nil)
+	if exp := `card "Cellar" is defined more than once`; err == nil || err.Error() != exp {
+		t.Errorf("NewPool error = %v, expected %q", err, exp)
+	}
+}
+
+func TestAlsoNeedFor(t *testing.T) {
+	pool := newTestPool(t)
+	tests := []struct {
+		names []string
+		exp   []string
+	}{
+		{names: nil, exp: []string{}},
+		{names: []string{"Base 2"}, exp: []string{}},
+		{names: []string{"Base 1"}, exp: []string{"Prize", "Ruins", "Trophy"}},
+		{names: []string{"Seaside 1"}, exp: []string{"Potion", "Ruins"}},
+		{names: []string{"Base 1", "Prize"}, exp: []string{"Ruins", "Trophy"}},
+	}
+	for _, tc := range tests {
+		if act := pool.AlsoNeedFor(tc.names...); !reflect.DeepEqual(tc.exp, act) {
+			t.Errorf("AlsoNeedFor(%q) = %q, expected %q", tc.names, act, tc.exp)
+		}
+	}
+}
+
+func TestGenerateIsReproducible(t *testing.T) {
+	pool := newTestPool(t)
+	opts := Options{Seed: 12345, Landscapes: RandomLandscapes, PlatinumChance: 50}
+	first, err := pool.Generate(opts)
+	if err != nil {
+		t.Fatalf("Generate unexpected error: %v", err)
+	}
+	for i := 0; i < 5; i++ {
+		again, err := pool.Generate(opts)
+		if err != nil {
+			t.Fatalf("Generate unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(first, again) {
+			t.Fatalf("Generate with the same seed gave different results:\n%+v\n%+v", first, again)
+		}
+	}
+
+	differs := false
+	for seed := int64(1); seed <= 10 && !differs; seed++ {
+		opts.Seed = seed
+		other, err := pool.Generate(opts)
+		if err != nil {
+			t.Fatalf("Generate unexpected error: %v", err)
+		}
+		differs = !reflect.DeepEqual(cardNames(first.Cards), cardNames(other.Cards))
+	}
+	if !differs {
+		t.Errorf("Generate gave the same cards for 10 different seeds")
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	pool := newTestPool(t)
+	for seed := int64(0); seed < 50; seed++ {
+		kingdom, err := pool.Generate(Options{Seed: seed, Landscapes: 1})
+		if err != nil {
+			t.Fatalf("seed %d: Generate unexpected error: %v", seed, err)
+		}
+		if len(kingdom.Cards) != DefaultKingdomSize {
+			t.Errorf("seed %d: got %d cards, expected %d", seed, len(kingdom.Cards), DefaultKingdomSize)
+		}
+		if len(kingdom.Landscapes) != 1 {
+			t.Errorf("seed %d: got %d landscapes, expected 1", seed, len(kingdom.Landscapes))
+		}
+		seen := make(map[string]bool)
+		for _, card := range kingdom.Cards {
+			if seen[card.Name] {
+				t.Errorf("seed %d: %q was picked more than once", seed, card.Name)
+			}
+			seen[card.Name] = true
+			if card.Category != cards.CategoryKingdom || card.Name == "Old" {
+				t.Errorf("seed %d: %q should not have been picked", seed, card.Name)
+			}
+		}
+		for _, c := range DefaultCostConstraints(DefaultKingdomSize) {
+			if count := countCosts(kingdom.Cards, c); count < c.Min || (c.Max != NoLimit && count > c.Max) {
+				t.Errorf("seed %d: %d cards costing %s, expected %s", seed, count, c.Costs(), rangeString(c.Min, c.Max))
+			}
+		}
+		hasBaneCard := seen[BaneCard]
+		switch {
+		case hasBaneCard && kingdom.Bane == nil:
+			t.Errorf("seed %d: %s was picked without a Bane", seed, BaneCard)
+		case !hasBaneCard && kingdom.Bane != nil:
+			t.Errorf("seed %d: a Bane (%s) was picked without %s", seed, kingdom.Bane.Name, BaneCard)
+		case kingdom.Bane != nil && (seen[kingdom.Bane.Name] || CardCost(kingdom.Bane) > 3):
+			t.Errorf("seed %d: Bane %s is already picked or costs too much", seed, kingdom.Bane.Name)
+		}
+	}
+}
+
+func TestGenerateOptions(t *testing.T) {
+	pool := newTestPool(t)
+
+	t.Run("weights", func(t *testing.T) {
+		kingdom, err := pool.Generate(Options{Seed: 3, Weights: map[string]float64{"seaside": 1}, Costs: []CostConstraint{}, Landscapes: 2})
+		if err != nil {
+			t.Fatalf("Generate unexpected error: %v", err)
+		}
+		for _, card := range append(kingdom.Cards, kingdom.Landscapes...) {
+			if card.Expansion != "seaside" {
+				t.Errorf("%q is from %q but only seaside has a weight", card.Name, card.Expansion)
+			}
+		}
+	})
+
+	t.Run("heavy weights", func(t *testing.T) {
+		counts := make(map[string]int)
+		for seed := int64(0); seed < 20; seed++ {
+			kingdom, err := pool.Generate(Options{Seed: seed, KingdomSize: 5, Weights: map[string]float64{"base": 1, "seaside": 20}, Costs: []CostConstraint{}})
+			if err != nil {
+				t.Fatalf("Generate unexpected error: %v", err)
+			}
+			for _, card := range kingdom.Cards {
+				counts[card.Expansion]++
+			}
+		}
+		if counts["seaside"] <= counts["base"]*3 {
+			t.Errorf("expected far more seaside cards than base cards, got %v", counts)
+		}
+	})
+
+	t.Run("first edition", func(t *testing.T) {
+		kingdom, err := pool.Generate(Options{Seed: 1, KingdomSize: 1, Edition: EditionFirst, Costs: []CostConstraint{{Low: 6, High: NoLimit, Min: 1, Max: NoLimit}}})
+		if err != nil {
+			t.Fatalf("Generate unexpected error: %v", err)
+		}
+		if exp, act := []string{"Old"}, cardNames(kingdom.Cards); !reflect.DeepEqual(exp, act) {
+			t.Errorf("cards = %q, expected %q", act, exp)
+		}
+	})
+
+	t.Run("cost constraints", func(t *testing.T) {
+		costs := []CostConstraint{{Low: 2, High: 3, Min: 4, Max: NoLimit}, {Low: 5, High: NoLimit, Min: 0, Max: 0}}
+		kingdom, err := pool.Generate(Options{Seed: 7, KingdomSize: 8, Costs: costs})
+		if err != nil {
+			t.Fatalf("Generate unexpected error: %v", err)
+		}
+		if count := countCosts(kingdom.Cards, costs[0]); count < 4 {
+			t.Errorf("got %d cards costing $2-3, expected at least 4", count)
+		}
+		if count := countCosts(kingdom.Cards, costs[1]); count != 0 {
+			t.Errorf("got %d cards costing $5+, expected 0", count)
+		}
+	})
+
+	t.Run("keep", func(t *testing.T) {
+		kingdom, err := pool.Generate(Options{Seed: 9, KingdomSize: 3, Keep: []string{"Seaside 1", "Old", "Base Event 2"}, Costs: []CostConstraint{}, Landscapes: 1})
+		if err != nil {
+			t.Fatalf("Generate unexpected error: %v", err)
+		}
+		names := cardNames(kingdom.Cards)
+		if len(names) != 3 || !strings.Contains(strings.Join(names, ","), "Old") || !strings.Contains(strings.Join(names, ","), "Seaside 1") {
+			t.Errorf("cards = %q, expected 3 including Old and Seaside 1", names)
+		}
+		if exp, act := []string{"Base Event 2"}, cardNames(kingdom.Landscapes); !reflect.DeepEqual(exp, act) {
+			t.Errorf("landscapes = %q, expected %q", act, exp)
+		}
+		for _, need := range []string{"Potion", "Ruins"} {
+			if !strings.Contains(strings.Join(kingdom.AlsoNeed, ","), need) {
+				t.Errorf("AlsoNeed = %q, expected it to have %q", kingdom.AlsoNeed, need)
+			}
+		}
+	})
+
+	t.Run("also need", func(t *testing.T) {
+		kingdom, err := pool.Generate(Options{Seed: 2, KingdomSize: 2, Keep: []string{"Base 1", "Seaside 1"}, Costs: []CostConstraint{}})
+		if err != nil {
+			t.Fatalf("Generate unexpected error: %v", err)
+		}
+		if exp := []string{"Potion", "Prize", "Ruins", "Trophy"}; !reflect.DeepEqual(exp, kingdom.AlsoNeed) {
+			t.Errorf("AlsoNeed = %q, expected %q", kingdom.AlsoNeed, exp)
+		}
+	})
+
+	t.Run("platinum", func(t *testing.T) {
+		for chance, exp := range map[int]bool{0: false, 100: true} {
+			kingdom, err := pool.Generate(Options{Seed: 4, PlatinumChance: chance})
+			if err != nil {
+				t.Fatalf("Generate unexpected error: %v", err)
+			}
+			if kingdom.PlatinumColony != exp {
+				t.Errorf("chance %d: PlatinumColony = %t, expected %t", chance, kingdom.PlatinumColony, exp)
+			}
+		}
+	})
+}
+
+func TestGenerateErrors(t *testing.T) {
+	pool := newTestPool(t)
+	tests := []struct {
+		name   string
+		opts   Options
+		expErr string
+	}{
+		{name: "negative size", opts: Options{KingdomSize: -1}, expErr: "invalid kingdom size -1: cannot be negative"},
+		{name: "bad platinum", opts: Options{PlatinumChance: 101}, expErr: "invalid platinum chance 101: must be from 0 to 100"},
+		{name: "unknown keep", opts: Options{Keep: []string{"Nope"}}, expErr: `unknown card "Nope" to keep`},
+		{name: "duplicate keep", opts: Options{Keep: []string{"Base 1", "Base 1"}}, expErr: `card "Base 1" to keep was provided more than once`},
+		{name: "keep other", opts: Options{Keep: []string{"Prize"}}, expErr: `card "Prize" to keep is not a kingdom card or landscape`},
+		{name: "too many keeps", opts: Options{KingdomSize: 1, Keep: []string{"Base 1", "Base 2"}}, expErr: "there are 2 kingdom cards to keep, but only 1 to pick"},
+		{
+			name:   "keeps over max",
+			opts:   Options{KingdomSize: 2, Keep: []string{"Base 1", "Base 5"}, Costs: []CostConstraint{{Low: 2, High: 2, Max: 1}}},
+			expErr: "there are 2 kingdom cards to keep costing $2, but the max is 1",
+		},
+		{
+			name:   "min more than size",
+			opts:   Options{KingdomSize: 2, Costs: []CostConstraint{{Low: 2, High: NoLimit, Min: 3, Max: NoLimit}}},
+			expErr: "cannot pick 3 kingdom cards costing $2+ with only 2 kingdom cards",
+		},
+		{
+			name:   "not enough at cost",
+			opts:   Options{Costs: []CostConstraint{{Low: 7, High: NoLimit, Min: 1, Max: NoLimit}}},
+			expErr: "not enough kingdom cards costing $7+ to pick 1",
+		},
+		{
+			name:   "not enough cards",
+			opts:   Options{KingdomSize: 12, Weights: map[string]float64{"seaside": 1}, Costs: []CostConstraint{{Low: 2, High: 2, Max: 0}}},
+			expErr: "not enough kingdom cards to pick 12 (found 9) with the cost limits",
+		},
+		{
+			name:   "not enough landscapes",
+			opts:   Options{Weights: map[string]float64{"seaside": 1}, Landscapes: 3},
+			expErr: "not enough Events, Landmarks, Projects, Ways and Traits to pick 3",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := pool.Generate(tc.opts)
+			if err == nil || err.Error() != tc.expErr {
+				t.Errorf("Generate error = %v, expected %q", err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestGenerateRepoData(t *testing.T) {
+	pool, err := LoadPool("../json")
+	if err != nil {
+		t.Fatalf("LoadPool unexpected error: %v", err)
+	}
+	opts := Options{
+		Costs:      []CostConstraint{{Low: 2, High: 3, Min: 1, Max: NoLimit}, {Low: 5, High: NoLimit, Min: 1, Max: NoLimit}},
+		Keep:       []string{"Young Witch"},
+		Landscapes: RandomLandscapes,
+	}
+	for seed := int64(0); seed < 20; seed++ {
+		opts.Seed = seed
+		kingdom, err := pool.Generate(opts)
+		if err != nil {
+			t.Fatalf("seed %d: Generate unexpected error: %v", seed, err)
+		}
+		if len(kingdom.Cards) != DefaultKingdomSize || kingdom.Bane == nil {
+			t.Errorf("seed %d: got %d cards and Bane %v", seed, len(kingdom.Cards), kingdom.Bane)
+		}
+		for _, c := range opts.Costs {
+			if countCosts(kingdom.Cards, c) < c.Min {
+				t.Errorf("seed %d: no cards costing %s in %q", seed, c.Costs(), cardNames(kingdom.Cards))
+			}
+		}
+		if !strings.Contains(strings.Join(kingdom.AlsoNeed, ","), "Curse") {
+			t.Errorf("seed %d: AlsoNeed = %q, expected it to have Curse (for the Young Witch)", seed, kingdom.AlsoNeed)
+		}
+	}
+}
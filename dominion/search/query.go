@@ -0,0 +1,244 @@
+// Package search finds Dominion cards using simple queries, e.g. `types:Attack cost<=4 set:seaside text:"+2 Actions"`.
+package search
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/SpicyLemon/dominion/cards"
+	"github.com/SpicyLemon/dominion/randomizer"
+)
+
+// The fields that can be used in a query Term.
+const (
+	// FieldName is the card's name. With ":" the name only has to contain the value.
+	FieldName = "name"
+	// FieldType is one of the card's types, e.g. "Attack".
+	FieldType = "type"
+	// FieldCost is the card's cost in dollars (Debt counts as dollars). It can be compared using <, <=, > and >=.
+	FieldCost = "cost"
+	// FieldSet is the card's expansion, e.g. "seaside" or "dark-ages".
+	FieldSet = "set"
+	// FieldText is the card's description. With ":" the description only has to contain the value.
+	FieldText = "text"
+	// FieldCategory is the card's category: "kingdom", "landscape" or "other".
+	FieldCategory = "category"
+)
+
+// fieldNames has the field for each name (or alias) that can be used in a query.
+var fieldNames = map[string]string{
+	"name":        FieldName,
+	"type":        FieldType,
+	"types":       FieldType,
+	"cost":        FieldCost,
+	"set":         FieldSet,
+	"expansion":   FieldSet,
+	"text":        FieldText,
+	"description": FieldText,
+	"category":    FieldCategory,
+}
+
+// ops are the operators that can separate a field from its value.
+// The two-character ones are first so that they're found before their one-character prefixes.
+var ops = []string{"<=", ">=", ":", "=", "<", ">"}
+
+// Term is a single part of a Query, e.g. `cost<=4`.
+type Term struct {
+	// Field is the card field being checked, e.g. FieldCost.
+	Field string
+	// Op is the operator: ":", "=", "<", "<=", ">" or ">=".
+	Op string
+	// Value is what the field is checked against.
+	Value string
+	// Negate is true if the term was prefixed with a "-", i.e. cards must NOT match it.
+	Negate bool
+
+	// cost is the Value as a number, only used for FieldCost.
+	cost int
+}
+
+// Query is a list of terms that a card must match all of.
+type Query []Term
+
+// ParseQuery parses a query string, e.g. `types:Attack cost<=4 set:seaside text:"+2 Actions"`.
+// Terms are separated by whitespace. Use double quotes for values that have whitespace.
+// A term without a field, e.g. `village`, is the same as `name:village`.
+// An empty query matches all cards.
+func ParseQuery(str string) (Query, error) {
+	words, err := splitWords(str)
+	if err != nil {
+		return nil, err
+	}
+	rv := make(Query, 0, len(words))
+	for _, word := range words {
+		term, err := ParseTerm(word)
+		if err != nil {
+			return nil, err
+		}
+		rv = append(rv, term)
+	}
+	return rv, nil
+}
+
+// ParseTerm parses a single (unquoted) query term, e.g. `cost<=4` or `-type:Attack`.
+func ParseTerm(word string) (Term, error) {
+	rv := Term{}
+	str := word
+	if len(str) > 1 && str[0] == '-' {
+		rv.Negate = true
+		str = str[1:]
+	}
+
+	opIndex := -1
+	for i := range str {
+		for _, op := range ops {
+			if strings.HasPrefix(str[i:], op) {
+				opIndex, rv.Op = i, op
+				break
+			}
+		}
+		if opIndex >= 0 {
+			break
+		}
+	}
+	if opIndex < 0 {
+		rv.Field, rv.Op, rv.Value = FieldName, ":", str
+		return rv, nil
+	}
+
+	var known bool
+	rv.Field, known = fieldNames[strings.ToLower(str[:opIndex])]
+	if !known {
+		return rv, fmt.Errorf("unknown field %q in %q: expected one of name, type, cost, set, text, category", str[:opIndex], word)
+	}
+	rv.Value = str[opIndex+len(rv.Op):]
+	if len(rv.Value) == 0 {
+		return rv, fmt.Errorf("no value provided in %q", word)
+	}
+	if rv.Field == FieldCost {
+		var err error
+		rv.cost, err = strconv.Atoi(strings.TrimPrefix(rv.Value, "$"))
+		if err != nil {
+			return rv, fmt.Errorf("invalid cost %q in %q: must be a whole number", rv.Value, word)
+		}
+	} else if rv.Op != ":" && rv.Op != "=" {
+		return rv, fmt.Errorf("invalid operator %q in %q: only cost can use <, <=, > or >=", rv.Op, word)
+	}
+	return rv, nil
+}
+
+// String gets the query string of this term.
+func (t Term) String() string {
+	rv := t.Field + t.Op + t.Value
+	if strings.ContainsFunc(t.Value, unicode.IsSpace) {
+		rv = t.Field + t.Op + `"` + t.Value + `"`
+	}
+	if t.Negate {
+		return "-" + rv
+	}
+	return rv
+}
+
+// Matches returns true if the provided card matches this term.
+func (t Term) Matches(card *cards.Card) bool {
+	return t.matches(card) != t.Negate
+}
+
+// matches returns true if the provided card matches this term, ignoring Negate.
+func (t Term) matches(card *cards.Card) bool {
+	switch t.Field {
+	case FieldName:
+		return matchString(card.Name, t.Value, t.Op == ":")
+	case FieldText:
+		return matchString(card.Description, t.Value, t.Op == ":")
+	case FieldType:
+		for _, cardType := range card.Types {
+			if strings.EqualFold(cardType, t.Value) {
+				return true
+			}
+		}
+		return false
+	case FieldSet:
+		return card.Expansion == cards.CardSet{Name: t.Value}.Key()
+	case FieldCategory:
+		return strings.EqualFold(card.Category, t.Value)
+	case FieldCost:
+		if card.CostInfo == nil {
+			return false
+		}
+		cost := randomizer.CardCost(card)
+		switch t.Op {
+		case "<":
+			return cost < t.cost
+		case "<=":
+			return cost <= t.cost
+		case ">":
+			return cost > t.cost
+		case ">=":
+			return cost >= t.cost
+		default:
+			return cost == t.cost
+		}
+	}
+	return false
+}
+
+// Matches returns true if the provided card matches all the terms in this query.
+func (q Query) Matches(card *cards.Card) bool {
+	for _, term := range q {
+		if !term.Matches(card) {
+			return false
+		}
+	}
+	return true
+}
+
+// String gets the query string of this query.
+func (q Query) String() string {
+	strs := make([]string, len(q))
+	for i, term := range q {
+		strs[i] = term.String()
+	}
+	return strings.Join(strs, " ")
+}
+
+// matchString returns true if the str equals the value (or contains it, if contains = true), ignoring case.
+func matchString(str, value string, contains bool) bool {
+	if contains {
+		return strings.Contains(strings.ToLower(str), strings.ToLower(value))
+	}
+	return strings.EqualFold(str, value)
+}
+
+// splitWords splits the provided string on whitespace, except where it's in double quotes.
+// The double quotes are removed.
+func splitWords(str string) ([]string, error) {
+	var rv []string
+	var word strings.Builder
+	inWord, inQuotes := false, false
+	for _, r := range str {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+			inWord = true
+		case unicode.IsSpace(r) && !inQuotes:
+			if inWord {
+				rv = append(rv, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+	if inQuotes {
+		return nil, fmt.Errorf("unclosed double quote in %q", str)
+	}
+	if inWord {
+		rv = append(rv, word.String())
+	}
+	return rv, nil
+}
@@ -0,0 +1,160 @@
+package search
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/SpicyLemon/dominion/cards"
+)
+
+func TestSplitWords(t *testing.T) {
+	tests := []struct {
+		str    string
+		exp    []string
+		expErr string
+	}{
+		{str: "", exp: nil},
+		{str: "   ", exp: nil},
+		{str: "village", exp: []string{"village"}},
+		{str: " types:Attack\tcost<=4 ", exp: []string{"types:Attack", "cost<=4"}},
+		{str: `text:"+2 Actions" set:seaside`, exp: []string{"text:+2 Actions", "set:seaside"}},
+		{str: `"name:Young Witch"`, exp: []string{"name:Young Witch"}},
+		{str: `text:"+2 Actions`, expErr: `unclosed double quote in "text:\"+2 Actions"`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.str, func(t *testing.T) {
+			act, err := splitWords(tc.str)
+			if len(tc.expErr) > 0 {
+				if err == nil || err.Error() != tc.expErr {
+					t.Fatalf("splitWords error = %v, expected %q", err, tc.expErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("splitWords unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(tc.exp, act) {
+				t.Errorf("splitWords = %q, expected %q", act, tc.exp)
+			}
+		})
+	}
+}
+
+func TestParseTerm(t *testing.T) {
+	tests := []struct {
+		word   string
+		exp    Term
+		expErr string
+	}{
+		{word: "village", exp: Term{Field: FieldName, Op: ":", Value: "village"}},
+		{word: "-village", exp: Term{Field: FieldName, Op: ":", Value: "village", Negate: true}},
+		{word: "-", exp: Term{Field: FieldName, Op: ":", Value: "-"}},
+		{word: "types:Attack", exp: Term{Field: FieldType, Op: ":", Value: "Attack"}},
+		{word: "-TYPE=Attack", exp: Term{Field: FieldType, Op: "=", Value: "Attack", Negate: true}},
+		{word: "cost<=4", exp: Term{Field: FieldCost, Op: "<=", Value: "4", cost: 4}},
+		{word: "cost>=$5", exp: Term{Field: FieldCost, Op: ">=", Value: "$5", cost: 5}},
+		{word: "cost<3", exp: Term{Field: FieldCost, Op: "<", Value: "3", cost: 3}},
+		{word: "cost>3", exp: Term{Field: FieldCost, Op: ">", Value: "3", cost: 3}},
+		{word: "cost:3", exp: Term{Field: FieldCost, Op: ":", Value: "3", cost: 3}},
+		{word: "expansion:dark-ages", exp: Term{Field: FieldSet, Op: ":", Value: "dark-ages"}},
+		{word: "text:+2 Actions", exp: Term{Field: FieldText, Op: ":", Value: "+2 Actions"}},
+		{word: "text:a:b", exp: Term{Field: FieldText, Op: ":", Value: "a:b"}},
+		{word: "category=landscape", exp: Term{Field: FieldCategory, Op: "=", Value: "landscape"}},
+		{word: "color:red", expErr: `unknown field "color" in "color:red": expected one of name, type, cost, set, text, category`},
+		{word: "name:", expErr: `no value provided in "name:"`},
+		{word: "cost<=four", expErr: `invalid cost "four" in "cost<=four": must be a whole number`},
+		{word: "name>=a", expErr: `invalid operator ">=" in "name>=a": only cost can use <, <=, > or >=`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.word, func(t *testing.T) {
+			act, err := ParseTerm(tc.word)
+			if len(tc.expErr) > 0 {
+				if err == nil || err.Error() != tc.expErr {
+					t.Fatalf("ParseTerm error = %v, expected %q", err, tc.expErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseTerm unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(tc.exp, act) {
+				t.Errorf("ParseTerm = %+v, expected %+v", act, tc.exp)
+			}
+		})
+	}
+}
+
+func TestQueryString(t *testing.T) {
+	query, err := ParseQuery(`village -types:Attack cost<=4 text:"+2 Actions"`)
+	if err != nil {
+		t.Fatalf("ParseQuery unexpected error: %v", err)
+	}
+	if exp, act := `name:village -type:Attack cost<=4 text:"+2 Actions"`, query.String(); exp != act {
+		t.Errorf("String() = %q, expected %q", act, exp)
+	}
+}
+
+func TestQueryMatches(t *testing.T) {
+	village := &cards.Card{
+		Name:        "Fishing Village",
+		Types:       []string{"Action", "Duration"},
+		Cost:        "$3",
+		CostInfo:    &cards.Cost{Coins: 3},
+		Description: "+2 Actions\n+$1\nAt the start of your next turn: +1 Action and +$1",
+		Expansion:   "seaside",
+		Category:    cards.CategoryKingdom,
+	}
+	debt := &cards.Card{
+		Name:        "Engineer",
+		Types:       []string{"Action"},
+		Cost:        "4Debt",
+		CostInfo:    &cards.Cost{Debt: 4},
+		Description: "Gain a card costing up to $4.",
+		Expansion:   "empires",
+		Category:    cards.CategoryKingdom,
+	}
+	noCost := &cards.Card{Name: "Tomb", Types: []string{"Landmark"}, Expansion: "empires", Category: cards.CategoryLandscape}
+
+	tests := []struct {
+		query string
+		exp   []string
+	}{
+		{query: "", exp: []string{"Fishing Village", "Engineer", "Tomb"}},
+		{query: "village", exp: []string{"Fishing Village"}},
+		{query: "-village", exp: []string{"Engineer", "Tomb"}},
+		{query: "name=village", exp: nil},
+		{query: `name="fishing village"`, exp: []string{"Fishing Village"}},
+		{query: "type:duration", exp: []string{"Fishing Village"}},
+		{query: "type:act", exp: nil},
+		{query: "types:Action cost<=3", exp: []string{"Fishing Village"}},
+		{query: "cost>=4", exp: []string{"Engineer"}},
+		{query: "cost:4", exp: []string{"Engineer"}},
+		{query: "-cost:4", exp: []string{"Fishing Village", "Tomb"}},
+		{query: "cost<10", exp: []string{"Fishing Village", "Engineer"}},
+		{query: "set:Seaside", exp: []string{"Fishing Village"}},
+		{query: `text:"+2 actions"`, exp: []string{"Fishing Village"}},
+		{query: `text="+2 actions"`, exp: nil},
+		{query: "category:landscape", exp: []string{"Tomb"}},
+		{query: "set:empires -category:landscape", exp: []string{"Engineer"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.query, func(t *testing.T) {
+			query, err := ParseQuery(tc.query)
+			if err != nil {
+				t.Fatalf("ParseQuery unexpected error: %v", err)
+			}
+			var act []string
+			for _, card := range []*cards.Card{village, debt, noCost} {
+				if query.Matches(card) {
+					act = append(act, card.Name)
+				}
+			}
+			if !reflect.DeepEqual(tc.exp, act) {
+				t.Errorf("matches = %q, expected %q", act, tc.exp)
+			}
+		})
+	}
+}
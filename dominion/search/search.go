@@ -0,0 +1,35 @@
+package search
+
+import (
+	"github.com/SpicyLemon/dominion/cards"
+	"github.com/SpicyLemon/dominion/randomizer"
+)
+
+// Result is a card found by a search.
+type Result struct {
+	*cards.Card
+	// SubCards are the cards that make up this card's pile, e.g. the Dame and Sir cards for "Knights".
+	// They're only included when expanding is requested.
+	SubCards []*cards.Card `json:"subCards,omitempty"`
+	// Needs are the names of the other cards (or groups of cards) needed when using this card, sorted.
+	// They come from extra_cards.json and include_if.json.
+	Needs []string `json:"needs,omitempty"`
+}
+
+// Find gets the cards in the provided pool that match the query.
+// They are in the same order as cards.All.Cards.
+// If expand is true, each result has its SubCards too.
+func Find(pool *randomizer.Pool, query Query, expand bool) []*Result {
+	rv := make([]*Result, 0)
+	for _, card := range pool.All.Cards() {
+		if !query.Matches(card) {
+			continue
+		}
+		result := &Result{Card: card, Needs: pool.AlsoNeedFor(card.Name)}
+		if expand {
+			result.SubCards = pool.All.SubCards(card)
+		}
+		rv = append(rv, result)
+	}
+	return rv
+}
@@ -0,0 +1,103 @@
+package search
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/SpicyLemon/dominion/cards"
+	"github.com/SpicyLemon/dominion/randomizer"
+)
+
+// resultNames gets the names of the provided results.
+func resultNames(results []*Result) []string {
+	rv := make([]string, len(results))
+	for i, result := range results {
+		rv[i] = result.Name
+	}
+	return rv
+}
+
+func TestFind(t *testing.T) {
+	all := cards.All{
+		"dark-ages": {
+			"kingdom": {Name: "Kingdom", Cards: []*cards.Card{
+				{Name: "Cultist", Types: []string{"Action", "Attack", "Looter"}, CostInfo: &cards.Cost{Coins: 5}, Expansion: "dark-ages", Category: cards.CategoryKingdom},
+				{Name: "Knights", Types: []string{"Action", "Attack", "Knight"}, CostInfo: &cards.Cost{Coins: 5}, Expansion: "dark-ages", Category: cards.CategoryKingdom},
+				{Name: "Poor House", Types: []string{"Action"}, CostInfo: &cards.Cost{Coins: 1}, Expansion: "dark-ages", Category: cards.CategoryKingdom},
+			}},
+			"knights": {Name: "Knights", Cards: []*cards.Card{
+				{Name: "Dame Anna", Types: []string{"Action", "Attack", "Knight"}, CostInfo: &cards.Cost{Coins: 5}, Expansion: "dark-ages", Category: cards.CategoryOther},
+				{Name: "Sir Martin", Types: []string{"Action", "Attack", "Knight"}, CostInfo: &cards.Cost{Coins: 4}, Expansion: "dark-ages", Category: cards.CategoryOther},
+			}},
+		},
+	}
+	pool, err := randomizer.NewPool(all, nil, map[string][]string{"Ruins": {"Cultist"}})
+	if err != nil {
+		t.Fatalf("NewPool unexpected error: %v", err)
+	}
+
+	t.Run("no expand", func(t *testing.T) {
+		results := Find(pool, Query{{Field: FieldType, Op: ":", Value: "Attack"}}, false)
+		if exp, act := []string{"Cultist", "Knights", "Dame Anna", "Sir Martin"}, resultNames(results); !reflect.DeepEqual(exp, act) {
+			t.Fatalf("Find names = %q, expected %q", act, exp)
+		}
+		if exp := []string{"Ruins"}; !reflect.DeepEqual(exp, results[0].Needs) {
+			t.Errorf("Cultist Needs = %q, expected %q", results[0].Needs, exp)
+		}
+		for _, result := range results {
+			if result.SubCards != nil {
+				t.Errorf("%s SubCards = %v, expected nil", result.Name, result.SubCards)
+			}
+		}
+	})
+
+	t.Run("expand", func(t *testing.T) {
+		query, err := ParseQuery("category:kingdom")
+		if err != nil {
+			t.Fatalf("ParseQuery unexpected error: %v", err)
+		}
+		results := Find(pool, query, true)
+		if exp, act := []string{"Cultist", "Knights", "Poor House"}, resultNames(results); !reflect.DeepEqual(exp, act) {
+			t.Fatalf("Find names = %q, expected %q", act, exp)
+		}
+		if exp, act := all["dark-ages"]["knights"].Cards, results[1].SubCards; !reflect.DeepEqual(exp, act) {
+			t.Errorf("Knights SubCards = %v, expected %v", act, exp)
+		}
+		if len(results[0].SubCards) != 0 || len(results[2].SubCards) != 0 {
+			t.Errorf("only the Knights should have SubCards")
+		}
+	})
+
+	t.Run("none found", func(t *testing.T) {
+		results := Find(pool, Query{{Field: FieldSet, Op: ":", Value: "seaside"}}, true)
+		if results == nil || len(results) != 0 {
+			t.Errorf("Find = %v, expected an empty slice", results)
+		}
+	})
+}
+
+func TestFindRepoData(t *testing.T) {
+	pool, err := randomizer.LoadPool(filepath.Join("..", "json"))
+	if err != nil {
+		t.Fatalf("LoadPool unexpected error: %v", err)
+	}
+	query, err := ParseQuery(`types:Attack cost<=4 set:seaside`)
+	if err != nil {
+		t.Fatalf("ParseQuery unexpected error: %v", err)
+	}
+	results := Find(pool, query, false)
+	if len(results) == 0 {
+		t.Fatalf("no seaside attacks costing $4 or less found")
+	}
+	for _, result := range results {
+		if !query.Matches(result.Card) {
+			t.Errorf("%s doesn't match the query", result.Name)
+		}
+	}
+
+	results = Find(pool, Query{{Field: FieldName, Op: "=", Value: "Augurs"}}, true)
+	if len(results) != 1 || len(results[0].SubCards) != 4 {
+		t.Errorf("Augurs results = %v, expected one with 4 sub-cards", results)
+	}
+}
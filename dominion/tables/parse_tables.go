@@ -1,17 +1,27 @@
 package main
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
+	"unicode"
+
+	"golang.org/x/net/html"
+
+	"github.com/SpicyLemon/dominion/cards"
 )
 
 func PrintUsage() {
-	fmt.Printf(`Usage: parse_tables.go [<source dir> [<dest dir>]
+	fmt.Printf(`Usage: parse_tables.go [--check] [--page <html file>] [<source dir> [<dest dir>]]
 
 Parses the cleaned html tables into JSON.
 
@@ -22,31 +32,27 @@ Default <dest dir> is the <source dir>.
 To provide a <dest dir> you must first provide a <source dir>.
 
 Output is one .json file for each .html file plus one .json file containing everything.
-`)
-}
+Minified versions of all.json, extra_cards.json and include_if.json are also written to the <dest dir>,
+e.g. all.min.json. Files are only written if their contents have changed.
 
-const notInTag = -1
+With --page, the minified JSON is also put into the provided html file (i.e. the randomizer page).
+Each place to put one has a pair of marker comments, e.g.
+    // BEGIN GENERATED allCards = all.min.json
+    let allCards = ...;
+    // END GENERATED allCards
+Everything between the markers is replaced with the variable being set to that file's minified JSON.
 
-type Card struct {
-	Name        string   `json:"name"`
-	Types       []string `json:"types"`
-	Cost        string   `json:"cost"`
-	Description string   `json:"description"`
-}
-
-type CardSet struct {
-	Name  string  `json:"name"`
-	Info  string  `json:"info"`
-	Cards []*Card `json:"cards"`
+With --check, no JSON is written. Instead, the html files are checked against the
+formatting rules (see dominion-maintenance.txt) and a file:line diagnostic is printed for each problem.
+The card names in the include_if.json and extra_cards.json files in the <dest dir> are also checked
+against the parsed cards. With --check, the default <dest dir> is '../json' relative to the <source dir>.
+`)
 }
 
-var cleanRx = regexp.MustCompile(`[^a-zA-Z0-9.]+`)
+// bundleNames are the base names of the JSON files that get minified for the page.
+var bundleNames = []string{"all", "extra_cards", "include_if"}
 
-func (s CardSet) Key() string {
-	return cleanRx.ReplaceAllString(strings.ToLower(s.Name), "-")
-}
-
-func ConvertDir(sourceDir, destDir string) error {
+func ConvertDir(sourceDir, destDir, pageFile string) error {
 	files, err := GetHtmlFiles(sourceDir)
 	if err != nil {
 		return err
@@ -56,18 +62,28 @@ func ConvertDir(sourceDir, destDir string) error {
 	}
 	all := make(map[string]json.RawMessage)
 	for _, filename := range files {
-		contents, err := ParseFile(filename)
+		game := GetFilenameBase(filename)
+		contents, err := ParseFile(filename, game)
 		if err != nil {
 			return fmt.Errorf("error parsing %q: %w", filename, err)
 		}
-		game := GetFilenameBase(filename)
 		bz, err := WriteFile(destDir, game, contents)
 		if err != nil {
 			return err
 		}
 		all[game] = bz
 	}
-	_, err = WriteFile(destDir, "all", all)
+	if _, err = WriteFile(destDir, "all", all); err != nil {
+		return err
+	}
+
+	bundles, err := WriteBundles(destDir)
+	if err != nil {
+		return err
+	}
+	if len(pageFile) > 0 {
+		return UpdatePage(pageFile, bundles)
+	}
 	return nil
 }
 
@@ -77,37 +93,136 @@ func WriteFile(destDir, game string, contents map[string]json.RawMessage) ([]byt
 	if err != nil {
 		return nil, fmt.Errorf("error creating JSON for %q: %w", filename, err)
 	}
-	err = os.WriteFile(filename, bz, 0644)
-	if err != nil {
-		return nil, fmt.Errorf("error writing %q: %w", filename, err)
+	if err = WriteIfChanged(filename, bz); err != nil {
+		return nil, err
 	}
-	fmt.Printf("File created: %s\n", filename)
 	return bz, nil
 }
 
-func ParseFile(filename string) (map[string]json.RawMessage, error) {
-	contents, err := os.ReadFile(filename)
+// WriteIfChanged writes the contents to the provided file unless the file already has those exact contents.
+// This keeps the file's modification time (and the git status) clean when nothing has actually changed.
+func WriteIfChanged(filename string, contents []byte) error {
+	existing, err := os.ReadFile(filename)
+	if err == nil && sha256.Sum256(existing) == sha256.Sum256(contents) {
+		fmt.Printf("File unchanged: %s\n", filename)
+		return nil
+	}
+	if err = os.WriteFile(filename, contents, 0644); err != nil {
+		return fmt.Errorf("error writing %q: %w", filename, err)
+	}
+	fmt.Printf("File created: %s\n", filename)
+	return nil
+}
+
+// WriteBundles creates the minified versions of the bundleNames JSON files in the provided dir,
+// e.g. all.json -> all.min.json. Missing files are skipped.
+// The returned map has the minified JSON of each, keyed by the minified filename (without the dir).
+func WriteBundles(dir string) (map[string][]byte, error) {
+	rv := make(map[string][]byte)
+	for _, name := range bundleNames {
+		filename := filepath.Join(dir, name+".json")
+		contents, err := os.ReadFile(filename)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				fmt.Printf("File not found, no minified version created: %s\n", filename)
+				continue
+			}
+			return nil, err
+		}
+		var bz bytes.Buffer
+		if err = json.Compact(&bz, contents); err != nil {
+			return nil, fmt.Errorf("error minifying %q: %w", filename, err)
+		}
+		minName := name + ".min.json"
+		if err = WriteIfChanged(filepath.Join(dir, minName), bz.Bytes()); err != nil {
+			return nil, err
+		}
+		rv[minName] = bz.Bytes()
+	}
+	return rv, nil
+}
+
+// UpdatePage puts the provided minified bundles into the html file (see SplicePage), and writes it if it changed.
+func UpdatePage(pageFile string, bundles map[string][]byte) error {
+	contents, err := os.ReadFile(pageFile)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	lines := strings.Split(string(contents), "\n")
-	fmt.Printf("%s has %d lines.\n", filename, len(lines))
-	tables, err := SplitTables(lines)
+	updated, err := SplicePage(contents, bundles)
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("error updating %q: %w", pageFile, err)
 	}
-	fmt.Printf("%s has %d tables.\n", filename, len(tables))
-	var cardSets []*CardSet
-	for i, table := range tables {
-		cardSet, err := ParseTable(table)
-		if err != nil {
-			return nil, fmt.Errorf("error parsing table %d: %w", i+1, err)
+	return WriteIfChanged(pageFile, updated)
+}
+
+var (
+	beginMarkerRx = regexp.MustCompile(`^(\s*)// BEGIN GENERATED ([A-Za-z_$][A-Za-z0-9_$]*) = (\S+)\s*$`)
+	endMarkerRx   = regexp.MustCompile(`^\s*// END GENERATED ([A-Za-z_$][A-Za-z0-9_$]*)\s*$`)
+)
+
+// SplicePage replaces the lines between each pair of marker comments in the page's contents with a line that sets
+// the variable named in the markers to the bundle named in the begin marker. E.g. with these lines:
+//
+//	// BEGIN GENERATED allCards = all.min.json
+//	let allCards = {...};
+//	// END GENERATED allCards
+//
+// The middle line becomes `let allCards = <bundles["all.min.json"]>;` (with the begin marker's indentation).
+// The bundles are keyed by filename, e.g. "all.min.json". It is an error for a marker to name an unknown bundle.
+func SplicePage(contents []byte, bundles map[string][]byte) ([]byte, error) {
+	lines := strings.Split(string(contents), "\n")
+	rv := make([]string, 0, len(lines))
+	found := 0
+	for i := 0; i < len(lines); i++ {
+		rv = append(rv, lines[i])
+		if endMarkerRx.MatchString(lines[i]) {
+			return nil, fmt.Errorf("line %d: end marker without a begin marker", i+1)
 		}
-		if cardSet.Name == "" {
-			cardSet.Name = "Kingdom"
+		begin := beginMarkerRx.FindStringSubmatch(lines[i])
+		if begin == nil {
+			continue
 		}
-		cardSets = append(cardSets, cardSet)
+		indent, varName, bundleName := begin[1], begin[2], begin[3]
+		bundle, ok := bundles[bundleName]
+		if !ok {
+			return nil, fmt.Errorf("line %d: unknown bundle %q", i+1, bundleName)
+		}
+		end := i + 1
+		for ; end < len(lines); end++ {
+			if beginMarkerRx.MatchString(lines[end]) {
+				return nil, fmt.Errorf("line %d: begin marker found before the end marker for %s (from line %d)", end+1, varName, i+1)
+			}
+			if m := endMarkerRx.FindStringSubmatch(lines[end]); m != nil {
+				if m[1] != varName {
+					return nil, fmt.Errorf("line %d: end marker for %s found, expected one for %s (from line %d)", end+1, m[1], varName, i+1)
+				}
+				break
+			}
+		}
+		if end >= len(lines) {
+			return nil, fmt.Errorf("line %d: no end marker found for %s", i+1, varName)
+		}
+		rv = append(rv, indent+"let "+varName+" = "+string(bundle)+";", lines[end])
+		i = end
+		found++
+	}
+	if found == 0 {
+		return nil, fmt.Errorf("no BEGIN GENERATED markers found")
 	}
+	return []byte(strings.Join(rv, "\n")), nil
+}
+
+func ParseFile(filename, expansion string) (map[string]json.RawMessage, error) {
+	contents, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Printf("%s has %d lines.\n", filename, bytes.Count(contents, []byte("\n"))+1)
+	cardSets, err := ParseCardSets(contents, expansion)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Printf("%s has %d tables.\n", filename, len(cardSets))
 	rv := make(map[string]json.RawMessage)
 	for i, cardSet := range cardSets {
 		bz, err := json.MarshalIndent(cardSet, "", "  ")
@@ -119,119 +234,647 @@ func ParseFile(filename string) (map[string]json.RawMessage, error) {
 	return rv, nil
 }
 
-func ParseTable(lines []string) (*CardSet, error) {
-	if len(lines) < 2 {
-		return nil, fmt.Errorf("unknown table contents: %q", strings.Join(lines, "\n"))
-	}
-	rv := &CardSet{}
-	if strings.HasPrefix(lines[0], "<!--") && strings.HasSuffix(lines[0], "-->") {
-		rv.Name = strings.TrimSpace(strings.TrimPrefix(strings.TrimSuffix(lines[0], "-->"), "<!--"))
-		lines = lines[1:]
-	}
-	if strings.HasPrefix(lines[0], "<!--") && strings.HasSuffix(lines[0], "-->") {
-		rv.Info = strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(lines[0], "<!--"), "-->"))
-		lines = lines[1:]
-	}
-	rows, err := SplitRows(lines)
+// ParseCardSets parses the contents of an html file into the card sets of the provided expansion.
+func ParseCardSets(contents []byte, expansion string) ([]*cards.CardSet, error) {
+	rv, err := ParseTables(bytes.NewReader(contents))
 	if err != nil {
 		return nil, err
 	}
-	for i, row := range rows {
-		card, err := ParseRow(row)
-		if err != nil {
-			return nil, fmt.Errorf("error parsing row %d: %w", i+1, err)
+	for _, cardSet := range rv {
+		if cardSet.Name == "" {
+			cardSet.Name = "Kingdom"
+		}
+		key := cardSet.Key()
+		for _, card := range cardSet.Cards {
+			card.Expansion = expansion
+			card.Category = cards.GetCategory(key, card)
 		}
-		rv.Cards = append(rv.Cards, card)
 	}
 	return rv, nil
 }
 
-func ParseRow(lines []string) (*Card, error) {
-	if len(lines) < 4 {
-		return nil, fmt.Errorf("not enough lines (%d) to be a card: %q", len(lines), strings.Join(lines, "\n"))
+// brMarker is put in a cell's contents in place of a <br> tag.
+const brMarker = "\x00"
+
+// tableParser has the state of the tables being parsed by ParseTables.
+type tableParser struct {
+	// line is the (one based) line number of the current token.
+	line int
+	// tables are the finished tables.
+	tables []*cards.CardSet
+	// table is the table currently being parsed, or nil if not in a table.
+	table *cards.CardSet
+	// comments is the number of comments found in the current table before its first row.
+	comments int
+	// hadRow is true once the current table has had a row.
+	hadRow bool
+	// row has the cells of the current row, or is nil if not in a row.
+	row []string
+	// rowLine is the line that the current row started on.
+	rowLine int
+	// isHeader is true if the current row has any <th> cells.
+	isHeader bool
+	// cell has the contents of the current cell, or is nil if not in a cell.
+	cell *strings.Builder
+}
+
+// ParseTables parses all the html tables in the provided reader.
+//
+// The first comment in a table (before any rows) is the table's name, and the second is its info.
+// Each row must have four cells: name, types (delimited by "--"), cost, and description.
+// Rows with any <th> cells are headers, and are ignored.
+//
+// Attributes and whitespace around the tags are ignored. In a cell, leading and trailing whitespace is
+// removed from each line, and tags are ignored (but their text is kept). Lines are normally split on the
+// new lines in the html, but if a cell has any <br> tags, those are the line breaks instead, and the new
+// lines are just whitespace.
+func ParseTables(r io.Reader) ([]*cards.CardSet, error) {
+	p := &tableParser{line: 1}
+	z := html.NewTokenizer(r)
+	for {
+		tt := z.Next()
+		// The line needs to be counted before anything else is done with the token since they can change the raw bytes.
+		line := p.line
+		p.line += bytes.Count(z.Raw(), []byte("\n"))
+		if err := p.handleToken(z, tt); err != nil {
+			if errors.Is(err, io.EOF) {
+				return p.tables, nil
+			}
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+}
+
+// handleToken updates the parser state with the current token of the provided tokenizer.
+// Returns io.EOF once there's nothing left to parse.
+func (p *tableParser) handleToken(z *html.Tokenizer, tt html.TokenType) error {
+	switch tt {
+	case html.ErrorToken:
+		if errors.Is(z.Err(), io.EOF) && p.table != nil {
+			return fmt.Errorf("table open at the end of the file")
+		}
+		return z.Err()
+	case html.CommentToken:
+		if p.table != nil && !p.hadRow {
+			text := strings.TrimSpace(string(z.Text()))
+			switch p.comments {
+			case 0:
+				p.table.Name = text
+			case 1:
+				p.table.Info = text
+			}
+			p.comments++
+		}
+	case html.TextToken:
+		text := z.Text()
+		switch {
+		case p.cell != nil:
+			p.cell.Write(text)
+		case len(bytes.TrimSpace(text)) > 0:
+			return fmt.Errorf("unexpected text outside of a table cell: %q", strings.TrimSpace(string(text)))
+		}
+	case html.StartTagToken, html.SelfClosingTagToken:
+		name, _ := z.TagName()
+		return p.startTag(string(name))
+	case html.EndTagToken:
+		name, _ := z.TagName()
+		return p.endTag(string(name))
+	}
+	return nil
+}
+
+// startTag updates the parser state for an opening tag with the provided name.
+func (p *tableParser) startTag(name string) error {
+	switch name {
+	case "table":
+		if p.table != nil {
+			return fmt.Errorf("<table> found but the previous table wasn't closed")
+		}
+		p.table = &cards.CardSet{SchemaVersion: cards.SchemaVersion}
+		p.comments, p.hadRow = 0, false
+	case "tr":
+		switch {
+		case p.table == nil:
+			return fmt.Errorf("<tr> found outside of a table")
+		case p.row != nil:
+			return fmt.Errorf("<tr> found but the previous row wasn't closed")
+		}
+		p.row, p.rowLine, p.isHeader, p.hadRow = make([]string, 0, 4), p.line, false, true
+	case "td", "th":
+		switch {
+		case p.row == nil:
+			return fmt.Errorf("<%s> found outside of a row", name)
+		case p.cell != nil:
+			return fmt.Errorf("<%s> found but the previous cell wasn't closed", name)
+		}
+		p.cell = &strings.Builder{}
+		p.isHeader = p.isHeader || name == "th"
+	case "br":
+		if p.cell != nil {
+			p.cell.WriteString(brMarker)
+		}
+	case "thead", "tbody", "tfoot", "colgroup", "col", "caption":
+		// These are allowed, but don't mean anything here.
+	default:
+		// Other tags are fine outside of tables, and in cells, but there shouldn't be any others in the table structure.
+		if p.table != nil && p.cell == nil {
+			return fmt.Errorf("unexpected <%s> outside of a table cell", name)
+		}
+	}
+	return nil
+}
+
+// endTag updates the parser state for a closing tag with the provided name.
+func (p *tableParser) endTag(name string) error {
+	switch name {
+	case "td", "th":
+		if p.cell == nil {
+			return fmt.Errorf("</%s> found without an open cell", name)
+		}
+		contents := p.cell.String()
+		if strings.Contains(contents, brMarker) {
+			// When a cell uses <br> for its line breaks, the new lines in it are just whitespace.
+			lines := strings.Split(contents, brMarker)
+			for i, line := range lines {
+				lines[i] = strings.Join(strings.Fields(line), " ")
+			}
+			contents = strings.Join(lines, "\n")
+		}
+		p.row = append(p.row, CleanCell(contents))
+		p.cell = nil
+	case "tr":
+		switch {
+		case p.row == nil:
+			return fmt.Errorf("</tr> found without an open row")
+		case p.cell != nil:
+			return fmt.Errorf("</tr> found but the last cell wasn't closed")
+		}
+		if !p.isHeader {
+			card, err := ParseRow(p.row)
+			if err != nil {
+				return fmt.Errorf("error parsing row starting on line %d: %w", p.rowLine, err)
+			}
+			p.table.Cards = append(p.table.Cards, card)
+		}
+		p.row = nil
+	case "table":
+		switch {
+		case p.table == nil:
+			return fmt.Errorf("</table> found without an open table")
+		case p.row != nil:
+			return fmt.Errorf("</table> found but the last row wasn't closed")
+		}
+		p.tables = append(p.tables, p.table)
+		p.table = nil
+	}
+	return nil
+}
+
+// CleanCell removes the leading and trailing whitespace from each line of a cell's contents,
+// and any empty lines at the start or end.
+func CleanCell(contents string) string {
+	lines := strings.Split(contents, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
 	}
-	rv := &Card{}
-	if !strings.HasPrefix(lines[0], "<td>") || !strings.HasSuffix(lines[0], "</td>") {
-		return nil, fmt.Errorf("Unknown row line, expecting name: %q", lines[0])
+	return strings.Trim(strings.Join(lines, "\n"), "\n")
+}
+
+// ParseRow creates a card from the (cleaned) cells of a row: name, types, cost, description.
+func ParseRow(cells []string) (*cards.Card, error) {
+	if len(cells) != 4 {
+		return nil, fmt.Errorf("row has %d cells, expected 4: name, types, cost, description", len(cells))
 	}
-	rv.Name = strings.TrimSuffix(strings.TrimPrefix(lines[0], "<td>"), "</td>")
-	lines = lines[1:]
-	if !strings.HasPrefix(lines[0], "<td>") || !strings.HasSuffix(lines[0], "</td>") {
-		return nil, fmt.Errorf("Unknown row line, expecting types: %q", lines[0])
+	rv := &cards.Card{
+		Name:        strings.Join(strings.Fields(cells[0]), " "),
+		Cost:        strings.Join(strings.Fields(cells[2]), " "),
+		Description: cells[3],
 	}
-	rv.Types = strings.Split(strings.TrimSuffix(strings.TrimPrefix(lines[0], "<td>"), "</td>"), " -- ")
-	lines = lines[1:]
-	if !strings.HasPrefix(lines[0], "<td>") || !strings.HasSuffix(lines[0], "</td>") {
-		return nil, fmt.Errorf("Unknown row line, expecting cost: %q", lines[0])
+	for _, t := range strings.Split(cells[1], "--") {
+		rv.Types = append(rv.Types, strings.TrimSpace(t))
 	}
-	rv.Cost = strings.TrimSuffix(strings.TrimPrefix(lines[0], "<td>"), "</td>")
-	lines = lines[1:]
-	final := strings.Join(lines, "\n")
-	if !strings.HasPrefix(final, "<td>") || !strings.HasSuffix(final, "</td>") {
-		return nil, fmt.Errorf("Unknown row lines, expecting description: %q", final)
+	var err error
+	rv.CostInfo, err = cards.ParseCost(rv.Cost)
+	if err != nil {
+		return nil, fmt.Errorf("card %q: %w", rv.Name, err)
 	}
-	rv.Description = strings.TrimSuffix(strings.TrimPrefix(final, "<td>"), "</td>")
 	return rv, nil
 }
 
-func SplitRows(lines []string) ([][]string, error) {
-	var rv [][]string
-	firstLine := notInTag
-	for i, line := range lines {
-		if line == "" {
+// A Diagnostic is a problem found in a file when checking it.
+type Diagnostic struct {
+	Filename string
+	// Line is the (one based) line number of the problem, or 0 if it's about the whole file.
+	Line    int
+	Message string
+}
+
+// String returns this Diagnostic in the standard "file:line: message" format.
+func (d Diagnostic) String() string {
+	if d.Line == 0 {
+		return fmt.Sprintf("%s: %s", d.Filename, d.Message)
+	}
+	return fmt.Sprintf("%s:%d: %s", d.Filename, d.Line, d.Message)
+}
+
+// lowercaseNameWords are the words that are not capitalized in a card name (unless they're the first word).
+var lowercaseNameWords = map[string]bool{
+	"the": true,
+	"and": true,
+	"in":  true,
+	"of":  true,
+}
+
+// commonTypeWords are card types that are also common words, so they're allowed to be lowercase in descriptions.
+var commonTypeWords = map[string]bool{
+	"command":   true,
+	"doom":      true,
+	"fate":      true,
+	"gathering": true,
+	"night":     true,
+	"reserve":   true,
+	"spirit":    true,
+	"state":     true,
+	"way":       true,
+}
+
+// basicCardNames are the cards that are in every game, so they aren't in the tables.
+var basicCardNames = map[string]bool{
+	"Copper":   true,
+	"Silver":   true,
+	"Gold":     true,
+	"Estate":   true,
+	"Duchy":    true,
+	"Province": true,
+	"Curse":    true,
+}
+
+// CardSeparator is the line used to separate the parts of a description.
+const CardSeparator = "----------"
+
+// define some regex for checking descriptions.
+var (
+	wordRx          = regexp.MustCompile(`[A-Za-z]+`)
+	separatorRx     = regexp.MustCompile(`^[-_=]{3,}$`)
+	spaceAfterPlus  = regexp.MustCompile(`\+ [$0-9]|\$ [0-9]`)
+	spaceBeforeUnit = regexp.MustCompile(`[0-9] (VP|Debt)\b`)
+	noSpaceAfterNum = regexp.MustCompile(`\+\$?[0-9]+([A-Za-z]+)`)
+	nonSentenceRx   = regexp.MustCompile(`^\+\$?[0-9]+(VP|Debt)?( [A-Za-z]+)?\.$`)
+	typeRx          = regexp.MustCompile(`^[A-Za-z]+$`)
+)
+
+// CheckDir checks all the .html files in the sourceDir against the formatting rules, printing a diagnostic for each problem.
+// The card names in the include_if.json and extra_cards.json files in the jsonDir are also checked against the parsed cards.
+// No JSON is written. An error is returned if any problems are found, or if neither of those json files exist.
+func CheckDir(sourceDir, jsonDir string) error {
+	files, err := GetHtmlFiles(sourceDir)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no html files found in %q", sourceDir)
+	}
+
+	// Parse everything first to get the known card names and types.
+	fileContents := make(map[string][]byte)
+	knownNames := make(map[string]bool)
+	typeWords := make(map[string]bool)
+	var diags []Diagnostic
+	allParsed := true
+	for _, filename := range files {
+		contents, err := os.ReadFile(filename)
+		if err != nil {
+			return err
+		}
+		fileContents[filename] = contents
+		cardSets, err := ParseCardSets(contents, GetFilenameBase(filename))
+		if err != nil {
+			allParsed = false
 			continue
 		}
-		if firstLine == notInTag {
-			if line == "<tr>" {
-				firstLine = i + 1
-			} else {
-				return nil, fmt.Errorf("Table line %d is %q but should be an opening row tag.", i, line)
+		for _, cardSet := range cardSets {
+			knownNames[cardSet.Name] = true
+			for _, card := range cardSet.Cards {
+				knownNames[card.Name] = true
+				for _, t := range card.Types {
+					typeWords[strings.ToLower(t)] = true
+				}
 			}
-		} else {
-			if line == "<tr>" {
-				return nil, fmt.Errorf("Table line %d is %q but the previous row wasn't clsoed.", i, line)
+		}
+	}
+	for word := range commonTypeWords {
+		delete(typeWords, word)
+	}
+
+	for _, filename := range files {
+		lines := strings.Split(string(fileContents[filename]), "\n")
+		fileDiags := CheckLines(filename, lines, typeWords)
+		if len(fileDiags) == 0 {
+			// Make sure anything the parser doesn't like is reported too.
+			if _, err = ParseCardSets(fileContents[filename], GetFilenameBase(filename)); err != nil {
+				fileDiags = append(fileDiags, Diagnostic{Filename: filename, Message: err.Error()})
 			}
-			if line == "</tr>" {
-				rv = append(rv, lines[firstLine:i])
-				firstLine = notInTag
+		}
+		diags = append(diags, fileDiags...)
+	}
+
+	if allParsed {
+		for name := range basicCardNames {
+			knownNames[name] = true
+		}
+		found := 0
+		for _, game := range []string{"include_if", "extra_cards"} {
+			filename := filepath.Join(jsonDir, game+".json")
+			nameDiags, err := CheckCardNamesFile(filename, knownNames)
+			if err != nil {
+				if errors.Is(err, os.ErrNotExist) {
+					fmt.Printf("Skipping %s: file not found.\n", filename)
+					continue
+				}
+				return err
 			}
+			found++
+			diags = append(diags, nameDiags...)
 		}
+		if found == 0 {
+			return fmt.Errorf("neither include_if.json nor extra_cards.json found in %q", jsonDir)
+		}
+	} else {
+		fmt.Printf("Skipping card name checks since not all files could be parsed.\n")
+	}
+
+	for _, diag := range diags {
+		fmt.Println(diag)
 	}
-	if firstLine != notInTag {
-		return nil, fmt.Errorf("Row open at the end of the table.")
+	if len(diags) > 0 {
+		return fmt.Errorf("found %d problem(s)", len(diags))
 	}
-	return rv, nil
+	fmt.Printf("No problems found in %d files.\n", len(files))
+	return nil
+}
+
+// checkCell is a <td> of a row being checked.
+type checkCell struct {
+	// line is the (one based) line number that the cell starts on.
+	line int
+	// lines are the contents of the cell, without the <td> and </td>.
+	lines []string
 }
 
-func SplitTables(lines []string) ([][]string, error) {
-	var rv [][]string
-	firstLine := notInTag
+// CheckLines checks the lines of an html file against the formatting rules.
+// The typeWords are the lowercased card types that should be capitalized in descriptions.
+func CheckLines(filename string, lines []string, typeWords map[string]bool) []Diagnostic {
+	var rv []Diagnostic
+	add := func(line int, format string, args ...interface{}) {
+		rv = append(rv, Diagnostic{Filename: filename, Line: line, Message: fmt.Sprintf(format, args...)})
+	}
+
+	inTable, inRow, inCell := false, false, false
+	tableLine, rowLine := 0, 0
+	setKey := ""
+	var cells []*checkCell
 	for i, line := range lines {
-		if line == "" {
+		num := i + 1
+		if trimmed := strings.TrimLeft(line, " \t"); trimmed != line {
+			add(num, "leading whitespace")
+			line = trimmed
+		}
+		if trimmed := strings.TrimRight(line, " \t\r"); trimmed != line {
+			add(num, "trailing whitespace")
+			line = trimmed
+		}
+
+		switch {
+		case !inTable:
+			switch line {
+			case "":
+			case "<table>":
+				inTable, tableLine, setKey = true, num, "kingdom"
+			default:
+				add(num, "expected <table> but found %q", line)
+			}
+		case inCell:
+			cell := cells[len(cells)-1]
+			cell.lines = append(cell.lines, strings.TrimSuffix(line, "</td>"))
+			inCell = !strings.HasSuffix(line, "</td>")
+			if !inCell && line == "</td>" && len(cells) == 4 {
+				add(num, "</td> should be on the same line as the last of the description")
+			}
+		case inRow:
+			switch {
+			case line == "</tr>":
+				rv = append(rv, checkRow(filename, rowLine, setKey, cells, typeWords)...)
+				inRow = false
+			case line == "":
+				add(num, "empty line in a row")
+			case strings.HasPrefix(line, "<td>"):
+				content := strings.TrimPrefix(line, "<td>")
+				cells = append(cells, &checkCell{line: num, lines: []string{strings.TrimSuffix(content, "</td>")}})
+				inCell = !strings.HasSuffix(content, "</td>")
+			default:
+				add(num, "expected <td> or </tr> but found %q", line)
+			}
+		default:
+			switch {
+			case line == "":
+			case line == "</table>":
+				inTable = false
+			case line == "<tr>":
+				inRow, rowLine, cells = true, num, nil
+			case strings.HasPrefix(line, "<!--") && strings.HasSuffix(line, "-->"):
+				if num > tableLine+2 {
+					add(num, "comments are only allowed on the two lines after <table>")
+				} else if num == tableLine+1 {
+					setKey = cards.CardSet{Name: strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(line, "<!--"), "-->"))}.Key()
+				}
+			default:
+				add(num, "expected <tr> or </table> but found %q", line)
+			}
+		}
+	}
+
+	switch {
+	case inCell:
+		add(cells[len(cells)-1].line, "<td> is never closed")
+	case inRow:
+		add(rowLine, "<tr> is never closed")
+	case inTable:
+		add(tableLine, "<table> is never closed")
+	}
+	return rv
+}
+
+// checkRow checks the cells of a row that starts on the provided line.
+func checkRow(filename string, rowLine int, setKey string, cells []*checkCell, typeWords map[string]bool) []Diagnostic {
+	var rv []Diagnostic
+	add := func(line int, format string, args ...interface{}) {
+		rv = append(rv, Diagnostic{Filename: filename, Line: line, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if len(cells) != 4 {
+		add(rowLine, "row has %d cells, expected 4: name, types, cost, description", len(cells))
+		return rv
+	}
+	for i, what := range []string{"name", "types", "cost"} {
+		if len(cells[i].lines) != 1 {
+			add(cells[i].line, "the %s must be on a single line", what)
+			return rv
+		}
+	}
+
+	name, nameLine := cells[0].lines[0], cells[0].line
+	for i, word := range strings.Split(name, " ") {
+		switch {
+		case len(word) == 0:
+			add(nameLine, "name %q has extra spaces", name)
+		case i > 0 && lowercaseNameWords[word]:
+		case i > 0 && lowercaseNameWords[strings.ToLower(word)]:
+			add(nameLine, "name %q should not capitalize %q", name, word)
+		case unicode.IsLower([]rune(word)[0]):
+			add(nameLine, "name %q should capitalize %q", name, word)
+		}
+	}
+
+	types, typesLine := cells[1].lines[0], cells[1].line
+	for _, t := range strings.Split(types, " -- ") {
+		switch {
+		case !typeRx.MatchString(t):
+			add(typesLine, "types %q should be delimited by \" -- \"", types)
+		case unicode.IsLower([]rune(t)[0]):
+			add(typesLine, "type %q should be capitalized", t)
+		}
+	}
+
+	cost, costLine := cells[2].lines[0], cells[2].line
+	costInfo, err := cards.ParseCost(cost)
+	switch {
+	case err != nil:
+		add(costLine, "%v", err)
+	case costInfo != nil && costInfo.NonSupply && cards.IsKingdomCardSet(setKey):
+		add(costLine, "kingdom card cost %q should not have an asterisk", cost)
+	}
+
+	for i, line := range cells[3].lines {
+		num := cells[3].line + i
+		switch {
+		case line == "":
+			add(num, "empty line in description")
+			continue
+		case separatorRx.MatchString(line) && line != CardSeparator:
+			add(num, "line separator should be exactly %q", CardSeparator)
 			continue
+		case nonSentenceRx.MatchString(line):
+			add(num, "%q should not end in a period", line)
+		}
+		if m := spaceAfterPlus.FindString(line); len(m) > 0 {
+			add(num, "there should not be a space in %q", m)
+		}
+		if m := spaceBeforeUnit.FindString(line); len(m) > 0 {
+			add(num, "there should not be a space in %q", m)
 		}
-		if firstLine == notInTag {
-			if line == "<table>" {
-				firstLine = i + 1
-			} else {
-				return nil, fmt.Errorf("Line %d is %q but should be an opening table tag.", i, line)
+		for _, m := range noSpaceAfterNum.FindAllStringSubmatch(line, -1) {
+			if m[1] != "VP" && m[1] != "Debt" {
+				add(num, "there should be a space in %q", m[0])
 			}
-		} else {
-			if line == "<table>" {
-				return nil, fmt.Errorf("Line %d is %q but the previous table wasn't closed.", i, line)
+		}
+		reported := make(map[string]bool)
+		for _, word := range wordRx.FindAllString(line, -1) {
+			if !reported[word] && (typeWords[word] || typeWords[strings.TrimSuffix(word, "s")]) {
+				add(num, "type %q should be capitalized", word)
+				reported[word] = true
 			}
-			if line == "</table>" {
-				rv = append(rv, lines[firstLine:i])
-				firstLine = notInTag
+		}
+	}
+	return rv
+}
+
+// CheckCardNamesFile checks that all the card names in a JSON file (e.g. include_if.json) are known.
+// The file must be an object where the keys are card names and the values are lists of card names.
+// A name with a / in it (e.g. "Deluded/Envious") is okay if each part is known.
+func CheckCardNamesFile(filename string, knownNames map[string]bool) ([]Diagnostic, error) {
+	contents, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var entries map[string][]string
+	if err = json.Unmarshal(contents, &entries); err != nil {
+		return nil, fmt.Errorf("could not parse %q: %w", filename, err)
+	}
+	lines := strings.Split(string(contents), "\n")
+
+	var rv []Diagnostic
+	add := func(key, name, format string, args ...interface{}) {
+		rv = append(rv, Diagnostic{Filename: filename, Line: findEntryLine(lines, key, name), Message: fmt.Sprintf(format, args...)})
+	}
+	isKnown := func(name string) bool {
+		if knownNames[name] {
+			return true
+		}
+		parts := strings.Split(name, "/")
+		for _, part := range parts {
+			if !knownNames[part] {
+				return false
 			}
 		}
+		return len(parts) > 1
 	}
-	if firstLine != notInTag {
-		return nil, fmt.Errorf("Table open at the end of the file.")
+
+	keys := make([]string, 0, len(entries))
+	for key := range entries {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return findEntryLine(lines, keys[i], "") < findEntryLine(lines, keys[j], "")
+	})
+	for _, key := range keys {
+		if !isKnown(key) {
+			add(key, "", "unknown card name %q", key)
+		}
+		seen := make(map[string]bool)
+		for _, name := range entries[key] {
+			switch {
+			case !isKnown(name):
+				add(key, name, "unknown card name %q in %q", name, key)
+			case seen[name]:
+				add(key, name, "duplicate card name %q in %q", name, key)
+			}
+			seen[name] = true
+		}
 	}
 	return rv, nil
 }
 
+// findEntryLine gets the (one based) line number of the provided key in some JSON.
+// If a name is provided, it's the line of that name in the key's value (starting at the key's line).
+// Returns 0 if it can't be found.
+func findEntryLine(lines []string, key, name string) int {
+	keyStr, _ := json.Marshal(key)
+	nameStr, _ := json.Marshal(name)
+	for i, line := range lines {
+		j := strings.Index(line, string(keyStr)+":")
+		if j < 0 {
+			continue
+		}
+		if len(name) == 0 {
+			return i + 1
+		}
+		line = line[j+len(keyStr)+1:]
+		for k := i; k < len(lines); k++ {
+			if k > i {
+				line = lines[k]
+			}
+			if strings.Contains(line, string(nameStr)) {
+				return k + 1
+			}
+			if strings.Contains(line, "]") {
+				break
+			}
+		}
+		return i + 1
+	}
+	return 0
+}
+
 func GetFilenameBase(filename string) string {
 	base := filepath.Base(filename)
 	parts := strings.Split(base, ".")
@@ -252,34 +895,73 @@ func GetHtmlFiles(dir string) ([]string, error) {
 	return rv, nil
 }
 
-func ParseArgs(args []string) (sourceDir string, destDir string, err error) {
-	sourceDir = "."
-	if len(args) > 0 {
-		sourceDir = args[0]
+// Args are the things defined by the command-line arguments.
+type Args struct {
+	SourceDir string
+	DestDir   string
+	PageFile  string
+	Check     bool
+}
+
+func ParseArgs(args []string) (*Args, error) {
+	rv := &Args{}
+	var dirs []string
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--check" || arg == "-check":
+			rv.Check = true
+		case arg == "--page" || arg == "-page":
+			if i+1 >= len(args) {
+				return nil, fmt.Errorf("no value provided after %s", arg)
+			}
+			i++
+			rv.PageFile = args[i]
+		case strings.HasPrefix(arg, "-"):
+			return nil, fmt.Errorf("unknown flag: %q", arg)
+		default:
+			dirs = append(dirs, arg)
+		}
+	}
+	if len(dirs) > 2 {
+		return nil, fmt.Errorf("too many args, expected: 0 to 2, found: %d", len(dirs))
 	}
-	destDir = sourceDir
-	if len(args) > 1 {
-		destDir = args[1]
+	if rv.Check && len(rv.PageFile) > 0 {
+		return nil, fmt.Errorf("the --check and --page flags cannot be used together")
 	}
-	if len(args) > 2 {
-		err = fmt.Errorf("too many args, expected: 0 to 2, found: %d", len(args))
+	rv.SourceDir = "."
+	if len(dirs) > 0 {
+		rv.SourceDir = dirs[0]
 	}
-	return
+	switch {
+	case len(dirs) > 1:
+		rv.DestDir = dirs[1]
+	case rv.Check:
+		// The name files being checked are hand-maintained in the json dir next to the tables dir, not in the tables dir.
+		rv.DestDir = filepath.Join(rv.SourceDir, "..", "json")
+	default:
+		rv.DestDir = rv.SourceDir
+	}
+	return rv, nil
 }
 
 func main() {
 	err := Run()
 	if err != nil {
 		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
 	}
 }
 
 func Run() error {
-	sourceDir, destDir, err := ParseArgs(os.Args[1:])
+	args, err := ParseArgs(os.Args[1:])
 	if err != nil {
 		PrintUsage()
 		return err
 	}
 
-	return ConvertDir(sourceDir, destDir)
+	if args.Check {
+		return CheckDir(args.SourceDir, args.DestDir)
+	}
+	return ConvertDir(args.SourceDir, args.DestDir, args.PageFile)
 }
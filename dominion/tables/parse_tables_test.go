@@ -0,0 +1,667 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SpicyLemon/dominion/cards"
+)
+
+func TestParseRow(t *testing.T) {
+	cells := []string{
+		"Fishing Village",
+		"Action -- Duration",
+		"$3",
+		"+2 Actions\n+$1\nAt the start of your next turn: +1 Action and +$1",
+	}
+	exp := &cards.Card{
+		Name:        "Fishing Village",
+		Types:       []string{"Action", "Duration"},
+		Cost:        "$3",
+		CostInfo:    &cards.Cost{Coins: 3},
+		Description: "+2 Actions\n+$1\nAt the start of your next turn: +1 Action and +$1",
+	}
+	card, err := ParseRow(cells)
+	if err != nil {
+		t.Fatalf("ParseRow unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(exp, card) {
+		t.Errorf("ParseRow =\n%+v\nexpected\n%+v", card, exp)
+	}
+
+	cells[2] = "3 Dollars"
+	_, err = ParseRow(cells)
+	if err == nil || err.Error() != `card "Fishing Village": unknown cost format: "3 Dollars"` {
+		t.Errorf("ParseRow with bad cost error = %v", err)
+	}
+
+	_, err = ParseRow(cells[:3])
+	if err == nil || err.Error() != "row has 3 cells, expected 4: name, types, cost, description" {
+		t.Errorf("ParseRow with 3 cells error = %v", err)
+	}
+}
+
+func TestCleanCell(t *testing.T) {
+	tests := []struct {
+		contents string
+		exp      string
+	}{
+		{contents: "", exp: ""},
+		{contents: "Village", exp: "Village"},
+		{contents: "  Village \t", exp: "Village"},
+		{contents: "\n  +1 Card\n  +2 Actions\n", exp: "+1 Card\n+2 Actions"},
+		{contents: "+1 Card\n\n+2 Actions", exp: "+1 Card\n\n+2 Actions"},
+	}
+
+	for _, tc := range tests {
+		if act := CleanCell(tc.contents); act != tc.exp {
+			t.Errorf("CleanCell(%q) = %q, expected %q", tc.contents, act, tc.exp)
+		}
+	}
+}
+
+func TestParseTables(t *testing.T) {
+	village := &cards.Card{
+		Name:        "Village",
+		Types:       []string{"Action"},
+		Cost:        "$3",
+		CostInfo:    &cards.Cost{Coins: 3},
+		Description: "+1 Card\n+2 Actions",
+	}
+
+	tests := []struct {
+		name   string
+		html   string
+		exp    []*cards.CardSet
+		expErr string
+	}{
+		{
+			name: "repo format",
+			html: `<table>
+<!-- Events -->
+<!-- Some info. -->
+<tr>
+<td>Village</td>
+<td>Action</td>
+<td>$3</td>
+<td>+1 Card
++2 Actions</td>
+</tr>
+</table>
+`,
+			exp: []*cards.CardSet{{SchemaVersion: cards.SchemaVersion, Name: "Events", Info: "Some info.", Cards: []*cards.Card{village}}},
+		},
+		{
+			name: "attributes, whitespace, and other tags",
+			html: `<html><body>
+  <TABLE class="cards" border=1>
+    <!--   Events   -->
+    <thead>
+      <tr><th>Name</th><th>Types</th><th>Cost</th><th>Description</th></tr>
+    </thead>
+    <tbody>
+      <tr class="card">
+        <td id="village"> <b>Village</b> </td>
+        <td>Action</td>
+        <td>
+          $3
+        </td>
+        <td>
+          +1 Card<br/>
+          +2 <i>Actions</i>
+        </td>
+      </tr>
+    </tbody>
+  </TABLE>
+</body></html>
+`,
+			exp: []*cards.CardSet{{SchemaVersion: cards.SchemaVersion, Name: "Events", Cards: []*cards.Card{village}}},
+		},
+		{
+			name: "br and entities",
+			html: "<table><tr><td>Village</td><td>Action</td><td>$3</td><td>+1 Card<br>+2 Actions &amp; +$1</td></tr></table>",
+			exp: []*cards.CardSet{{SchemaVersion: cards.SchemaVersion, Cards: []*cards.Card{{
+				Name:        "Village",
+				Types:       []string{"Action"},
+				Cost:        "$3",
+				CostInfo:    &cards.Cost{Coins: 3},
+				Description: "+1 Card\n+2 Actions & +$1",
+			}}}},
+		},
+		{
+			name: "two tables",
+			html: "<table><!-- A --><tr><td>Village</td><td>Action</td><td>$3</td><td>+1 Card\n+2 Actions</td></tr></table>\n" +
+				"<table><!-- B --><!-- info --><!-- ignored --></table>",
+			exp: []*cards.CardSet{
+				{SchemaVersion: cards.SchemaVersion, Name: "A", Cards: []*cards.Card{village}},
+				{SchemaVersion: cards.SchemaVersion, Name: "B", Info: "info"},
+			},
+		},
+		{
+			name:   "missing cell",
+			html:   "<table>\n<tr>\n<td>Village</td>\n<td>Action</td>\n<td>$3</td>\n</tr>\n</table>",
+			expErr: "line 6: error parsing row starting on line 2: row has 3 cells, expected 4: name, types, cost, description",
+		},
+		{
+			name:   "unclosed row",
+			html:   "<table>\n<tr>\n<tr>",
+			expErr: "line 3: <tr> found but the previous row wasn't closed",
+		},
+		{
+			name:   "unclosed table",
+			html:   "<table>\n<!-- A -->\n",
+			expErr: "line 3: table open at the end of the file",
+		},
+		{
+			name:   "text outside of cell",
+			html:   "<table>\n<tr>oops</tr></table>",
+			expErr: `line 2: unexpected text outside of a table cell: "oops"`,
+		},
+		{
+			name:   "tag in table structure",
+			html:   "<table>\n<div></div></table>",
+			expErr: "line 2: unexpected <div> outside of a table cell",
+		},
+		{
+			name:   "cell outside of row",
+			html:   "<table><td>Village</td></table>",
+			expErr: "line 1: <td> found outside of a row",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			act, err := ParseTables(strings.NewReader(tc.html))
+			if len(tc.expErr) > 0 {
+				if err == nil || err.Error() != tc.expErr {
+					t.Fatalf("ParseTables error = %v, expected %q", err, tc.expErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseTables unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(tc.exp, act) {
+				t.Errorf("ParseTables =\n%s\nexpected\n%s", toJSON(t, act), toJSON(t, tc.exp))
+			}
+		})
+	}
+}
+
+func TestConvertDirGolden(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("..", "json", "*.json"))
+	if err != nil {
+		t.Fatalf("could not list the json files: %v", err)
+	}
+	destDir := t.TempDir()
+	// The hand-maintained files need to be there for their minified versions to be made.
+	for _, name := range []string{"extra_cards.json", "include_if.json"} {
+		copyFile(t, filepath.Join("..", "json", name), filepath.Join(destDir, name))
+	}
+	pageFile := filepath.Join(destDir, "page.html")
+	copyFile(t, filepath.Join("..", "source", "dominion-randomizer.html"), pageFile)
+
+	if err = ConvertDir(".", destDir, pageFile); err != nil {
+		t.Fatalf("ConvertDir unexpected error: %v", err)
+	}
+	if len(files) < len(bundleNames)*2 {
+		t.Errorf("only %d json files found to compare", len(files))
+	}
+	for _, file := range files {
+		base := filepath.Base(file)
+		exp, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("could not read %s: %v", file, err)
+		}
+		act, err := os.ReadFile(filepath.Join(destDir, base))
+		if err != nil {
+			t.Errorf("could not read the generated %s: %v", base, err)
+			continue
+		}
+		if string(exp) != string(act) {
+			t.Errorf("the generated %s is different from %s", base, file)
+		}
+	}
+
+	exp, err := os.ReadFile(filepath.Join("..", "source", "dominion-randomizer.html"))
+	if err != nil {
+		t.Fatalf("could not read the page: %v", err)
+	}
+	act, err := os.ReadFile(pageFile)
+	if err != nil {
+		t.Fatalf("could not read the updated page: %v", err)
+	}
+	if string(exp) != string(act) {
+		t.Errorf("the updated page is different from source/dominion-randomizer.html (regenerate the json and page)")
+	}
+}
+
+func copyFile(t *testing.T, from, to string) {
+	t.Helper()
+	contents, err := os.ReadFile(from)
+	if err != nil {
+		t.Fatalf("could not read %s: %v", from, err)
+	}
+	if err = os.WriteFile(to, contents, 0644); err != nil {
+		t.Fatalf("could not write %s: %v", to, err)
+	}
+}
+
+func TestWriteIfChanged(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "test.json")
+	if err := WriteIfChanged(filename, []byte("one")); err != nil {
+		t.Fatalf("WriteIfChanged (new file) unexpected error: %v", err)
+	}
+	old := time.Now().Add(-time.Hour).Truncate(time.Second)
+	if err := os.Chtimes(filename, old, old); err != nil {
+		t.Fatalf("could not change the file times: %v", err)
+	}
+
+	if err := WriteIfChanged(filename, []byte("one")); err != nil {
+		t.Fatalf("WriteIfChanged (same contents) unexpected error: %v", err)
+	}
+	info, err := os.Stat(filename)
+	if err != nil {
+		t.Fatalf("could not stat the file: %v", err)
+	}
+	if !info.ModTime().Equal(old) {
+		t.Errorf("file was written even though its contents didn't change")
+	}
+
+	if err = WriteIfChanged(filename, []byte("two")); err != nil {
+		t.Fatalf("WriteIfChanged (new contents) unexpected error: %v", err)
+	}
+	if contents, _ := os.ReadFile(filename); string(contents) != "two" {
+		t.Errorf("file contents = %q, expected %q", string(contents), "two")
+	}
+
+	err = WriteIfChanged(filepath.Join(t.TempDir(), "nope", "test.json"), []byte("one"))
+	if err == nil || !strings.HasPrefix(err.Error(), "error writing ") {
+		t.Errorf("WriteIfChanged to missing dir error = %v", err)
+	}
+}
+
+func TestWriteBundles(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "all.json"), []byte("{\n  \"a\": [\n    1,\n    2\n  ]\n}"), 0644); err != nil {
+		t.Fatalf("could not write all.json: %v", err)
+	}
+	bundles, err := WriteBundles(dir)
+	if err != nil {
+		t.Fatalf("WriteBundles unexpected error: %v", err)
+	}
+	exp := map[string][]byte{"all.min.json": []byte(`{"a":[1,2]}`)}
+	if !reflect.DeepEqual(exp, bundles) {
+		t.Errorf("WriteBundles = %q, expected %q", bundles, exp)
+	}
+	if contents, _ := os.ReadFile(filepath.Join(dir, "all.min.json")); string(contents) != `{"a":[1,2]}` {
+		t.Errorf("all.min.json = %q", string(contents))
+	}
+
+	if err = os.WriteFile(filepath.Join(dir, "include_if.json"), []byte("{"), 0644); err != nil {
+		t.Fatalf("could not write include_if.json: %v", err)
+	}
+	_, err = WriteBundles(dir)
+	if err == nil || !strings.HasPrefix(err.Error(), "error minifying ") {
+		t.Errorf("WriteBundles with bad JSON error = %v", err)
+	}
+}
+
+func TestSplicePage(t *testing.T) {
+	bundles := map[string][]byte{"all.min.json": []byte(`{"a":1}`), "b.min.json": []byte(`[]`)}
+	tests := []struct {
+		name   string
+		page   string
+		exp    string
+		expErr string
+	}{
+		{
+			name: "two bundles",
+			page: "<script>\n  // BEGIN GENERATED allCards = all.min.json\n  let allCards = {};\n  // END GENERATED allCards\n" +
+				"let x = 1;\n\t// BEGIN GENERATED b = b.min.json\n\t// END GENERATED b\n</script>\n",
+			exp: "<script>\n  // BEGIN GENERATED allCards = all.min.json\n  let allCards = {\"a\":1};\n  // END GENERATED allCards\n" +
+				"let x = 1;\n\t// BEGIN GENERATED b = b.min.json\n\tlet b = [];\n\t// END GENERATED b\n</script>\n",
+		},
+		{
+			name: "several lines replaced",
+			page: "// BEGIN GENERATED allCards = all.min.json\nlet allCards = {\n  \"a\": 0\n};\n// END GENERATED allCards",
+			exp:  "// BEGIN GENERATED allCards = all.min.json\nlet allCards = {\"a\":1};\n// END GENERATED allCards",
+		},
+		{
+			name:   "no markers",
+			page:   "<script>\nlet allCards = {};\n</script>\n",
+			expErr: "no BEGIN GENERATED markers found",
+		},
+		{
+			name:   "unknown bundle",
+			page:   "x\n// BEGIN GENERATED c = c.min.json\n// END GENERATED c\n",
+			expErr: `line 2: unknown bundle "c.min.json"`,
+		},
+		{
+			name:   "no end",
+			page:   "// BEGIN GENERATED allCards = all.min.json\nlet allCards = {};\n",
+			expErr: "line 1: no end marker found for allCards",
+		},
+		{
+			name:   "wrong end",
+			page:   "// BEGIN GENERATED allCards = all.min.json\n// END GENERATED b\n",
+			expErr: "line 2: end marker for b found, expected one for allCards (from line 1)",
+		},
+		{
+			name:   "nested begin",
+			page:   "// BEGIN GENERATED allCards = all.min.json\n// BEGIN GENERATED b = b.min.json\n",
+			expErr: "line 2: begin marker found before the end marker for allCards (from line 1)",
+		},
+		{
+			name:   "end without begin",
+			page:   "x\n// END GENERATED b\n",
+			expErr: "line 2: end marker without a begin marker",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			act, err := SplicePage([]byte(tc.page), bundles)
+			if len(tc.expErr) > 0 {
+				if err == nil || err.Error() != tc.expErr {
+					t.Fatalf("SplicePage error = %v, expected %q", err, tc.expErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SplicePage unexpected error: %v", err)
+			}
+			if string(act) != tc.exp {
+				t.Errorf("SplicePage =\n%s\nexpected\n%s", string(act), tc.exp)
+			}
+		})
+	}
+}
+
+func toJSON(t *testing.T, v interface{}) string {
+	bz, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		t.Fatalf("could not marshal %#v to JSON: %v", v, err)
+	}
+	return string(bz)
+}
+
+func TestParseArgs(t *testing.T) {
+	tests := []struct {
+		name   string
+		args   []string
+		exp    *Args
+		expErr string
+	}{
+		{name: "no args", args: nil, exp: &Args{SourceDir: ".", DestDir: "."}},
+		{name: "source", args: []string{"tables"}, exp: &Args{SourceDir: "tables", DestDir: "tables"}},
+		{name: "source and dest", args: []string{"tables", "json"}, exp: &Args{SourceDir: "tables", DestDir: "json"}},
+		{name: "check", args: []string{"--check"}, exp: &Args{SourceDir: ".", DestDir: filepath.Join("..", "json"), Check: true}},
+		{name: "check with source", args: []string{"--check", "tables/"}, exp: &Args{SourceDir: "tables/", DestDir: "json", Check: true}},
+		{name: "check after dirs", args: []string{"tables", "json", "--check"}, exp: &Args{SourceDir: "tables", DestDir: "json", Check: true}},
+		{
+			name: "page",
+			args: []string{"--page", "source/dominion-randomizer.html", "tables/", "json/"},
+			exp:  &Args{SourceDir: "tables/", DestDir: "json/", PageFile: "source/dominion-randomizer.html"},
+		},
+		{name: "page without file", args: []string{"tables", "--page"}, expErr: "no value provided after --page"},
+		{name: "check and page", args: []string{"--check", "--page", "x.html"}, expErr: "the --check and --page flags cannot be used together"},
+		{name: "unknown flag", args: []string{"--nope"}, expErr: `unknown flag: "--nope"`},
+		{name: "too many", args: []string{"a", "b", "c"}, expErr: "too many args, expected: 0 to 2, found: 3"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			act, err := ParseArgs(tc.args)
+			if len(tc.expErr) > 0 {
+				if err == nil || err.Error() != tc.expErr {
+					t.Fatalf("ParseArgs error = %v, expected %q", err, tc.expErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseArgs unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(tc.exp, act) {
+				t.Errorf("ParseArgs = %+v, expected %+v", act, tc.exp)
+			}
+		})
+	}
+}
+
+func TestCheckLines(t *testing.T) {
+	typeWords := map[string]bool{"action": true, "treasure": true}
+	tests := []struct {
+		name  string
+		lines []string
+		exp   []string
+	}{
+		{
+			name: "all good",
+			lines: []string{
+				"<table>",
+				"<!-- Events -->",
+				"<!-- Not part of the supply. -->",
+				"<tr>",
+				"<td>Lost in the Woods</td>",
+				"<td>Action -- Duration</td>",
+				"<td>$4+3Debt</td>",
+				"<td>+1 Card",
+				"+$2",
+				"----------",
+				"Gain an Action or 2 Treasures worth 2VP and 4Debt.</td>",
+				"</tr>",
+				"</table>",
+				"",
+			},
+			exp: nil,
+		},
+		{
+			name: "whitespace",
+			lines: []string{
+				" <table>",
+				"<tr>",
+				"<td>Village</td>\t",
+				"<td>Action</td>",
+				"<td>$3</td>",
+				"<td>+1 Card",
+				"",
+				"+2 Actions",
+				"</td>",
+				"</tr>",
+				"</table>",
+			},
+			exp: []string{
+				"test.html:1: leading whitespace",
+				"test.html:3: trailing whitespace",
+				"test.html:9: </td> should be on the same line as the last of the description",
+				"test.html:7: empty line in description",
+				"test.html:9: empty line in description",
+			},
+		},
+		{
+			name: "names types and costs",
+			lines: []string{
+				"<table>",
+				"<tr>",
+				"<td>sack Of loot</td>",
+				"<td>Action - attack</td>",
+				"<td>$4*</td>",
+				"<td>Stuff</td>",
+				"</tr>",
+				"<tr>",
+				"<td>Peddler</td>",
+				"<td>Action -- treasure</td>",
+				"<td>4 Debt</td>",
+				"<td>Stuff</td>",
+				"</tr>",
+				"</table>",
+			},
+			exp: []string{
+				`test.html:3: name "sack Of loot" should capitalize "sack"`,
+				`test.html:3: name "sack Of loot" should not capitalize "Of"`,
+				`test.html:3: name "sack Of loot" should capitalize "loot"`,
+				`test.html:4: types "Action - attack" should be delimited by " -- "`,
+				`test.html:5: kingdom card cost "$4*" should not have an asterisk`,
+				`test.html:10: type "treasure" should be capitalized`,
+				`test.html:11: unknown cost format: "4 Debt"`,
+			},
+		},
+		{
+			name: "descriptions",
+			lines: []string{
+				"<table>",
+				"<!-- Other -->",
+				"<tr>",
+				"<td>Prize</td>",
+				"<td>Treasure</td>",
+				"<td>$0*</td>",
+				"<td>+1 Card.",
+				"+ $2 and +$ 2",
+				"-----",
+				"+1Action and 2 VP and 3 Debt",
+				"Play an action or treasures, any action.</td>",
+				"</tr>",
+				"</table>",
+			},
+			exp: []string{
+				`test.html:7: "+1 Card." should not end in a period`,
+				`test.html:8: there should not be a space in "+ $"`,
+				`test.html:9: line separator should be exactly "----------"`,
+				`test.html:10: there should not be a space in "2 VP"`,
+				`test.html:10: there should be a space in "+1Action"`,
+				`test.html:11: type "action" should be capitalized`,
+				`test.html:11: type "treasures" should be capitalized`,
+			},
+		},
+		{
+			name: "structure",
+			lines: []string{
+				"<table>",
+				"<tr>",
+				"<td>Village</td>",
+				"<td>Action</td>",
+				"<td>$3</td>",
+				"</tr>",
+				"<!-- Late -->",
+				"<tr>",
+				"<td>Smithy</td>",
+				"<td>Action",
+				"</td>",
+				"<td>$4</td>",
+				"<td>+3 Cards</td>",
+				"</tr>",
+				"</table>",
+				"stuff",
+				"<table>",
+				"<tr>",
+			},
+			exp: []string{
+				"test.html:2: row has 3 cells, expected 4: name, types, cost, description",
+				"test.html:7: comments are only allowed on the two lines after <table>",
+				"test.html:10: the types must be on a single line",
+				`test.html:16: expected <table> but found "stuff"`,
+				"test.html:18: <tr> is never closed",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var act []string
+			for _, diag := range CheckLines("test.html", tc.lines, typeWords) {
+				act = append(act, diag.String())
+			}
+			if !reflect.DeepEqual(tc.exp, act) {
+				t.Errorf("CheckLines diagnostics:\n%s\nexpected:\n%s", strings.Join(act, "\n"), strings.Join(tc.exp, "\n"))
+			}
+		})
+	}
+}
+
+func TestCheckCardNamesFile(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "include_if.json")
+	contents := `{
+  "Ruins": ["Cultist", "Death Cart"],
+  "Deluded/Envious": ["Cursed Village"],
+  "Loots": ["Jewelled Egg", "Propser",
+    "Search", "Search"],
+  "Curse": ["Witch"],
+  "Nope": ["Witch"]
+}
+`
+	if err := os.WriteFile(filename, []byte(contents), 0644); err != nil {
+		t.Fatalf("could not write test file: %v", err)
+	}
+	known := map[string]bool{
+		"Ruins": true, "Cultist": true, "Death Cart": true, "Deluded": true, "Envious": true, "Cursed Village": true,
+		"Loots": true, "Jewelled Egg": true, "Search": true, "Curse": true, "Witch": true,
+	}
+	exp := []string{
+		filename + `:4: unknown card name "Propser" in "Loots"`,
+		filename + `:5: duplicate card name "Search" in "Loots"`,
+		filename + `:7: unknown card name "Nope"`,
+	}
+
+	diags, err := CheckCardNamesFile(filename, known)
+	if err != nil {
+		t.Fatalf("CheckCardNamesFile unexpected error: %v", err)
+	}
+	var act []string
+	for _, diag := range diags {
+		act = append(act, diag.String())
+	}
+	if !reflect.DeepEqual(exp, act) {
+		t.Errorf("CheckCardNamesFile diagnostics:\n%s\nexpected:\n%s", strings.Join(act, "\n"), strings.Join(exp, "\n"))
+	}
+
+	_, err = CheckCardNamesFile(filepath.Join(t.TempDir(), "missing.json"), known)
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("CheckCardNamesFile of missing file error = %v, expected os.ErrNotExist", err)
+	}
+}
+
+func TestCheckDir(t *testing.T) {
+	sourceDir := t.TempDir()
+	html := `<table>
+<!-- Events -->
+<tr>
+<td>Village</td>
+<td>Action</td>
+<td>$3</td>
+<td>+1 Card
++2 Actions</td>
+</tr>
+</table>
+`
+	if err := os.WriteFile(filepath.Join(sourceDir, "base.html"), []byte(html), 0644); err != nil {
+		t.Fatalf("could not write test file: %v", err)
+	}
+
+	jsonDir := t.TempDir()
+	err := CheckDir(sourceDir, jsonDir)
+	if exp := fmt.Sprintf("neither include_if.json nor extra_cards.json found in %q", jsonDir); err == nil || err.Error() != exp {
+		t.Errorf("CheckDir without name files error = %v, expected %q", err, exp)
+	}
+
+	if err = os.WriteFile(filepath.Join(jsonDir, "include_if.json"), []byte(`{"Village": ["Copper"]}`+"\n"), 0644); err != nil {
+		t.Fatalf("could not write test file: %v", err)
+	}
+	if err = CheckDir(sourceDir, jsonDir); err != nil {
+		t.Errorf("CheckDir with one name file unexpected error: %v", err)
+	}
+
+	if err = os.WriteFile(filepath.Join(jsonDir, "extra_cards.json"), []byte(`{"Village": ["Nope"]}`+"\n"), 0644); err != nil {
+		t.Fatalf("could not write test file: %v", err)
+	}
+	if err = CheckDir(sourceDir, jsonDir); err == nil || err.Error() != "found 1 problem(s)" {
+		t.Errorf("CheckDir with a bad name error = %v, expected %q", err, "found 1 problem(s)")
+	}
+}
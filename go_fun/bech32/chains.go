@@ -0,0 +1,202 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// HRPFamilySuffixes are the suffixes added to a prefix to get each of the standard Cosmos HRPs.
+// In order, they're for: accounts, account public keys, validator operators,
+// validator operator public keys, validator consensus nodes, and validator consensus public keys.
+var HRPFamilySuffixes = []string{"", "pub", "valoper", "valoperpub", "valcons", "valconspub"}
+
+// HRPFamily gets all the standard Cosmos HRPs for the provided prefix.
+// E.g. "cosmos" => "cosmos", "cosmospub", "cosmosvaloper", "cosmosvaloperpub", "cosmosvalcons", "cosmosvalconspub".
+func HRPFamily(prefix string) []string {
+	rv := make([]string, len(HRPFamilySuffixes))
+	for i, suffix := range HRPFamilySuffixes {
+		rv[i] = prefix + suffix
+	}
+	return rv
+}
+
+// Chains are the known chains and their bech32 account prefixes.
+var Chains = map[string]string{
+	"agoric":        "agoric",
+	"akash":         "akash",
+	"archway":       "archway",
+	"axelar":        "axelar",
+	"band":          "band",
+	"bitsong":       "bitsong",
+	"celestia":      "celestia",
+	"cheqd":         "cheqd",
+	"chihuahua":     "chihuahua",
+	"comdex":        "comdex",
+	"coreum":        "core",
+	"cosmoshub":     "cosmos",
+	"crescent":      "cre",
+	"desmos":        "desmos",
+	"dydx":          "dydx",
+	"dymension":     "dym",
+	"evmos":         "evmos",
+	"fetchai":       "fetch",
+	"gravitybridge": "gravity",
+	"injective":     "inj",
+	"irisnet":       "iaa",
+	"juno":          "juno",
+	"kava":          "kava",
+	"kujira":        "kujira",
+	"likecoin":      "like",
+	"mars":          "mars",
+	"neutron":       "neutron",
+	"noble":         "noble",
+	"nolus":         "nolus",
+	"omniflix":      "omniflix",
+	"osmosis":       "osmo",
+	"persistence":   "persistence",
+	"provenance":    "pb",
+	"quicksilver":   "quick",
+	"regen":         "regen",
+	"secretnetwork": "secret",
+	"sei":           "sei",
+	"sentinel":      "sent",
+	"sommelier":     "somm",
+	"stargaze":      "stars",
+	"stride":        "stride",
+	"teritori":      "tori",
+	"terra":         "terra",
+	"umee":          "umee",
+}
+
+// chainHRPFamilies are the HRPs for the known chains that don't use the standard suffixes (see HRPFamily).
+// They're in the same order as HRPFamilySuffixes.
+var chainHRPFamilies = map[string][]string{
+	"irisnet": {"iaa", "iap", "iva", "ivp", "ica", "icp"},
+}
+
+// chainAliases are other names for some of the known chains.
+var chainAliases = map[string]string{
+	"cosmos":  "cosmoshub",
+	"gaia":    "cosmoshub",
+	"hub":     "cosmoshub",
+	"fetch":   "fetchai",
+	"gravity": "gravitybridge",
+	"iris":    "irisnet",
+	"osmo":    "osmosis",
+	"pb":      "provenance",
+	"secret":  "secretnetwork",
+	"stars":   "stargaze",
+}
+
+// getChainName gets the name of the provided chain as it is in Chains (case insensitive).
+// Dashes, underscores, and spaces in the chain name are ignored, e.g. "gravity-bridge" is the same as "gravitybridge".
+func getChainName(chain string) (string, error) {
+	name := strings.ToLower(strings.TrimSpace(chain))
+	name = strings.NewReplacer("-", "", "_", "", " ", "").Replace(name)
+	if alias, ok := chainAliases[name]; ok {
+		name = alias
+	}
+	if _, ok := Chains[name]; ok {
+		return name, nil
+	}
+	return "", fmt.Errorf("unknown chain %q: use the chains command to list the known chains", chain)
+}
+
+// GetChainPrefix gets the bech32 account prefix of the provided chain (case insensitive).
+// Dashes, underscores, and spaces in the chain name are ignored, e.g. "gravity-bridge" is the same as "gravitybridge".
+func GetChainPrefix(chain string) (string, error) {
+	name, err := getChainName(chain)
+	if err != nil {
+		return "", err
+	}
+	return Chains[name], nil
+}
+
+// GetChainHRPs gets all the HRPs used by the provided chain (case insensitive).
+// Most chains use the standard ones (see HRPFamily), but some (e.g. irisnet) have their own.
+func GetChainHRPs(chain string) ([]string, error) {
+	name, err := getChainName(chain)
+	if err != nil {
+		return nil, err
+	}
+	if hrps, ok := chainHRPFamilies[name]; ok {
+		return append([]string{}, hrps...), nil
+	}
+	return HRPFamily(Chains[name]), nil
+}
+
+// ExpandHRPs gets the HRPs for the provided --hrp-family prefixes and --chain names.
+// The returned HRPs do not include any in the existing ones (or duplicates).
+func ExpandHRPs(existing, families, chains []string) ([]string, error) {
+	var hrpFamilies [][]string
+	for _, family := range families {
+		family = strings.TrimSpace(family)
+		if len(family) == 0 {
+			return nil, errors.New("empty --hrp-family value")
+		}
+		hrpFamilies = append(hrpFamilies, HRPFamily(family))
+	}
+	for _, chain := range chains {
+		hrps, err := GetChainHRPs(chain)
+		if err != nil {
+			return nil, err
+		}
+		hrpFamilies = append(hrpFamilies, hrps)
+	}
+
+	known := make(map[string]bool, len(existing))
+	for _, hrp := range existing {
+		known[hrp] = true
+	}
+	var rv []string
+	for _, hrps := range hrpFamilies {
+		for _, hrp := range hrps {
+			if !known[hrp] {
+				known[hrp] = true
+				rv = append(rv, hrp)
+			}
+		}
+	}
+	return rv, nil
+}
+
+// NewChainsCmd creates the chains sub-command.
+func NewChainsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "chains",
+		Short: "List the known chains and their bech32 prefixes",
+		Long: `List the known chains and their bech32 prefixes.
+These are the chains that can be provided with the --chain flag.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return PrintChains(cmd.OutOrStdout())
+		},
+		SilenceUsage: true,
+	}
+	return cmd
+}
+
+// PrintChains prints each known chain and its prefix (sorted by chain name).
+// Chains that don't use the standard HRPs also have all of their HRPs listed.
+func PrintChains(w io.Writer) error {
+	names := make([]string, 0, len(Chains))
+	for name := range Chains {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		line := fmt.Sprintf("%-15s %s", name, Chains[name])
+		if hrps, ok := chainHRPFamilies[name]; ok {
+			line += " (" + strings.Join(hrps, ", ") + ")"
+		}
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHRPFamily(t *testing.T) {
+	tests := []struct {
+		prefix string
+		exp    []string
+	}{
+		{prefix: "", exp: []string{"", "pub", "valoper", "valoperpub", "valcons", "valconspub"}},
+		{
+			prefix: "cosmos",
+			exp:    []string{"cosmos", "cosmospub", "cosmosvaloper", "cosmosvaloperpub", "cosmosvalcons", "cosmosvalconspub"},
+		},
+		{prefix: "pb", exp: []string{"pb", "pbpub", "pbvaloper", "pbvaloperpub", "pbvalcons", "pbvalconspub"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.prefix, func(t *testing.T) {
+			actual := HRPFamily(tc.prefix)
+			assert.Equal(t, tc.exp, actual, "HRPFamily(%q)", tc.prefix)
+		})
+	}
+}
+
+func TestGetChainPrefix(t *testing.T) {
+	tests := []struct {
+		chain  string
+		exp    string
+		expErr string
+	}{
+		{chain: "", expErr: `unknown chain "": use the chains command to list the known chains`},
+		{chain: "notachain", expErr: `unknown chain "notachain": use the chains command to list the known chains`},
+		{chain: "osmosis", exp: "osmo"},
+		{chain: "OSMOSIS", exp: "osmo"},
+		{chain: " Osmosis ", exp: "osmo"},
+		{chain: "osmo", exp: "osmo"},
+		{chain: "cosmoshub", exp: "cosmos"},
+		{chain: "cosmos", exp: "cosmos"},
+		{chain: "hub", exp: "cosmos"},
+		{chain: "gaia", exp: "cosmos"},
+		{chain: "cosmos-hub", exp: "cosmos"},
+		{chain: "provenance", exp: "pb"},
+		{chain: "gravity-bridge", exp: "gravity"},
+		{chain: "gravity_bridge", exp: "gravity"},
+		{chain: "secret network", exp: "secret"},
+		{chain: "stargaze", exp: "stars"},
+		{chain: "irisnet", exp: "iaa"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.chain, func(t *testing.T) {
+			actual, err := GetChainPrefix(tc.chain)
+			AssertErrorContents(t, err, tc.expErr, "GetChainPrefix(%q) error", tc.chain)
+			assert.Equal(t, tc.exp, actual, "GetChainPrefix(%q)", tc.chain)
+		})
+	}
+}
+
+func TestGetChainHRPs(t *testing.T) {
+	tests := []struct {
+		chain  string
+		exp    []string
+		expErr string
+	}{
+		{chain: "notachain", expErr: `unknown chain "notachain": use the chains command to list the known chains`},
+		{chain: "osmosis", exp: []string{"osmo", "osmopub", "osmovaloper", "osmovaloperpub", "osmovalcons", "osmovalconspub"}},
+		{chain: "irisnet", exp: []string{"iaa", "iap", "iva", "ivp", "ica", "icp"}},
+		{chain: "Iris", exp: []string{"iaa", "iap", "iva", "ivp", "ica", "icp"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.chain, func(t *testing.T) {
+			actual, err := GetChainHRPs(tc.chain)
+			AssertErrorContents(t, err, tc.expErr, "GetChainHRPs(%q) error", tc.chain)
+			assert.Equal(t, tc.exp, actual, "GetChainHRPs(%q)", tc.chain)
+		})
+	}
+}
+
+func TestChains(t *testing.T) {
+	// Make sure all the aliases point to known chains, and none of them shadow a chain name.
+	for alias, chain := range chainAliases {
+		_, isChain := Chains[chain]
+		assert.True(t, isChain, "chainAliases[%q] = %q is not in Chains", alias, chain)
+		_, isShadow := Chains[alias]
+		assert.False(t, isShadow, "chainAliases[%q] is also in Chains", alias)
+	}
+	// Make sure all the names are normalized so they can be found.
+	for chain, prefix := range Chains {
+		assert.Equal(t, strings.ToLower(chain), chain, "chain name %q", chain)
+		assert.NotEmpty(t, prefix, "Chains[%q]", chain)
+	}
+	// Make sure the non-standard families are for known chains and start with that chain's account prefix.
+	for chain, hrps := range chainHRPFamilies {
+		prefix, isChain := Chains[chain]
+		if assert.True(t, isChain, "chainHRPFamilies[%q] is not in Chains", chain) {
+			assert.Len(t, hrps, len(HRPFamilySuffixes), "chainHRPFamilies[%q]", chain)
+			assert.Equal(t, prefix, hrps[0], "chainHRPFamilies[%q][0]", chain)
+		}
+	}
+}
+
+func TestExpandHRPs(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing []string
+		families []string
+		chains   []string
+		exp      []string
+		expErr   string
+	}{
+		{
+			name: "nothing",
+		},
+		{
+			name:     "only existing",
+			existing: []string{"abc"},
+		},
+		{
+			name:     "one family",
+			families: []string{"abc"},
+			exp:      []string{"abc", "abcpub", "abcvaloper", "abcvaloperpub", "abcvalcons", "abcvalconspub"},
+		},
+		{
+			name:     "empty family",
+			families: []string{"abc", " "},
+			expErr:   "empty --hrp-family value",
+		},
+		{
+			name:   "one chain",
+			chains: []string{"osmosis"},
+			exp:    []string{"osmo", "osmopub", "osmovaloper", "osmovaloperpub", "osmovalcons", "osmovalconspub"},
+		},
+		{
+			name:   "unknown chain",
+			chains: []string{"osmosis", "nope"},
+			expErr: `unknown chain "nope": use the chains command to list the known chains`,
+		},
+		{
+			name:     "family and chain",
+			families: []string{"abc"},
+			chains:   []string{"pb"},
+			exp: []string{
+				"abc", "abcpub", "abcvaloper", "abcvaloperpub", "abcvalcons", "abcvalconspub",
+				"pb", "pbpub", "pbvaloper", "pbvaloperpub", "pbvalcons", "pbvalconspub",
+			},
+		},
+		{
+			name:     "duplicates skipped",
+			existing: []string{"abcvaloper", "xyz"},
+			families: []string{"abc", "abc"},
+			exp:      []string{"abc", "abcpub", "abcvaloperpub", "abcvalcons", "abcvalconspub"},
+		},
+		{
+			name:   "chain with its own hrps",
+			chains: []string{"irisnet"},
+			exp:    []string{"iaa", "iap", "iva", "ivp", "ica", "icp"},
+		},
+		{
+			name:     "same prefix from family and chain",
+			families: []string{"cosmos"},
+			chains:   []string{"cosmoshub"},
+			exp:      []string{"cosmos", "cosmospub", "cosmosvaloper", "cosmosvaloperpub", "cosmosvalcons", "cosmosvalconspub"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := ExpandHRPs(tc.existing, tc.families, tc.chains)
+			AssertErrorContents(t, err, tc.expErr, "ExpandHRPs error")
+			assert.Equal(t, tc.exp, actual, "ExpandHRPs result")
+		})
+	}
+}
+
+func TestPrintChains(t *testing.T) {
+	var buffer bytes.Buffer
+	err := PrintChains(&buffer)
+	require.NoError(t, err, "PrintChains")
+	lines := strings.Split(strings.TrimSuffix(buffer.String(), "\n"), "\n")
+	assert.Len(t, lines, len(Chains), "number of lines printed")
+	assert.Contains(t, lines, "cosmoshub       cosmos", "printed lines")
+	assert.Contains(t, lines, "osmosis         osmo", "printed lines")
+	assert.Contains(t, lines, "irisnet         iaa (iaa, iap, iva, ivp, ica, icp)", "printed lines")
+	assert.IsIncreasing(t, lines, "printed lines")
+}
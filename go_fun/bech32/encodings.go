@@ -0,0 +1,110 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+
+	"github.com/cosmos/btcutil/base58"
+)
+
+// base58CheckLen is the length of the checksum at the end of base58check encoded bytes.
+const base58CheckLen = 4
+
+// DecodeBase58 decodes the provided base58 string (using the Bitcoin alphabet, e.g. Solana addresses).
+func DecodeBase58(str string) ([]byte, error) {
+	rv := base58.Decode(str)
+	// The base58 library returns an empty slice for invalid strings.
+	// Any non-empty valid string decodes to at least one byte.
+	if len(rv) == 0 && len(str) > 0 {
+		return nil, errors.New("invalid base58 string")
+	}
+	return rv, nil
+}
+
+// base58Checksum gets the base58check checksum of the provided bytes: the first 4 bytes of SHA256(SHA256(bz)).
+func base58Checksum(bz []byte) []byte {
+	first := sha256.Sum256(bz)
+	second := sha256.Sum256(first[:])
+	return second[:base58CheckLen]
+}
+
+// EncodeBase58Check encodes the provided bytes as base58 with a 4 byte checksum on the end (e.g. Bitcoin addresses).
+// Any version byte is expected to already be at the start of the provided bytes.
+func EncodeBase58Check(bz []byte) string {
+	withSum := make([]byte, 0, len(bz)+base58CheckLen)
+	withSum = append(withSum, bz...)
+	withSum = append(withSum, base58Checksum(bz)...)
+	return base58.Encode(withSum)
+}
+
+// DecodeBase58Check decodes the provided base58check string and validates its checksum.
+// The returned bytes include any version byte, but not the checksum.
+func DecodeBase58Check(str string) ([]byte, error) {
+	bz, err := DecodeBase58(str)
+	if err != nil {
+		return nil, err
+	}
+	if len(bz) < base58CheckLen {
+		return nil, fmt.Errorf("invalid base58check length %d: must be at least %d", len(bz), base58CheckLen)
+	}
+	rv, sum := bz[:len(bz)-base58CheckLen], bz[len(bz)-base58CheckLen:]
+	if !bytes.Equal(sum, base58Checksum(rv)) {
+		return nil, errors.New("invalid base58check checksum")
+	}
+	return rv, nil
+}
+
+// EncodeEVMHex encodes the provided bytes as 0x prefixed hex with the EIP-55 mixed-case checksum.
+func EncodeEVMHex(bz []byte) string {
+	return "0x" + eip55Checksum(hex.EncodeToString(bz))
+}
+
+// DecodeEVMHex decodes the provided 0x prefixed hex string.
+// If the hex has both upper and lower case letters, it must have a valid EIP-55 checksum.
+func DecodeEVMHex(str string) ([]byte, error) {
+	if !strings.HasPrefix(str, "0x") && !strings.HasPrefix(str, "0X") {
+		return nil, errors.New("missing 0x prefix")
+	}
+	digits := str[2:]
+	rv, err := hex.DecodeString(digits)
+	if err != nil {
+		return nil, err
+	}
+	if digits != strings.ToLower(digits) && digits != strings.ToUpper(digits) {
+		if exp := eip55Checksum(digits); digits != exp {
+			return nil, fmt.Errorf("invalid EIP-55 checksum: expected 0x%s", exp)
+		}
+	}
+	return rv, nil
+}
+
+// eip55Checksum applies the EIP-55 checksum casing to the provided hex digits.
+// Each letter is upper case if the matching nibble of Keccak256(lowercase digits) is 8 or more.
+// The hash only has 64 nibbles, so any digits after the first 64 are left lower case.
+func eip55Checksum(digits string) string {
+	lower := strings.ToLower(digits)
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write([]byte(lower))
+	hash := hasher.Sum(nil)
+
+	rv := []byte(lower)
+	for i, c := range rv {
+		if i/2 >= len(hash) {
+			break
+		}
+		nibble := hash[i/2] >> 4
+		if i%2 == 1 {
+			nibble = hash[i/2] & 0x0f
+		}
+		if c >= 'a' && c <= 'f' && nibble >= 8 {
+			rv[i] = c - 'a' + 'A'
+		}
+	}
+	return string(rv)
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeBase58(t *testing.T) {
+	tests := []struct {
+		name   string
+		str    string
+		exp    []byte
+		expErr string
+	}{
+		{name: "empty", str: "", exp: []byte{}},
+		{name: "one zero byte", str: "1", exp: []byte{0}},
+		{name: "leading zeros", str: "112FGE29L", exp: mustHex("00000b0b0bfbff")},
+		{name: "solana system program", str: "11111111111111111111111111111111", exp: make([]byte, 32)},
+		{name: "invalid zero", str: "0", expErr: "invalid base58 string"},
+		{name: "invalid capital o", str: "2FGEO29L", expErr: "invalid base58 string"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := DecodeBase58(tc.str)
+			AssertErrorContents(t, err, tc.expErr, "DecodeBase58(%q) error", tc.str)
+			assert.Equal(t, tc.exp, bz, "DecodeBase58(%q) result", tc.str)
+		})
+	}
+}
+
+func TestBase58Check(t *testing.T) {
+	tests := []struct {
+		name string
+		bz   []byte
+		exp  string
+	}{
+		{name: "empty", bz: []byte{}, exp: "3QJmnh"},
+		{name: "bitcoin p2pkh", bz: mustHex("0077bff20c60e522dfaa3350c39b030a5d004e839a"), exp: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"},
+		{name: "bitcoin p2sh", bz: mustHex("05b472a266d0bd89c13706a4132ccfb16f7c3b9fcb"), exp: "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			str := EncodeBase58Check(tc.bz)
+			assert.Equal(t, tc.exp, str, "EncodeBase58Check(%x)", tc.bz)
+			bz, err := DecodeBase58Check(str)
+			if assert.NoError(t, err, "DecodeBase58Check(%q) error", str) {
+				assert.Equal(t, tc.bz, bz, "DecodeBase58Check(%q) result", str)
+			}
+		})
+	}
+}
+
+func TestDecodeBase58Check(t *testing.T) {
+	tests := []struct {
+		name   string
+		str    string
+		exp    []byte
+		expErr string
+	}{
+		{name: "valid", str: "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy", exp: mustHex("05b472a266d0bd89c13706a4132ccfb16f7c3b9fcb")},
+		{name: "bad checksum", str: "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLz", expErr: "invalid base58check checksum"},
+		{name: "too short", str: "2FG", expErr: "invalid base58check length 2: must be at least 4"},
+		{name: "not base58", str: "0J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy", expErr: "invalid base58 string"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := DecodeBase58Check(tc.str)
+			AssertErrorContents(t, err, tc.expErr, "DecodeBase58Check(%q) error", tc.str)
+			assert.Equal(t, tc.exp, bz, "DecodeBase58Check(%q) result", tc.str)
+		})
+	}
+}
+
+func TestEVMHex(t *testing.T) {
+	// These are the test vectors from EIP-55.
+	tests := []string{
+		"0x52908400098527886E0F7030069857D2E4169EE7",
+		"0x8617E340B3D01FA5F11F306F4090FD50E238070D",
+		"0xde709f2102306220921060314715629080e2fb77",
+		"0x27b1fdb04752bbc536007a920d24acb045561c26",
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+		"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB",
+		"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb",
+	}
+
+	for _, str := range tests {
+		t.Run(str, func(t *testing.T) {
+			bz, err := DecodeEVMHex(str)
+			if !assert.NoError(t, err, "DecodeEVMHex(%q) error", str) {
+				return
+			}
+			assert.Equal(t, mustHex(str[2:]), bz, "DecodeEVMHex(%q) result", str)
+			assert.Equal(t, str, EncodeEVMHex(bz), "EncodeEVMHex(%x)", bz)
+		})
+	}
+}
+
+func TestDecodeEVMHex(t *testing.T) {
+	tests := []struct {
+		name   string
+		str    string
+		exp    []byte
+		expErr string
+	}{
+		{name: "empty", str: "0x", exp: []byte{}},
+		{name: "all lower", str: "0xfb6916095ca1df60bb79ce92ce3ea74c37c5d359", exp: mustHex("fb6916095ca1df60bb79ce92ce3ea74c37c5d359")},
+		{name: "all upper", str: "0xFB6916095CA1DF60BB79CE92CE3EA74C37C5D359", exp: mustHex("fb6916095ca1df60bb79ce92ce3ea74c37c5d359")},
+		{name: "upper prefix", str: "0XfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359", exp: mustHex("fb6916095ca1df60bb79ce92ce3ea74c37c5d359")},
+		{
+			name: "longer than hash",
+			str:  EncodeEVMHex(bytes.Repeat([]byte{0xab}, 40)),
+			exp:  bytes.Repeat([]byte{0xab}, 40),
+		},
+		{
+			name:   "bad checksum",
+			str:    "0xFb6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+			expErr: "invalid EIP-55 checksum: expected 0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+		},
+		{name: "no prefix", str: "fb6916095ca1df60bb79ce92ce3ea74c37c5d359", expErr: "missing 0x prefix"},
+		{name: "odd length", str: "0xabc", expErr: "encoding/hex: odd length hex string"},
+		{name: "not hex", str: "0xabcg", expErr: "encoding/hex: invalid byte: U+0067 'g'"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := DecodeEVMHex(tc.str)
+			AssertErrorContents(t, err, tc.expErr, "DecodeEVMHex(%q) error", tc.str)
+			assert.Equal(t, tc.exp, bz, "DecodeEVMHex(%q) result", tc.str)
+		})
+	}
+}
+
+func TestEncodeEVMHexLong(t *testing.T) {
+	// Only the first 64 digits get the checksum casing.
+	str := EncodeEVMHex(bytes.Repeat([]byte{0xab}, 40))
+	assert.Equal(t, strings.ToLower(str[66:]), str[66:], "digits after the first 64")
+	assert.NotEqual(t, strings.ToLower(str[2:66]), str[2:66], "first 64 digits")
+}
@@ -3,16 +3,18 @@ module github.com/SpicyLemon/bech32
 go 1.20
 
 require (
+	github.com/cosmos/btcutil v1.0.5
 	github.com/cosmos/cosmos-sdk v0.47.2
 	github.com/spf13/cobra v1.7.0
 	github.com/stretchr/testify v1.8.3
+	golang.org/x/crypto v0.9.0
 )
 
 require (
-	github.com/cosmos/btcutil v1.0.5 // indirect
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/inconshreveable/mousetrap v1.1.0 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	github.com/spf13/pflag v1.0.5 // indirect
+	golang.org/x/sys v0.8.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -0,0 +1,449 @@
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/btcutil/bech32"
+)
+
+// bech32Charset is the characters used in the data part of a bech32 string, in order of their values.
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// checksumLen is the number of characters in a bech32 checksum.
+const checksumLen = 6
+
+// MaxFixSubstitutions is the most characters that will be substituted when looking for possible fixes to a checksum.
+const MaxFixSubstitutions = 3
+
+// MaxFixes is the most possible fixes that will be provided for a single input.
+const MaxFixes = 5
+
+// A Variant is a type of bech32 checksum.
+type Variant string
+
+// String returns this Variant as a string.
+func (v Variant) String() string {
+	return string(v)
+}
+
+const (
+	// VariantBech32 is the original checksum defined in BIP-173.
+	VariantBech32 Variant = "bech32"
+	// VariantBech32m is the modified checksum defined in BIP-350.
+	VariantBech32m Variant = "bech32m"
+)
+
+// variantConsts are the values that the polymod of a valid string must have for each Variant.
+var variantConsts = []struct {
+	variant Variant
+	value   uint32
+}{
+	{variant: VariantBech32, value: 1},
+	{variant: VariantBech32m, value: 0x2bc830a3},
+}
+
+// SegwitHRPs are the HRPs that use segwit-style addresses (a witness version followed by a witness program).
+var SegwitHRPs = []string{"bc", "tb", "bcrt", "ltc", "tltc", "rltc"}
+
+// Fix is a possible correction to a bech32 string that has an invalid checksum or invalid characters.
+type Fix struct {
+	// Address is the corrected string.
+	Address string
+	// Variant is the checksum variant that the corrected string is valid for.
+	Variant Variant
+	// Positions are the (zero-based) indexes of the characters that were changed.
+	Positions []int
+}
+
+// Inspection is all the info found when inspecting a bech32 string.
+type Inspection struct {
+	// Input is the string that was inspected.
+	Input string
+	// HRP is the human readable part of the input (lower-case).
+	HRP string
+	// Variant is the checksum variant. It's empty if the checksum isn't valid for either variant.
+	Variant Variant
+	// DataChars is the number of characters in the data part (not including the checksum).
+	DataChars int
+	// Bytes is the data converted into bytes. For segwit-style addresses, this is the witness program.
+	Bytes []byte
+	// IsSegwit is true if the HRP is one that uses segwit-style addresses.
+	IsSegwit bool
+	// WitnessVersion is the witness version of a segwit-style address.
+	WitnessVersion int
+	// Errors are the problems found with the input.
+	Errors []string
+	// Fixes are possible corrections to the input, if it has an invalid checksum or characters.
+	Fixes []Fix
+	// LookedForFixes is true if the input was searched for possible fixes.
+	LookedForFixes bool
+}
+
+// IsValid returns true if no problems were found with the input.
+func (i *Inspection) IsValid() bool {
+	return len(i.Errors) == 0
+}
+
+// addErr adds a formatted error message to this Inspection.
+func (i *Inspection) addErr(format string, args ...interface{}) {
+	i.Errors = append(i.Errors, fmt.Sprintf(format, args...))
+}
+
+// InspectAddr decodes the provided bech32 string (without a length limit) and identifies any problems with it.
+// If the checksum is invalid (or there are invalid characters), it will look for possible fixes.
+func InspectAddr(input string) *Inspection {
+	rv := &Inspection{Input: input}
+
+	lower := strings.ToLower(input)
+	if lower != input && strings.ToUpper(input) != input {
+		rv.addErr("mixed case is not allowed")
+	}
+
+	sep := strings.LastIndexByte(lower, '1')
+	switch {
+	case sep < 0:
+		rv.addErr("no separator (1) found")
+		return rv
+	case sep == 0:
+		rv.addErr("empty HRP")
+		return rv
+	}
+	rv.HRP = lower[:sep]
+	for i, c := range rv.HRP {
+		if c < 33 || c > 126 {
+			rv.addErr("invalid HRP character %q at position %d", c, i)
+		}
+	}
+
+	dataPart := lower[sep+1:]
+	if len(dataPart) < checksumLen {
+		rv.addErr("data part is too short: has %d characters, must have at least %d", len(dataPart), checksumLen)
+		return rv
+	}
+
+	values := make([]byte, len(dataPart))
+	var invalid []int
+	for i := range dataPart {
+		v := strings.IndexByte(bech32Charset, dataPart[i])
+		if v < 0 {
+			rv.addErr("invalid character %q at position %d", dataPart[i], sep+1+i)
+			invalid = append(invalid, i)
+			continue
+		}
+		values[i] = byte(v)
+	}
+
+	if len(invalid) == 0 {
+		pm := bech32Polymod(append(hrpExpand(rv.HRP), values...))
+		for _, vc := range variantConsts {
+			if pm == vc.value {
+				rv.Variant = vc.variant
+			}
+		}
+	}
+	if len(rv.Variant) == 0 {
+		if len(invalid) == 0 {
+			rv.addErr("invalid checksum")
+		}
+		rv.Fixes = findFixes(input, sep, values, invalid)
+		rv.LookedForFixes = true
+		return rv
+	}
+
+	data := values[:len(values)-checksumLen]
+	rv.DataChars = len(data)
+	for _, hrp := range SegwitHRPs {
+		if rv.HRP == hrp {
+			rv.IsSegwit = true
+		}
+	}
+
+	if !rv.IsSegwit {
+		var err error
+		rv.Bytes, err = bech32.ConvertBits(data, 5, 8, false)
+		if err != nil {
+			rv.addErr("could not convert data to bytes: %v", err)
+		}
+		return rv
+	}
+
+	if len(data) == 0 {
+		rv.addErr("no witness version")
+		return rv
+	}
+	rv.WitnessVersion = int(data[0])
+	var err error
+	rv.Bytes, err = bech32.ConvertBits(data[1:], 5, 8, false)
+	if err != nil {
+		rv.addErr("could not convert witness program to bytes: %v", err)
+		return rv
+	}
+	switch {
+	case rv.WitnessVersion > 16:
+		rv.addErr("invalid witness version %d: must be between 0 and 16", rv.WitnessVersion)
+	case len(rv.Bytes) < 2 || len(rv.Bytes) > 40:
+		rv.addErr("invalid witness program length %d: must be between 2 and 40 bytes", len(rv.Bytes))
+	case rv.WitnessVersion == 0 && len(rv.Bytes) != 20 && len(rv.Bytes) != 32:
+		rv.addErr("invalid witness program length %d: must be 20 or 32 bytes for version 0", len(rv.Bytes))
+	}
+	switch {
+	case rv.WitnessVersion == 0 && rv.Variant != VariantBech32:
+		rv.addErr("witness version 0 must use %s, not %s", VariantBech32, rv.Variant)
+	case rv.WitnessVersion > 0 && rv.Variant != VariantBech32m:
+		rv.addErr("witness version %d must use %s, not %s", rv.WitnessVersion, VariantBech32m, rv.Variant)
+	}
+	return rv
+}
+
+// hrpExpand converts the provided HRP into the values used in the checksum calculation.
+func hrpExpand(hrp string) []byte {
+	rv := make([]byte, len(hrp)*2+1)
+	for i := range hrp {
+		rv[i] = hrp[i] >> 5
+		rv[len(hrp)+1+i] = hrp[i] & 31
+	}
+	return rv
+}
+
+// bech32Generators are the generator values used in the bech32 checksum calculation.
+var bech32Generators = []uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+
+// bech32Polymod calculates the bech32 checksum polymod of the provided values.
+func bech32Polymod(values []byte) uint32 {
+	return polymodFrom(1, values)
+}
+
+// polymodFrom calculates the bech32 checksum polymod of the provided values, starting with the provided value.
+// Starting with zero gives how much the values change the polymod,
+// which is how the effect of a substitution is calculated.
+func polymodFrom(chk uint32, values []byte) uint32 {
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i, gen := range bech32Generators {
+			if (top>>i)&1 == 1 {
+				chk ^= gen
+			}
+		}
+	}
+	return chk
+}
+
+// substitution is a change to a single character in the data part of a bech32 string.
+type substitution struct {
+	// index is the index of the character in the data part.
+	index int
+	// delta is xored with the character's value to get the new value.
+	delta byte
+	// effect is how much this substitution changes the polymod.
+	effect uint32
+}
+
+// findFixes looks for changes of up to MaxFixSubstitutions characters in the data part of the input
+// that would make it valid. The values are the data part values, and invalid has the indexes (in the data part)
+// of characters that aren't in the charset (those values are zero and must be part of any fix).
+// Only the fixes that change the fewest characters are returned.
+func findFixes(input string, sep int, values []byte, invalid []int) []Fix {
+	hrp := strings.ToLower(input[:sep])
+	pm := bech32Polymod(append(hrpExpand(hrp), values...))
+
+	isInvalid := make(map[int]bool, len(invalid))
+	for _, i := range invalid {
+		isInvalid[i] = true
+	}
+
+	// Substituting a value changes the polymod by an amount that only depends on the change and its position.
+	var substs []substitution
+	byEffect := make(map[uint32][]substitution)
+	zeros := make([]byte, len(values))
+	for i := range values {
+		minDelta := byte(1)
+		if isInvalid[i] {
+			// Invalid characters have a value of zero, which is also a possibility for the fix.
+			minDelta = 0
+		}
+		for delta := minDelta; delta < 32; delta++ {
+			s := substitution{index: i, delta: delta, effect: polymodFrom(uint32(delta), zeros[i+1:])}
+			substs = append(substs, s)
+			byEffect[s.effect] = append(byEffect[s.effect], s)
+		}
+	}
+
+	minCount := len(invalid)
+	if minCount == 0 {
+		minCount = 1
+	}
+	var rv []Fix
+	for count := minCount; count <= MaxFixSubstitutions && len(rv) == 0; count++ {
+		for _, vc := range variantConsts {
+			target := pm ^ vc.value
+			var search func(start int, chosen []substitution, effect uint32)
+			search = func(start int, chosen []substitution, effect uint32) {
+				if len(rv) >= MaxFixes {
+					return
+				}
+				if len(chosen) == count-1 {
+					for _, s := range byEffect[effect^target] {
+						if (len(chosen) == 0 || s.index > chosen[len(chosen)-1].index) && len(rv) < MaxFixes {
+							if fix, ok := newFix(input, sep, values, append(chosen, s), isInvalid, vc.variant); ok {
+								rv = append(rv, fix)
+							}
+						}
+					}
+					return
+				}
+				for j := start; j < len(substs); j++ {
+					s := substs[j]
+					if len(chosen) > 0 && s.index <= chosen[len(chosen)-1].index {
+						continue
+					}
+					search(j+1, append(chosen, s), effect^s.effect)
+				}
+			}
+			search(0, make([]substitution, 0, count), 0)
+		}
+	}
+	return rv
+}
+
+// newFix creates the Fix for the provided substitutions.
+// Returns false if the substitutions don't change all of the invalid characters.
+func newFix(input string, sep int, values []byte, substs []substitution, isInvalid map[int]bool, variant Variant) (Fix, bool) {
+	changed := 0
+	fixed := make([]byte, len(values))
+	copy(fixed, values)
+	positions := make([]int, len(substs))
+	for i, s := range substs {
+		if isInvalid[s.index] {
+			changed++
+		}
+		fixed[s.index] ^= s.delta
+		positions[i] = sep + 1 + s.index
+	}
+	if changed != len(isInvalid) {
+		return Fix{}, false
+	}
+
+	var sb strings.Builder
+	sb.WriteString(input[:sep+1])
+	for _, v := range fixed {
+		sb.WriteByte(bech32Charset[v])
+	}
+	addr := sb.String()
+	if strings.ToUpper(input) == input {
+		addr = strings.ToUpper(addr)
+	}
+	return Fix{Address: addr, Variant: variant, Positions: positions}, true
+}
+
+// inspectLabelFmt is the format used for each label in the inspection output.
+const inspectLabelFmt = "%-17s"
+
+// WriteInspection writes the details of the provided Inspection to the writer.
+func WriteInspection(w io.Writer, insp *Inspection) error {
+	var lines []string
+	addLine := func(label string, format string, args ...interface{}) {
+		lines = append(lines, fmt.Sprintf(inspectLabelFmt, label+":")+fmt.Sprintf(format, args...))
+	}
+
+	addLine("Input", "%s", insp.Input)
+	if len(insp.HRP) > 0 {
+		addLine("HRP", "%s", insp.HRP)
+	}
+	if len(insp.Variant) > 0 {
+		addLine("Variant", "%s", insp.Variant)
+		addLine("Data Length", "%d characters", insp.DataChars)
+		if insp.IsSegwit {
+			addLine("Witness Version", "%d", insp.WitnessVersion)
+		}
+		if insp.Bytes != nil {
+			label := "Bytes"
+			if insp.IsSegwit {
+				label = "Witness Program"
+			}
+			addLine(label, "%d: %s", len(insp.Bytes), strings.ToUpper(hex.EncodeToString(insp.Bytes)))
+		}
+	}
+	for _, err := range insp.Errors {
+		addLine("Error", "%s", err)
+	}
+	for _, fix := range insp.Fixes {
+		addLine("Possible Fix", "%s (%s)", fix.Address, fix.Variant)
+		marks := []byte(strings.Repeat(" ", len(fix.Address)))
+		for _, pos := range fix.Positions {
+			marks[pos] = '^'
+		}
+		lines = append(lines, fmt.Sprintf(inspectLabelFmt, "")+strings.TrimRight(string(marks), " "))
+	}
+	if insp.LookedForFixes && len(insp.Fixes) == 0 {
+		addLine("Possible Fix", "none found with up to %d substitutions", MaxFixSubstitutions)
+	}
+
+	for _, line := range lines {
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewInspectCmd creates the inspect sub-command.
+func NewInspectCmd() *cobra.Command {
+	cmdConfig := &CmdConfig{From: string(FromValBech32)}
+	cmd := &cobra.Command{
+		Use:   "inspect <addr> [<addr2> ...]",
+		Short: "Show the details of bech32 strings",
+		Long: `Show the details of bech32 strings: the HRP, the checksum variant (bech32 or bech32m),
+the data length, and the data bytes.
+
+For segwit-style addresses (HRPs: ` + strings.Join(SegwitHRPs, ", ") + `), the witness version and program are shown.
+
+If the checksum is invalid, or there are invalid characters, the string is searched for possible fixes.
+The fixes that change the fewest characters (up to ` + fmt.Sprintf("%d", MaxFixSubstitutions) + `) are shown,
+with the changed characters marked below them.
+
+An error is returned if any of the strings are invalid.`,
+		Example: `$ bech32 inspect cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu
+$ bech32 inspect bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0`,
+		PreRunE: cmdConfig.Prep,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return InspectAndPrintAll(cmdConfig)
+		},
+		SilenceUsage: true,
+	}
+	return cmd
+}
+
+// InspectAndPrintAll inspects and prints all the provided inputs.
+// Returns an error if any of them are invalid.
+func InspectAndPrintAll(cfg *CmdConfig) error {
+	bad := 0
+	for i, arg := range cfg.Inputs {
+		if i > 0 {
+			if _, err := fmt.Fprintln(cfg.Writer); err != nil {
+				return err
+			}
+		}
+		insp := InspectAddr(arg)
+		if !insp.IsValid() {
+			bad++
+		}
+		if err := WriteInspection(cfg.Writer, insp); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case bad == 0:
+		return nil
+	case cfg.Count == 1:
+		return errors.New("invalid bech32 string")
+	}
+	return fmt.Errorf("%d of %d bech32 strings are invalid", bad, cfg.Count)
+}
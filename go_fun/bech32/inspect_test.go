@@ -0,0 +1,428 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Some known-good addresses used in the inspect tests.
+const (
+	// goodCosmos is a cosmos address with the bytes 0x01 through 0x14.
+	goodCosmos = "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"
+	// goodSegwitV0 is a version 0 segwit address from BIP-173.
+	goodSegwitV0 = "BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4"
+	// goodSegwitV1 is a version 1 (taproot) segwit address from BIP-350.
+	goodSegwitV1 = "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0"
+)
+
+func TestInspectAddr(t *testing.T) {
+	cosmosBytes := []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}
+	cosmosFix := []Fix{{Address: goodCosmos, Variant: VariantBech32, Positions: []int{44}}}
+
+	tests := []struct {
+		name  string
+		input string
+		exp   *Inspection
+	}{
+		{
+			name:  "empty",
+			input: "",
+			exp:   &Inspection{Errors: []string{"no separator (1) found"}},
+		},
+		{
+			name:  "no separator",
+			input: "cosmosqypqxpq9",
+			exp:   &Inspection{Input: "cosmosqypqxpq9", Errors: []string{"no separator (1) found"}},
+		},
+		{
+			name:  "no hrp",
+			input: "1qypqxpq9",
+			exp:   &Inspection{Input: "1qypqxpq9", Errors: []string{"empty HRP"}},
+		},
+		{
+			name:  "data too short",
+			input: "abc1qypqx",
+			exp: &Inspection{
+				Input:  "abc1qypqx",
+				HRP:    "abc",
+				Errors: []string{"data part is too short: has 5 characters, must have at least 6"},
+			},
+		},
+		{
+			name:  "good cosmos",
+			input: goodCosmos,
+			exp:   &Inspection{Input: goodCosmos, HRP: "cosmos", Variant: VariantBech32, DataChars: 32, Bytes: cosmosBytes},
+		},
+		{
+			name:  "good cosmos upper",
+			input: strings.ToUpper(goodCosmos),
+			exp: &Inspection{
+				Input:     strings.ToUpper(goodCosmos),
+				HRP:       "cosmos",
+				Variant:   VariantBech32,
+				DataChars: 32,
+				Bytes:     cosmosBytes,
+			},
+		},
+		{
+			name:  "good from encoder",
+			input: mustBech32("twenty", bytes.Repeat([]byte{20}, 20)),
+			exp: &Inspection{
+				Input:     mustBech32("twenty", bytes.Repeat([]byte{20}, 20)),
+				HRP:       "twenty",
+				Variant:   VariantBech32,
+				DataChars: 32,
+				Bytes:     bytes.Repeat([]byte{20}, 20),
+			},
+		},
+		{
+			name:  "segwit v0",
+			input: goodSegwitV0,
+			exp: &Inspection{
+				Input:     goodSegwitV0,
+				HRP:       "bc",
+				Variant:   VariantBech32,
+				DataChars: 33,
+				Bytes: []byte{
+					0x75, 0x1e, 0x76, 0xe8, 0x19, 0x91, 0x96, 0xd4, 0x54, 0x94,
+					0x1c, 0x45, 0xd1, 0xb3, 0xa3, 0x23, 0xf1, 0x43, 0x3b, 0xd6,
+				},
+				IsSegwit:       true,
+				WitnessVersion: 0,
+			},
+		},
+		{
+			name:  "segwit v1",
+			input: goodSegwitV1,
+			exp: &Inspection{
+				Input:     goodSegwitV1,
+				HRP:       "bc",
+				Variant:   VariantBech32m,
+				DataChars: 53,
+				Bytes: []byte{
+					0x79, 0xbe, 0x66, 0x7e, 0xf9, 0xdc, 0xbb, 0xac, 0x55, 0xa0, 0x62, 0x95, 0xce, 0x87, 0x0b, 0x07,
+					0x02, 0x9b, 0xfc, 0xdb, 0x2d, 0xce, 0x28, 0xd9, 0x59, 0xf2, 0x81, 0x5b, 0x16, 0xf8, 0x17, 0x98,
+				},
+				IsSegwit:       true,
+				WitnessVersion: 1,
+			},
+		},
+		{
+			// This is an invalid address from BIP-350: version 0 with a bech32m checksum.
+			name:  "segwit v0 with bech32m",
+			input: "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kemeawh",
+			exp: &Inspection{
+				Input:     "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kemeawh",
+				HRP:       "bc",
+				Variant:   VariantBech32m,
+				DataChars: 33,
+				Bytes: []byte{
+					0x75, 0x1e, 0x76, 0xe8, 0x19, 0x91, 0x96, 0xd4, 0x54, 0x94,
+					0x1c, 0x45, 0xd1, 0xb3, 0xa3, 0x23, 0xf1, 0x43, 0x3b, 0xd6,
+				},
+				IsSegwit:       true,
+				WitnessVersion: 0,
+				Errors:         []string{"witness version 0 must use bech32, not bech32m"},
+			},
+		},
+		{
+			// This is an invalid address from BIP-350: version 1 with a bech32 checksum.
+			name:  "segwit v1 with bech32",
+			input: "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqh2y7hd",
+			exp: &Inspection{
+				Input:     "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqh2y7hd",
+				HRP:       "bc",
+				Variant:   VariantBech32,
+				DataChars: 53,
+				Bytes: []byte{
+					0x79, 0xbe, 0x66, 0x7e, 0xf9, 0xdc, 0xbb, 0xac, 0x55, 0xa0, 0x62, 0x95, 0xce, 0x87, 0x0b, 0x07,
+					0x02, 0x9b, 0xfc, 0xdb, 0x2d, 0xce, 0x28, 0xd9, 0x59, 0xf2, 0x81, 0x5b, 0x16, 0xf8, 0x17, 0x98,
+				},
+				IsSegwit:       true,
+				WitnessVersion: 1,
+				Errors:         []string{"witness version 1 must use bech32m, not bech32"},
+			},
+		},
+		{
+			name:  "mixed case",
+			input: "Cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu",
+			exp: &Inspection{
+				Input:     "Cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu",
+				HRP:       "cosmos",
+				Variant:   VariantBech32,
+				DataChars: 32,
+				Bytes:     cosmosBytes,
+				Errors:    []string{"mixed case is not allowed"},
+			},
+		},
+		{
+			name:  "one substitution in checksum",
+			input: goodCosmos[:44] + "v",
+			exp: &Inspection{
+				Input:          goodCosmos[:44] + "v",
+				HRP:            "cosmos",
+				Errors:         []string{"invalid checksum"},
+				Fixes:          cosmosFix,
+				LookedForFixes: true,
+			},
+		},
+		{
+			name:  "one substitution in data",
+			input: goodCosmos[:10] + "z" + goodCosmos[11:],
+			exp: &Inspection{
+				Input:          goodCosmos[:10] + "z" + goodCosmos[11:],
+				HRP:            "cosmos",
+				Errors:         []string{"invalid checksum"},
+				Fixes:          []Fix{{Address: goodCosmos, Variant: VariantBech32, Positions: []int{10}}},
+				LookedForFixes: true,
+			},
+		},
+		{
+			name:  "two substitutions",
+			input: goodCosmos[:27] + "7" + goodCosmos[28:44] + "v",
+			exp: &Inspection{
+				Input:          goodCosmos[:27] + "7" + goodCosmos[28:44] + "v",
+				HRP:            "cosmos",
+				Errors:         []string{"invalid checksum"},
+				Fixes:          []Fix{{Address: goodCosmos, Variant: VariantBech32, Positions: []int{27, 44}}},
+				LookedForFixes: true,
+			},
+		},
+		{
+			name:  "three substitutions",
+			input: goodCosmos[:27] + "7" + goodCosmos[28:42] + "8xv",
+			exp: &Inspection{
+				Input:          goodCosmos[:27] + "7" + goodCosmos[28:42] + "8xv",
+				HRP:            "cosmos",
+				Errors:         []string{"invalid checksum"},
+				Fixes:          []Fix{{Address: goodCosmos, Variant: VariantBech32, Positions: []int{27, 42, 44}}},
+				LookedForFixes: true,
+			},
+		},
+		{
+			name:  "upper case fix",
+			input: strings.ToUpper(goodCosmos[:44] + "v"),
+			exp: &Inspection{
+				Input:          strings.ToUpper(goodCosmos[:44] + "v"),
+				HRP:            "cosmos",
+				Errors:         []string{"invalid checksum"},
+				Fixes:          []Fix{{Address: strings.ToUpper(goodCosmos), Variant: VariantBech32, Positions: []int{44}}},
+				LookedForFixes: true,
+			},
+		},
+		{
+			name:  "bech32m fix",
+			input: goodSegwitV1[:20] + "q" + goodSegwitV1[21:],
+			exp: &Inspection{
+				Input:          goodSegwitV1[:20] + "q" + goodSegwitV1[21:],
+				HRP:            "bc",
+				Errors:         []string{"invalid checksum"},
+				Fixes:          []Fix{{Address: goodSegwitV1, Variant: VariantBech32m, Positions: []int{20}}},
+				LookedForFixes: true,
+			},
+		},
+		{
+			name:  "invalid character",
+			input: goodCosmos[:10] + "b" + goodCosmos[11:],
+			exp: &Inspection{
+				Input:          goodCosmos[:10] + "b" + goodCosmos[11:],
+				HRP:            "cosmos",
+				Errors:         []string{"invalid character 'b' at position 10"},
+				Fixes:          []Fix{{Address: goodCosmos, Variant: VariantBech32, Positions: []int{10}}},
+				LookedForFixes: true,
+			},
+		},
+		{
+			name:  "invalid character and substitution",
+			input: goodCosmos[:10] + "i" + goodCosmos[11:44] + "v",
+			exp: &Inspection{
+				Input:          goodCosmos[:10] + "i" + goodCosmos[11:44] + "v",
+				HRP:            "cosmos",
+				Errors:         []string{"invalid character 'i' at position 10"},
+				Fixes:          []Fix{{Address: goodCosmos, Variant: VariantBech32, Positions: []int{10, 44}}},
+				LookedForFixes: true,
+			},
+		},
+		{
+			name:  "too many invalid characters",
+			input: "cosmos1bbbbqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu",
+			exp: &Inspection{
+				Input: "cosmos1bbbbqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu",
+				HRP:   "cosmos",
+				Errors: []string{
+					"invalid character 'b' at position 7",
+					"invalid character 'b' at position 8",
+					"invalid character 'b' at position 9",
+					"invalid character 'b' at position 10",
+				},
+				LookedForFixes: true,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var act *Inspection
+			testFunc := func() {
+				act = InspectAddr(tc.input)
+			}
+			require.NotPanics(t, testFunc, "InspectAddr(%q)", tc.input)
+			assert.Equal(t, tc.exp, act, "InspectAddr(%q) result", tc.input)
+			assert.Equal(t, len(tc.exp.Errors) == 0, act.IsValid(), "IsValid()")
+		})
+	}
+}
+
+func TestWriteInspection(t *testing.T) {
+	tests := []struct {
+		name string
+		insp *Inspection
+		exp  []string
+	}{
+		{
+			name: "no separator",
+			insp: InspectAddr("nope"),
+			exp: []string{
+				"Input:           nope",
+				"Error:           no separator (1) found",
+			},
+		},
+		{
+			name: "good cosmos",
+			insp: InspectAddr(goodCosmos),
+			exp: []string{
+				"Input:           " + goodCosmos,
+				"HRP:             cosmos",
+				"Variant:         bech32",
+				"Data Length:     32 characters",
+				"Bytes:           20: 0102030405060708090A0B0C0D0E0F1011121314",
+			},
+		},
+		{
+			name: "segwit",
+			insp: InspectAddr(goodSegwitV1),
+			exp: []string{
+				"Input:           " + goodSegwitV1,
+				"HRP:             bc",
+				"Variant:         bech32m",
+				"Data Length:     53 characters",
+				"Witness Version: 1",
+				"Witness Program: 32: 79BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798",
+			},
+		},
+		{
+			name: "fix",
+			insp: InspectAddr(goodCosmos[:27] + "7" + goodCosmos[28:44] + "v"),
+			exp: []string{
+				"Input:           " + goodCosmos[:27] + "7" + goodCosmos[28:44] + "v",
+				"HRP:             cosmos",
+				"Error:           invalid checksum",
+				"Possible Fix:    " + goodCosmos + " (bech32)",
+				"                 " + strings.Repeat(" ", 27) + "^" + strings.Repeat(" ", 16) + "^",
+			},
+		},
+		{
+			name: "no fix",
+			insp: InspectAddr("cosmos1bbbbqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"),
+			exp: []string{
+				"Input:           cosmos1bbbbqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu",
+				"HRP:             cosmos",
+				"Error:           invalid character 'b' at position 7",
+				"Error:           invalid character 'b' at position 8",
+				"Error:           invalid character 'b' at position 9",
+				"Error:           invalid character 'b' at position 10",
+				"Possible Fix:    none found with up to 3 substitutions",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var buffer bytes.Buffer
+			err := WriteInspection(&buffer, tc.insp)
+			require.NoError(t, err, "WriteInspection")
+			outLines := strings.Split(strings.TrimSuffix(buffer.String(), "\n"), "\n")
+			assert.Equal(t, tc.exp, outLines, "WriteInspection output")
+		})
+	}
+}
+
+func TestInspectCmd(t *testing.T) {
+	tests := []struct {
+		name   string
+		args   []string
+		stdin  string
+		expErr string
+		expOut []string
+	}{
+		{
+			name: "one good",
+			args: []string{"inspect", goodCosmos},
+			expOut: []string{
+				"Input:           " + goodCosmos,
+				"HRP:             cosmos",
+				"Variant:         bech32",
+				"Data Length:     32 characters",
+				"Bytes:           20: 0102030405060708090A0B0C0D0E0F1011121314",
+			},
+		},
+		{
+			name:   "one bad",
+			args:   []string{"inspect", "nope"},
+			expErr: "invalid bech32 string",
+			expOut: []string{
+				"Input:           nope",
+				"Error:           no separator (1) found",
+			},
+		},
+		{
+			name:   "from stdin one good one bad",
+			args:   []string{"inspect"},
+			stdin:  goodCosmos + "\nnope\n",
+			expErr: "1 of 2 bech32 strings are invalid",
+			expOut: []string{
+				"Input:           " + goodCosmos,
+				"HRP:             cosmos",
+				"Variant:         bech32",
+				"Data Length:     32 characters",
+				"Bytes:           20: 0102030405060708090A0B0C0D0E0F1011121314",
+				"",
+				"Input:           nope",
+				"Error:           no separator (1) found",
+			},
+		},
+		{
+			name:   "nothing to inspect",
+			args:   []string{"inspect"},
+			expErr: "no input addresses provided",
+		},
+		{
+			name:   "root command still converts",
+			args:   []string{goodCosmos, "--hrp", "abc"},
+			expOut: []string{mustBech32("abc", []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20})},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := NewRootCmd()
+			var outBuffer bytes.Buffer
+			cmd.SetOut(&outBuffer)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetIn(strings.NewReader(tc.stdin))
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			AssertErrorContents(t, err, tc.expErr, "Execute error")
+			var outLines []string
+			if outBuffer.Len() > 0 {
+				outLines = strings.Split(strings.TrimSuffix(outBuffer.String(), "\n"), "\n")
+			}
+			assert.Equal(t, tc.expOut, outLines, "Execute output")
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"bytes"
+	"encoding/base32"
 	"encoding/base64"
 	"encoding/hex"
 	"errors"
@@ -11,22 +13,45 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/cosmos/btcutil/base58"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 )
 
 // CmdConfig contains all the flags and info about the command being run.
 type CmdConfig struct {
 	// ToHRPs are the strings provided with the --hrp flag(s).
+	// During Prep, the HRPs from the HRPFamilies and Chains are added to it.
 	ToHRPs []string
+	// HRPFamilies are the prefixes provided with the --hrp-family flag(s).
+	HRPFamilies []string
+	// Chains are the chain names provided with the --chain flag(s).
+	Chains []string
 	// ToHex indicates the presence of the --hex flag.
 	ToHex bool
 	// ToBase64 indicates the presence of the --base64 flag.
 	ToBase64 bool
+	// ToBase64URL indicates the presence of the --base64url flag.
+	ToBase64URL bool
+	// ToBase32 indicates the presence of the --base32 flag.
+	ToBase32 bool
+	// ToBase58 indicates the presence of the --base58 flag.
+	ToBase58 bool
+	// ToBase58Check indicates the presence of the --base58check flag.
+	ToBase58Check bool
+	// ToEVM indicates the presence of the --evm flag.
+	ToEVM bool
 	// ToRaw indicates the presence of the --raw flag.
 	ToRaw bool
 
 	// Quiet indicates the presence of the --quiet flag.
 	Quiet bool
+	// KeepGoing indicates the presence of the --keep-going flag.
+	KeepGoing bool
+
+	// Output is the string provided with the --output flag.
+	Output string
+	// OutputFmt is the normalized version of the Output field.
+	OutputFmt OutputFmt
 
 	// From is the string provided with the --from arg.
 	From string
@@ -35,6 +60,8 @@ type CmdConfig struct {
 
 	// Writer is what's used for printing the output.
 	Writer io.Writer
+	// ErrWriter is what's used for printing errors when using --keep-going with text output.
+	ErrWriter io.Writer
 	// Count is a count of the args provided.
 	Count int
 	// Inputs is all the input strings to convert
@@ -44,6 +71,7 @@ type CmdConfig struct {
 // Prep sets up the final stuff needed in the CmdConfig before trying to do stuff.
 func (c *CmdConfig) Prep(cmd *cobra.Command, args []string) error {
 	c.Writer = cmd.OutOrStdout()
+	c.ErrWriter = cmd.ErrOrStderr()
 
 	c.Inputs = args
 	c.Count = len(c.Inputs)
@@ -54,6 +82,17 @@ func (c *CmdConfig) Prep(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	c.OutputFmt, err = ToOutputFmt(c.Output)
+	if err != nil {
+		return err
+	}
+
+	moreHRPs, err := ExpandHRPs(c.ToHRPs, c.HRPFamilies, c.Chains)
+	if err != nil {
+		return err
+	}
+	c.ToHRPs = append(c.ToHRPs, moreHRPs...)
+
 	if len(c.Inputs) == 0 {
 		// Read from stdin if either:
 		// a) it's being piped too (e.g. <cmd1> | bech32)
@@ -92,7 +131,8 @@ func (c *CmdConfig) Prep(cmd *cobra.Command, args []string) error {
 
 // OutputTypeDefined returns true if any output types have been dictated.
 func (c *CmdConfig) OutputTypeDefined() bool {
-	return len(c.ToHRPs) > 0 || c.ToHex || c.ToBase64 || c.ToRaw
+	return len(c.ToHRPs) > 0 || c.ToHex || c.ToBase64 || c.ToRaw ||
+		c.ToBase64URL || c.ToBase32 || c.ToBase58 || c.ToBase58Check || c.ToEVM
 }
 
 // A FromVal is a valid string to provide with the --from flag.
@@ -110,15 +150,31 @@ const (
 	FromValBech32 FromVal = "bech32"
 	// FromValBase64 decodes the input as a base64 encoded string.
 	FromValBase64 FromVal = "base64"
+	// FromValBase64URL decodes the input as a URL-safe base64 encoded string (RFC 4648 section 5).
+	FromValBase64URL FromVal = "base64url"
+	// FromValBase32 decodes the input as a base32 encoded string (RFC 4648 section 6).
+	FromValBase32 FromVal = "base32"
+	// FromValBase58 decodes the input as a base58 string (e.g. Solana addresses).
+	FromValBase58 FromVal = "base58"
+	// FromValBase58Check decodes the input as a base58 string with a checksum (e.g. Bitcoin addresses).
+	FromValBase58Check FromVal = "base58check"
 	// FromValHex decodes the input as a hex string.
 	FromValHex FromVal = "hex"
+	// FromValEVM decodes the input as a 0x prefixed hex string, validating any EIP-55 checksum.
+	FromValEVM FromVal = "evm"
 	// FromValRaw indicates that the input is raw and should not be decoded.
 	FromValRaw FromVal = "raw"
+	// FromValPubKey indicates that the input is a public key (bech32, hex, or base64) to get the address of.
+	FromValPubKey FromVal = "pubkey"
+	// FromValModule indicates that the input is a module name to get the module account address of.
+	FromValModule FromVal = "module"
 )
 
 // FromValOptionsStr is a string indicating all the valid --from options.
 var FromValOptionsStr = `"` + strings.Join([]string{
-	FromValDetect.String(), FromValBech32.String(), FromValBase64.String(), FromValHex.String(), FromValRaw.String(),
+	FromValDetect.String(), FromValBech32.String(), FromValBase64.String(), FromValBase64URL.String(),
+	FromValBase32.String(), FromValBase58.String(), FromValBase58Check.String(), FromValHex.String(),
+	FromValEVM.String(), FromValRaw.String(), FromValPubKey.String(), FromValModule.String(),
 }, `" "`) + `"`
 
 // ToFromVal converts the provided string into a FromVal or returns an error.
@@ -130,14 +186,49 @@ func ToFromVal(str string) (FromVal, error) {
 		return FromValBech32, nil
 	case string(FromValBase64), "b64", "64":
 		return FromValBase64, nil
+	case string(FromValBase64URL), "b64url", "url64", "base64-url":
+		return FromValBase64URL, nil
+	case string(FromValBase32), "base-32", "rfc4648":
+		return FromValBase32, nil
+	case string(FromValBase58), "b58", "58":
+		return FromValBase58, nil
+	case string(FromValBase58Check), "b58check", "b58c", "58check", "base58-check":
+		return FromValBase58Check, nil
 	case string(FromValHex), "h", "x":
 		return FromValHex, nil
+	case string(FromValEVM), "eip55", "eth", "0x":
+		return FromValEVM, nil
 	case string(FromValRaw), "r":
 		return FromValRaw, nil
+	case string(FromValPubKey), "pub", "pk", "key":
+		return FromValPubKey, nil
+	case string(FromValModule), "mod", "m":
+		return FromValModule, nil
 	}
 	return FromValDetect, fmt.Errorf("invalid --from value %q, must be one of %s", str, FromValOptionsStr)
 }
 
+// Decoders are the functions used to decode the input for each of the --from values that are an encoding.
+var Decoders = map[FromVal]func(string) ([]byte, error){
+	FromValBech32: func(str string) ([]byte, error) {
+		_, bz, err := bech32.DecodeAndConvert(str)
+		return bz, err
+	},
+	FromValBase64:      base64.StdEncoding.DecodeString,
+	FromValBase64URL:   base64.URLEncoding.DecodeString,
+	FromValBase32:      base32.StdEncoding.DecodeString,
+	FromValBase58:      DecodeBase58,
+	FromValBase58Check: DecodeBase58Check,
+	FromValHex:         hex.DecodeString,
+	FromValEVM:         DecodeEVMHex,
+}
+
+// DetectOrder are the types that are tried (in order) when detecting the input type.
+// Plain base58 isn't included because too many hex and base64 strings are also valid base58.
+var DetectOrder = []FromVal{
+	FromValBech32, FromValBase64, FromValBase64URL, FromValBase32, FromValBase58Check, FromValHex, FromValEVM,
+}
+
 // NewRootCmd creates the root bech32 command.
 func NewRootCmd() *cobra.Command {
 	cmdConfig := &CmdConfig{}
@@ -146,30 +237,89 @@ func NewRootCmd() *cobra.Command {
 		Short: "Convert bech32 strings",
 		Long: `Convert bech32 strings to hex, base64, or new HRPs.
 
-If none of --hrp --base64 --hex or --raw are provided, --hex is used.
+If none of --hrp --base64 --base64url --base32 --base58 --base58check --hex --evm or --raw are provided, --hex is used.
+
+By default, the input type is detected from: bech32, base64, base64url, base32, base58check, hex, or evm.
+Plain base58 is never detected, so --from base58 is needed for it (e.g. Solana addresses).
+With --from evm, inputs must start with 0x, and mixed-case inputs must have a valid EIP-55 checksum.
+
+With --from pubkey, each input is a secp256k1 or ed25519 public key (bech32, hex, or base64),
+and the output is the account address of that key.
+With --from module, each input is a module name (e.g. gov), and the output is that module's account address.
 The --hrp flag can be provided multiple times.
 Multiple HRPs can be provided after --hrp by separating each with commas.
 
+The --hrp-family flag adds all the standard Cosmos HRPs for a prefix, e.g. --hrp-family cosmos adds:
+  cosmos cosmospub cosmosvaloper cosmosvaloperpub cosmosvalcons cosmosvalconspub
+The --chain flag does the same thing using the prefix of a known chain, e.g. --chain osmosis is --hrp-family osmo.
+Use the chains command to list the known chains.
+Both can be provided multiple times or with comma separated values, and are added after the --hrp ones.
+
 When multiple output types are requested, they will be in this order:
   1. Bech32(s) in the order the HRPs were provided
   2. Base64
-  3. Hex
-  4. Raw`,
+  3. Base64 URL-safe
+  4. Base32
+  5. Base58
+  6. Base58check
+  7. Hex
+  8. EVM hex
+  9. Raw
+
+The --output flag changes the output format:
+  text: (default) One line per output; with multiple inputs, each line is "[<i>/<count>] <input> => <output>".
+  json: One JSON object per input (each on its own line) with the input, the type it was decoded from,
+        each requested encoding, and any error.
+  csv:  A header line, then one line per input with the input, the type it was decoded from,
+        each requested encoding, and any error.
+  tsv:  Same as csv, but with tabs instead of commas.
+
+By default, processing stops at the first input that cannot be converted.
+With --keep-going, the rest of the inputs are still processed, and an error is returned at the end.
+Failures are included in the json, csv, and tsv output, and printed to stderr for text output.`,
 		Example: `$ bech32 xyz1q5zs2pg9q5zs2pg9q5zs2pg9q5zs2pg9fzxqpn --hrp abc
 $ bech32 0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b --hrp abc,def --from hex
-$ bech32 5c5c5c5c5c5c --hrp abc --hrp def --hex --from base64`,
+$ bech32 5c5c5c5c5c5c --hrp abc --hrp def --hex --from base64
+$ bech32 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed --hrp cosmos --base58check
+$ bech32 0279BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798 --from pubkey --hrp cosmos
+$ bech32 gov distribution --from module --hrp cosmos
+$ bech32 cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu --hrp-family cosmos --chain osmosis
+$ cat addresses.txt | bech32 --hrp cosmos --hex --output csv --keep-going
+$ bech32 inspect xyz1q5zs2pg9q5zs2pg9q5zs2pg9q5zs2pg9fzxqpn`,
+		Args:    cobra.ArbitraryArgs,
 		PreRunE: cmdConfig.Prep,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			return ConvertAndPrintAll(cmdConfig)
 		},
 		SilenceUsage: true,
 	}
+	cmd.CompletionOptions.DisableDefaultCmd = true
+	cmd.AddCommand(NewInspectCmd(), NewChainsCmd())
 
 	cmd.Flags().StringSliceVar(&cmdConfig.ToHRPs, "hrp", cmdConfig.ToHRPs, "Output address(es) as bech32 with provided HRPs")
+	cmd.Flags().StringSliceVar(&cmdConfig.HRPFamilies, "hrp-family", cmdConfig.HRPFamilies,
+		"Output address(es) as bech32 with all the standard HRPs for the provided prefixes",
+	)
+	cmd.Flags().StringSliceVar(&cmdConfig.Chains, "chain", cmdConfig.Chains,
+		"Output address(es) as bech32 with all the standard HRPs for the provided chains",
+	)
 	cmd.Flags().BoolVarP(&cmdConfig.ToBase64, "base64", "b", cmdConfig.ToHex, "Output address(es) as base64")
+	cmd.Flags().BoolVar(&cmdConfig.ToBase64URL, "base64url", cmdConfig.ToBase64URL, "Output address(es) as URL-safe base64")
+	cmd.Flags().BoolVar(&cmdConfig.ToBase32, "base32", cmdConfig.ToBase32, "Output address(es) as base32")
+	cmd.Flags().BoolVar(&cmdConfig.ToBase58, "base58", cmdConfig.ToBase58, "Output address(es) as base58")
+	cmd.Flags().BoolVar(&cmdConfig.ToBase58Check, "base58check", cmdConfig.ToBase58Check,
+		"Output address(es) as base58 with a checksum",
+	)
 	cmd.Flags().BoolVarP(&cmdConfig.ToHex, "hex", "x", cmdConfig.ToHex, "Output address(es) as hex")
+	cmd.Flags().BoolVar(&cmdConfig.ToEVM, "evm", cmdConfig.ToEVM, "Output address(es) as 0x hex with an EIP-55 checksum")
 	cmd.Flags().BoolVarP(&cmdConfig.ToRaw, "raw", "r", cmdConfig.ToRaw, "Output raw address(es) bytes")
 	cmd.Flags().BoolVarP(&cmdConfig.Quiet, "quiet", "q", cmdConfig.Quiet, "Only print the converted output")
+	cmd.Flags().BoolVarP(&cmdConfig.KeepGoing, "keep-going", "k", cmdConfig.KeepGoing,
+		"Keep processing inputs after one cannot be converted",
+	)
+	cmd.Flags().StringVarP(&cmdConfig.Output, "output", "o", string(OutputFmtText),
+		"The output format, options: "+OutputFmtOptionsStr,
+	)
 	cmd.Flags().StringVar(&cmdConfig.From, "from", string(FromValDetect),
 		"The type of strings being provided, options: "+FromValOptionsStr,
 	)
@@ -179,14 +329,26 @@ $ bech32 5c5c5c5c5c5c --hrp abc --hrp def --hex --from base64`,
 
 // ConvertAndPrintAll converts and prints all the provided args.
 func ConvertAndPrintAll(cfg *CmdConfig) error {
+	if cfg.OutputFmt != OutputFmtText && len(cfg.OutputFmt) > 0 {
+		return PrintRecords(cfg)
+	}
+
+	failed := 0
 	for i, arg := range cfg.Inputs {
 		err := ConvertAndPrint(cfg, arg, i+1)
 		if err != nil {
-			return err
+			if !cfg.KeepGoing {
+				return err
+			}
+			failed++
+			_, err = fmt.Fprintf(cfg.ErrWriter, "Error: %v\n", err)
+			if err != nil {
+				return err
+			}
 		}
 	}
 
-	return nil
+	return failedErr(failed, cfg.Count)
 }
 
 // ConvertAndPrint converts the provided argument and prints results to the provided writer.
@@ -217,65 +379,88 @@ func ConvertAndPrint(cfg *CmdConfig, arg string, i int) error {
 
 // GetAddrBytes decodes the provided address string.
 func GetAddrBytes(cfg *CmdConfig, input string) ([]byte, error) {
+	addr, _, err := DecodeAddr(cfg, input)
+	return addr, err
+}
+
+// DecodeAddr decodes the provided address string, also returning the type that it was decoded from.
+// The type is empty if the input is empty.
+func DecodeAddr(cfg *CmdConfig, input string) ([]byte, FromVal, error) {
 	if len(input) == 0 {
-		return []byte{}, nil
+		return []byte{}, "", nil
 	}
 
-	if cfg.FromVal == FromValRaw {
-		return []byte(input), nil
+	switch cfg.FromVal {
+	case FromValRaw:
+		return []byte(input), FromValRaw, nil
+	case FromValPubKey:
+		addr, err := GetPubKeyAddrBytes(input)
+		return addr, FromValPubKey, err
+	case FromValModule:
+		return ModuleAddr(input), FromValModule, nil
 	}
 
 	isDetect := cfg.FromVal == FromValDetect || len(cfg.FromVal)+len(cfg.From) == 0
 
-	var addr, addrTmp []byte
-	var err error
-	okTypes := make([]string, 0, 3)
-
-	if cfg.FromVal == FromValBech32 || isDetect {
-		_, addrTmp, err = bech32.DecodeAndConvert(input)
-		if err == nil {
-			okTypes = append(okTypes, FromValBech32.String())
-			addr = addrTmp
-		}
-	}
-
-	if cfg.FromVal == FromValBase64 || isDetect {
-		addrTmp, err = base64.StdEncoding.DecodeString(input)
-		if err == nil {
-			okTypes = append(okTypes, FromValBase64.String())
-			addr = addrTmp
+	if !isDetect {
+		decode, ok := Decoders[cfg.FromVal]
+		if !ok {
+			return nil, cfg.FromVal, fmt.Errorf("invalid --from value %q, must be one of %s", cfg.FromVal, FromValOptionsStr)
 		}
-	}
-
-	if cfg.FromVal == FromValHex || isDetect {
-		addrTmp, err = hex.DecodeString(input)
-		if err == nil {
-			okTypes = append(okTypes, FromValHex.String())
-			addr = addrTmp
+		addr, err := decode(input)
+		if err != nil {
+			return nil, cfg.FromVal, fmt.Errorf("could not decode %q as %s: %w", input, cfg.FromVal, err)
 		}
+		return addr, cfg.FromVal, nil
 	}
 
-	if !isDetect {
+	var okTypes []string
+	var okAddrs [][]byte
+types:
+	for _, fromVal := range DetectOrder {
+		addr, err := Decoders[fromVal](input)
 		if err != nil {
-			return nil, fmt.Errorf("could not decode %q as %s: %w", input, cfg.FromVal, err)
+			continue
+		}
+		// Two types that decode to the same bytes aren't ambiguous, e.g. base64 without any + or /
+		// is also valid base64url. In such cases, the first type is the one identified.
+		for _, okAddr := range okAddrs {
+			if bytes.Equal(addr, okAddr) {
+				continue types
+			}
 		}
-		return addr, nil
+		okTypes = append(okTypes, fromVal.String())
+		okAddrs = append(okAddrs, addr)
 	}
 
 	switch len(okTypes) {
 	case 0:
-		return nil, fmt.Errorf("could not decode %q as bech32, hex, or base64", input)
+		// A 0x prefix can only be evm, so the evm error (e.g. a bad checksum) is more helpful than a list of types.
+		if strings.HasPrefix(input, "0x") || strings.HasPrefix(input, "0X") {
+			_, err := DecodeEVMHex(input)
+			return nil, FromValDetect, fmt.Errorf("could not decode %q as %s: %w", input, FromValEVM, err)
+		}
+		return nil, FromValDetect, fmt.Errorf("could not decode %q as any of %s", input, detectTypesStr())
 	case 1:
-		return addr, nil
+		return okAddrs[0], FromVal(okTypes[0]), nil
 	default:
-		return nil, fmt.Errorf(`could not detect %q type between "%s"`, input, strings.Join(okTypes, `" "`))
+		return nil, FromValDetect, fmt.Errorf(`could not detect %q type between "%s"`, input, strings.Join(okTypes, `" "`))
+	}
+}
+
+// detectTypesStr gets a string of all the types that are tried when detecting the input type.
+func detectTypesStr() string {
+	names := make([]string, len(DetectOrder))
+	for i, fromVal := range DetectOrder {
+		names[i] = fromVal.String()
 	}
+	return `"` + strings.Join(names, `" "`) + `"`
 }
 
 // EncodeAddr encodes the provided address as desired.
 func EncodeAddr(cfg *CmdConfig, addr []byte) ([]string, error) {
 	var err error
-	rv := make([]string, len(cfg.ToHRPs), len(cfg.ToHRPs)+3)
+	rv := make([]string, len(cfg.ToHRPs), len(cfg.ToHRPs)+8)
 	for i, hrp := range cfg.ToHRPs {
 		rv[i], err = bech32.ConvertAndEncode(hrp, addr)
 		if err != nil {
@@ -285,9 +470,24 @@ func EncodeAddr(cfg *CmdConfig, addr []byte) ([]string, error) {
 	if cfg.ToBase64 {
 		rv = append(rv, base64.StdEncoding.EncodeToString(addr))
 	}
+	if cfg.ToBase64URL {
+		rv = append(rv, base64.URLEncoding.EncodeToString(addr))
+	}
+	if cfg.ToBase32 {
+		rv = append(rv, base32.StdEncoding.EncodeToString(addr))
+	}
+	if cfg.ToBase58 {
+		rv = append(rv, base58.Encode(addr))
+	}
+	if cfg.ToBase58Check {
+		rv = append(rv, EncodeBase58Check(addr))
+	}
 	if cfg.ToHex || !cfg.OutputTypeDefined() {
 		rv = append(rv, strings.ToUpper(hex.EncodeToString(addr)))
 	}
+	if cfg.ToEVM {
+		rv = append(rv, EncodeEVMHex(addr))
+	}
 	if cfg.ToRaw {
 		rv = append(rv, string(addr))
 	}
@@ -53,6 +53,7 @@ func TestCmdConfig_Prep(t *testing.T) {
 		expFromVal FromVal
 		expCount   int
 		expInputs  []string
+		expHRPs    []string
 	}{
 		{
 			name:       "invalid from",
@@ -94,6 +95,28 @@ func TestCmdConfig_Prep(t *testing.T) {
 			expCount:   1,
 			expInputs:  []string{"0a 0b"},
 		},
+		{
+			name:       "hrp family and chain",
+			cfg:        &CmdConfig{ToHRPs: []string{"osmo"}, HRPFamilies: []string{"abc"}, Chains: []string{"osmosis"}},
+			args:       []string{"0a"},
+			expFromVal: FromValDetect,
+			expCount:   1,
+			expInputs:  []string{"0a"},
+			expHRPs: []string{
+				"osmo",
+				"abc", "abcpub", "abcvaloper", "abcvaloperpub", "abcvalcons", "abcvalconspub",
+				"osmopub", "osmovaloper", "osmovaloperpub", "osmovalcons", "osmovalconspub",
+			},
+		},
+		{
+			name:       "unknown chain",
+			cfg:        &CmdConfig{Chains: []string{"nope"}},
+			args:       []string{"0a"},
+			expErr:     `unknown chain "nope": use the chains command to list the known chains`,
+			expFromVal: FromValDetect,
+			expCount:   1,
+			expInputs:  []string{"0a"},
+		},
 		{
 			name:       "one arg two stdin",
 			cfg:        &CmdConfig{},
@@ -122,6 +145,7 @@ func TestCmdConfig_Prep(t *testing.T) {
 			assert.Equal(t, tc.expCount, tc.cfg.Count, "Count")
 			assert.Equal(t, tc.expFromVal, tc.cfg.FromVal, "FromVal")
 			assert.Equal(t, tc.expInputs, tc.cfg.Inputs, "Inputs")
+			assert.Equal(t, tc.expHRPs, tc.cfg.ToHRPs, "ToHRPs")
 
 			// Make sure the writer got set to the outBuffer we gave to the command.
 			_, err = fmt.Fprintf(tc.cfg.Writer, "%s", testString)
@@ -145,6 +169,11 @@ func TestCmdConfig_OutputTypeDefined(t *testing.T) {
 		{name: "to hex", cfg: &CmdConfig{ToHex: true}, exp: true},
 		{name: "to base64", cfg: &CmdConfig{ToBase64: true}, exp: true},
 		{name: "to raw", cfg: &CmdConfig{ToRaw: true}, exp: true},
+		{name: "to base64url", cfg: &CmdConfig{ToBase64URL: true}, exp: true},
+		{name: "to base32", cfg: &CmdConfig{ToBase32: true}, exp: true},
+		{name: "to base58", cfg: &CmdConfig{ToBase58: true}, exp: true},
+		{name: "to base58check", cfg: &CmdConfig{ToBase58Check: true}, exp: true},
+		{name: "to evm", cfg: &CmdConfig{ToEVM: true}, exp: true},
 		{name: "one hrp and to hex", cfg: &CmdConfig{ToHRPs: []string{"one"}, ToHex: true}, exp: true},
 		{name: "one hrp and to base64", cfg: &CmdConfig{ToHRPs: []string{"one"}, ToBase64: true}, exp: true},
 		{name: "one hrp and to raw", cfg: &CmdConfig{ToHRPs: []string{"one"}, ToRaw: true}, exp: true},
@@ -204,10 +233,50 @@ func TestToFromVal(t *testing.T) {
 		{str: "h", exp: FromValHex},
 		{str: "x", exp: FromValHex},
 
+		{str: "base64url", exp: FromValBase64URL},
+		{str: "Base64URL", exp: FromValBase64URL},
+		{str: "b64url", exp: FromValBase64URL},
+		{str: "url64", exp: FromValBase64URL},
+		{str: "base64-url", exp: FromValBase64URL},
+
+		{str: "base32", exp: FromValBase32},
+		{str: "BASE32", exp: FromValBase32},
+		{str: "base-32", exp: FromValBase32},
+		{str: "rfc4648", exp: FromValBase32},
+
+		{str: "base58", exp: FromValBase58},
+		{str: "BASE58", exp: FromValBase58},
+		{str: "b58", exp: FromValBase58},
+		{str: "58", exp: FromValBase58},
+
+		{str: "base58check", exp: FromValBase58Check},
+		{str: "Base58Check", exp: FromValBase58Check},
+		{str: "b58check", exp: FromValBase58Check},
+		{str: "b58c", exp: FromValBase58Check},
+		{str: "58check", exp: FromValBase58Check},
+		{str: "base58-check", exp: FromValBase58Check},
+
+		{str: "evm", exp: FromValEVM},
+		{str: "EVM", exp: FromValEVM},
+		{str: "eip55", exp: FromValEVM},
+		{str: "eth", exp: FromValEVM},
+		{str: "0x", exp: FromValEVM},
+
 		{str: "raw", exp: FromValRaw},
 		{str: "RAW", exp: FromValRaw},
 		{str: "Raw", exp: FromValRaw},
 		{str: "r", exp: FromValRaw},
+
+		{str: "pubkey", exp: FromValPubKey},
+		{str: "PubKey", exp: FromValPubKey},
+		{str: "pub", exp: FromValPubKey},
+		{str: "pk", exp: FromValPubKey},
+		{str: "key", exp: FromValPubKey},
+
+		{str: "module", exp: FromValModule},
+		{str: "MODULE", exp: FromValModule},
+		{str: "mod", exp: FromValModule},
+		{str: "m", exp: FromValModule},
 	}
 
 	for _, tc := range tests {
@@ -538,10 +607,40 @@ func TestGetAddrBytes(t *testing.T) {
 			exp:   bytes.Repeat([]byte{7}, 32),
 		},
 		{
-			name:   "detect not bech32 hex or base64",
+			name:  "detect base64url",
+			cfg:   &CmdConfig{FromVal: FromValDetect},
+			input: "CwsL-_8=",
+			exp:   mustHex("0b0b0bfbff"),
+		},
+		{
+			name:   "detect base64 or base32",
+			cfg:    &CmdConfig{FromVal: FromValDetect},
+			input:  "BMFQX677",
+			expErr: `could not detect "BMFQX677" type between "base64" "base32"`,
+		},
+		{
+			name:  "detect base58check",
+			cfg:   &CmdConfig{FromVal: FromValDetect},
+			input: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",
+			exp:   mustHex("0077bff20c60e522dfaa3350c39b030a5d004e839a"),
+		},
+		{
+			name:  "detect evm",
+			cfg:   &CmdConfig{FromVal: FromValDetect},
+			input: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+			exp:   mustHex("5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"),
+		},
+		{
+			name:   "detect evm bad checksum",
+			cfg:    &CmdConfig{FromVal: FromValDetect},
+			input:  "0x5aaeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+			expErr: `could not decode "0x5aaeb6053F3E94C9b9A09f33669435E7Ef1BeAed" as evm: invalid EIP-55 checksum: expected 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed`,
+		},
+		{
+			name:   "detect not any type",
 			cfg:    &CmdConfig{FromVal: FromValDetect},
 			input:  "x",
-			expErr: `could not decode "x" as bech32, hex, or base64`,
+			expErr: `could not decode "x" as any of "bech32" "base64" "base64url" "base32" "base58check" "hex" "evm"`,
 		},
 		{
 			name:  "bech32 empty bytes",
@@ -669,6 +768,66 @@ func TestGetAddrBytes(t *testing.T) {
 			input:  "invalidbase64",
 			expErr: `could not decode "invalidbase64" as base64: illegal base64 data at input byte 12`,
 		},
+		{
+			name:  "base64url 5 bytes",
+			cfg:   &CmdConfig{FromVal: FromValBase64URL},
+			input: "CwsL-_8=",
+			exp:   mustHex("0b0b0bfbff"),
+		},
+		{
+			name:   "base64url invalid",
+			cfg:    &CmdConfig{FromVal: FromValBase64URL},
+			input:  "CwsL+/8=",
+			expErr: `could not decode "CwsL+/8=" as base64url: illegal base64 data at input byte 4`,
+		},
+		{
+			name:  "base32 5 bytes",
+			cfg:   &CmdConfig{FromVal: FromValBase32},
+			input: "BMFQX677",
+			exp:   mustHex("0b0b0bfbff"),
+		},
+		{
+			name:   "base32 invalid",
+			cfg:    &CmdConfig{FromVal: FromValBase32},
+			input:  "bmfqx677",
+			expErr: `could not decode "bmfqx677" as base32: illegal base32 data at input byte 0`,
+		},
+		{
+			name:  "base58 5 bytes",
+			cfg:   &CmdConfig{FromVal: FromValBase58},
+			input: "2FGE29L",
+			exp:   mustHex("0b0b0bfbff"),
+		},
+		{
+			name:   "base58 invalid",
+			cfg:    &CmdConfig{FromVal: FromValBase58},
+			input:  "0OIl",
+			expErr: `could not decode "0OIl" as base58: invalid base58 string`,
+		},
+		{
+			name:  "base58check 21 bytes",
+			cfg:   &CmdConfig{FromVal: FromValBase58Check},
+			input: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",
+			exp:   mustHex("0077bff20c60e522dfaa3350c39b030a5d004e839a"),
+		},
+		{
+			name:   "base58check bad checksum",
+			cfg:    &CmdConfig{FromVal: FromValBase58Check},
+			input:  "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN3",
+			expErr: `could not decode "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN3" as base58check: invalid base58check checksum`,
+		},
+		{
+			name:  "evm lower",
+			cfg:   &CmdConfig{FromVal: FromValEVM},
+			input: "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+			exp:   mustHex("5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"),
+		},
+		{
+			name:   "evm no prefix",
+			cfg:    &CmdConfig{FromVal: FromValEVM},
+			input:  "5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+			expErr: `could not decode "5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed" as evm: missing 0x prefix`,
+		},
 		{
 			name:  "raw empty",
 			cfg:   &CmdConfig{FromVal: FromValRaw},
@@ -699,6 +858,30 @@ func TestGetAddrBytes(t *testing.T) {
 			input: "(xXx:~<d[_-'rawbytes'-_]b>~:xXx)",
 			exp:   []byte("(xXx:~<d[_-'rawbytes'-_]b>~:xXx)"),
 		},
+		{
+			name:  "pubkey hex",
+			cfg:   &CmdConfig{FromVal: FromValPubKey},
+			input: "0279BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798",
+			exp: []byte{
+				0x75, 0x1e, 0x76, 0xe8, 0x19, 0x91, 0x96, 0xd4, 0x54, 0x94,
+				0x1c, 0x45, 0xd1, 0xb3, 0xa3, 0x23, 0xf1, 0x43, 0x3b, 0xd6,
+			},
+		},
+		{
+			name:   "pubkey invalid",
+			cfg:    &CmdConfig{FromVal: FromValPubKey},
+			input:  "notakey",
+			expErr: `could not decode public key "notakey" as bech32, hex, or base64`,
+		},
+		{
+			name:  "module",
+			cfg:   &CmdConfig{FromVal: FromValModule},
+			input: "gov",
+			exp: []byte{
+				0x7b, 0x5f, 0xe2, 0x2b, 0x54, 0x46, 0xf7, 0xc6, 0x2e, 0xa2,
+				0x7b, 0x8b, 0xd7, 0x1c, 0xef, 0x94, 0xe0, 0x3f, 0x3d, 0xf2,
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -815,6 +998,12 @@ func TestEncodeAddr(t *testing.T) {
 			addr: []byte{},
 			exp:  []string{"hrp1vhqs52", "", "", ""},
 		},
+		{
+			name: "to other encodings",
+			cfg:  &CmdConfig{ToBase64URL: true, ToBase32: true, ToBase58: true, ToBase58Check: true, ToEVM: true},
+			addr: mustHex("0b0b0bfbff"),
+			exp:  []string{"CwsL-_8=", "BMFQX677", "2FGE29L", "99rtw7scaFh1", "0x0b0B0BfBfF"},
+		},
 		// Not sure if there's a way to make bech32.ConvertAndEncode return an error.
 	}
 
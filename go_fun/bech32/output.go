@@ -0,0 +1,277 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// An OutputFmt is a valid string to provide with the --output flag.
+type OutputFmt string
+
+// String returns this OutputFmt as a string.
+func (f OutputFmt) String() string {
+	return string(f)
+}
+
+const (
+	// OutputFmtText is the default free text output, e.g. "[1/2] <input> => <output>".
+	OutputFmtText OutputFmt = "text"
+	// OutputFmtJSON outputs one JSON object per input, each on its own line.
+	OutputFmtJSON OutputFmt = "json"
+	// OutputFmtCSV outputs a header line followed by one line of comma separated values per input.
+	OutputFmtCSV OutputFmt = "csv"
+	// OutputFmtTSV outputs a header line followed by one line of tab separated values per input.
+	OutputFmtTSV OutputFmt = "tsv"
+)
+
+// OutputFmtOptionsStr is a string indicating all the valid --output options.
+var OutputFmtOptionsStr = `"` + strings.Join([]string{
+	OutputFmtText.String(), OutputFmtJSON.String(), OutputFmtCSV.String(), OutputFmtTSV.String(),
+}, `" "`) + `"`
+
+// ToOutputFmt converts the provided string into an OutputFmt or returns an error.
+func ToOutputFmt(str string) (OutputFmt, error) {
+	switch strings.ToLower(strings.TrimSpace(str)) {
+	case string(OutputFmtText), "txt", "":
+		return OutputFmtText, nil
+	case string(OutputFmtJSON), "j":
+		return OutputFmtJSON, nil
+	case string(OutputFmtCSV), "c":
+		return OutputFmtCSV, nil
+	case string(OutputFmtTSV), "t":
+		return OutputFmtTSV, nil
+	}
+	return OutputFmtText, fmt.Errorf("invalid --output value %q, must be one of %s", str, OutputFmtOptionsStr)
+}
+
+// Bech32Record is a single bech32 encoding of an input.
+type Bech32Record struct {
+	// HRP is the human readable part used for the encoding.
+	HRP string `json:"hrp"`
+	// Address is the encoded address.
+	Address string `json:"address"`
+}
+
+// Record is the result of converting a single input, used for the structured output formats.
+// Only the requested encodings are set, and none of them are set if there's an error.
+type Record struct {
+	// Input is the input string.
+	Input string `json:"input"`
+	// From is the type that the input was decoded from.
+	From FromVal `json:"from,omitempty"`
+	// Bech32 are the bech32 encodings in the order the HRPs were provided.
+	Bech32 []Bech32Record `json:"bech32,omitempty"`
+	// Base64 is the base64 encoding.
+	Base64 *string `json:"base64,omitempty"`
+	// Base64URL is the URL-safe base64 encoding.
+	Base64URL *string `json:"base64url,omitempty"`
+	// Base32 is the base32 encoding.
+	Base32 *string `json:"base32,omitempty"`
+	// Base58 is the base58 encoding.
+	Base58 *string `json:"base58,omitempty"`
+	// Base58Check is the base58check encoding.
+	Base58Check *string `json:"base58check,omitempty"`
+	// Hex is the hex encoding.
+	Hex *string `json:"hex,omitempty"`
+	// EVM is the 0x prefixed hex encoding with the EIP-55 checksum.
+	EVM *string `json:"evm,omitempty"`
+	// Raw is the raw bytes as a string.
+	Raw *string `json:"raw,omitempty"`
+	// Error is the error encountered while converting the input.
+	Error string `json:"error,omitempty"`
+}
+
+// NewRecord converts the provided input and creates a Record with the results.
+// Any error is both put in the Record and returned.
+func NewRecord(cfg *CmdConfig, input string) (*Record, error) {
+	rv := &Record{Input: input}
+	addr, fromVal, err := DecodeAddr(cfg, input)
+	rv.From = fromVal
+	if err != nil {
+		rv.Error = err.Error()
+		return rv, err
+	}
+
+	outputs, err := EncodeAddr(cfg, addr)
+	if err != nil {
+		err = fmt.Errorf("error encoding %q %v: %w", input, addr, err)
+		rv.Error = err.Error()
+		return rv, err
+	}
+
+	// The outputs are in a known order, so we can just pull them off the front as we go.
+	for _, hrp := range cfg.ToHRPs {
+		rv.Bech32 = append(rv.Bech32, Bech32Record{HRP: hrp, Address: outputs[0]})
+		outputs = outputs[1:]
+	}
+	if cfg.ToBase64 {
+		rv.Base64 = &outputs[0]
+		outputs = outputs[1:]
+	}
+	if cfg.ToBase64URL {
+		rv.Base64URL = &outputs[0]
+		outputs = outputs[1:]
+	}
+	if cfg.ToBase32 {
+		rv.Base32 = &outputs[0]
+		outputs = outputs[1:]
+	}
+	if cfg.ToBase58 {
+		rv.Base58 = &outputs[0]
+		outputs = outputs[1:]
+	}
+	if cfg.ToBase58Check {
+		rv.Base58Check = &outputs[0]
+		outputs = outputs[1:]
+	}
+	if cfg.ToHex || !cfg.OutputTypeDefined() {
+		rv.Hex = &outputs[0]
+		outputs = outputs[1:]
+	}
+	if cfg.ToEVM {
+		rv.EVM = &outputs[0]
+		outputs = outputs[1:]
+	}
+	if cfg.ToRaw {
+		rv.Raw = &outputs[0]
+	}
+	return rv, nil
+}
+
+// Header gets the column names for the delimited output formats.
+func Header(cfg *CmdConfig) []string {
+	rv := make([]string, 0, len(cfg.ToHRPs)+11)
+	rv = append(rv, "input", "from")
+	rv = append(rv, cfg.ToHRPs...)
+	if cfg.ToBase64 {
+		rv = append(rv, "base64")
+	}
+	if cfg.ToBase64URL {
+		rv = append(rv, "base64url")
+	}
+	if cfg.ToBase32 {
+		rv = append(rv, "base32")
+	}
+	if cfg.ToBase58 {
+		rv = append(rv, "base58")
+	}
+	if cfg.ToBase58Check {
+		rv = append(rv, "base58check")
+	}
+	if cfg.ToHex || !cfg.OutputTypeDefined() {
+		rv = append(rv, "hex")
+	}
+	if cfg.ToEVM {
+		rv = append(rv, "evm")
+	}
+	if cfg.ToRaw {
+		rv = append(rv, "raw")
+	}
+	return append(rv, "error")
+}
+
+// Row gets the values of this Record for the delimited output formats.
+// The values are in the same order as the columns from Header.
+func (r *Record) Row(cfg *CmdConfig) []string {
+	rv := make([]string, 0, len(cfg.ToHRPs)+11)
+	rv = append(rv, r.Input, r.From.String())
+	for i := range cfg.ToHRPs {
+		if i < len(r.Bech32) {
+			rv = append(rv, r.Bech32[i].Address)
+		} else {
+			rv = append(rv, "")
+		}
+	}
+	if cfg.ToBase64 {
+		rv = append(rv, derefStr(r.Base64))
+	}
+	if cfg.ToBase64URL {
+		rv = append(rv, derefStr(r.Base64URL))
+	}
+	if cfg.ToBase32 {
+		rv = append(rv, derefStr(r.Base32))
+	}
+	if cfg.ToBase58 {
+		rv = append(rv, derefStr(r.Base58))
+	}
+	if cfg.ToBase58Check {
+		rv = append(rv, derefStr(r.Base58Check))
+	}
+	if cfg.ToHex || !cfg.OutputTypeDefined() {
+		rv = append(rv, derefStr(r.Hex))
+	}
+	if cfg.ToEVM {
+		rv = append(rv, derefStr(r.EVM))
+	}
+	if cfg.ToRaw {
+		rv = append(rv, derefStr(r.Raw))
+	}
+	return append(rv, r.Error)
+}
+
+// derefStr returns the string that the provided pointer points to, or an empty string if it's nil.
+func derefStr(str *string) string {
+	if str == nil {
+		return ""
+	}
+	return *str
+}
+
+// PrintRecords converts all the inputs and prints them in the requested structured output format.
+// Without --keep-going, it stops at the first input that can't be converted (and that input isn't printed).
+// With --keep-going, those inputs are printed with their error, and an error is returned at the end.
+func PrintRecords(cfg *CmdConfig) error {
+	var csvW *csv.Writer
+	if cfg.OutputFmt == OutputFmtCSV || cfg.OutputFmt == OutputFmtTSV {
+		csvW = csv.NewWriter(cfg.Writer)
+		if cfg.OutputFmt == OutputFmtTSV {
+			csvW.Comma = '\t'
+		}
+		if err := csvW.Write(Header(cfg)); err != nil {
+			return err
+		}
+	}
+	jsonEnc := json.NewEncoder(cfg.Writer)
+	jsonEnc.SetEscapeHTML(false)
+
+	failed := 0
+	for _, arg := range cfg.Inputs {
+		rec, err := NewRecord(cfg, arg)
+		if err != nil {
+			if !cfg.KeepGoing {
+				if csvW != nil {
+					csvW.Flush()
+				}
+				return err
+			}
+			failed++
+		}
+
+		if csvW != nil {
+			err = csvW.Write(rec.Row(cfg))
+		} else {
+			err = jsonEnc.Encode(rec)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if csvW != nil {
+		csvW.Flush()
+		if err := csvW.Error(); err != nil {
+			return err
+		}
+	}
+	return failedErr(failed, cfg.Count)
+}
+
+// failedErr returns an error if there were any failed inputs.
+func failedErr(failed, count int) error {
+	if failed == 0 {
+		return nil
+	}
+	return fmt.Errorf("%d of %d inputs could not be converted", failed, count)
+}
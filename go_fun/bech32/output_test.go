@@ -0,0 +1,350 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// strPtr returns a pointer to the provided string.
+func strPtr(str string) *string {
+	return &str
+}
+
+func TestToOutputFmt(t *testing.T) {
+	tests := []struct {
+		str    string
+		exp    OutputFmt
+		expErr string
+	}{
+		{str: "noclue", exp: OutputFmtText, expErr: `invalid --output value "noclue", must be one of ` + OutputFmtOptionsStr},
+
+		{str: "", exp: OutputFmtText},
+		{str: "text", exp: OutputFmtText},
+		{str: "TEXT", exp: OutputFmtText},
+		{str: "txt", exp: OutputFmtText},
+
+		{str: "json", exp: OutputFmtJSON},
+		{str: " JSON ", exp: OutputFmtJSON},
+		{str: "j", exp: OutputFmtJSON},
+
+		{str: "csv", exp: OutputFmtCSV},
+		{str: "CSV", exp: OutputFmtCSV},
+		{str: "c", exp: OutputFmtCSV},
+
+		{str: "tsv", exp: OutputFmtTSV},
+		{str: "Tsv", exp: OutputFmtTSV},
+		{str: "t", exp: OutputFmtTSV},
+	}
+
+	for _, tc := range tests {
+		name := tc.str
+		if len(tc.str) == 0 {
+			name = "empty"
+		}
+		t.Run(name, func(t *testing.T) {
+			outputFmt, err := ToOutputFmt(tc.str)
+			AssertErrorContents(t, err, tc.expErr, "ToOutputFmt(%q)", tc.str)
+			assert.Equal(t, tc.exp, outputFmt, "ToOutputFmt(%q)", tc.str)
+		})
+	}
+}
+
+func TestNewRecord(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    *CmdConfig
+		input  string
+		exp    *Record
+		expErr string
+	}{
+		{
+			name:  "no output types",
+			cfg:   &CmdConfig{FromVal: FromValHex},
+			input: "0a0b",
+			exp:   &Record{Input: "0a0b", From: FromValHex, Hex: strPtr("0A0B")},
+		},
+		{
+			name:  "all output types",
+			cfg:   &CmdConfig{FromVal: FromValDetect, ToHRPs: []string{"abc", "def"}, ToBase64: true, ToHex: true, ToRaw: true},
+			input: mustBech32("xyz", []byte("hi")),
+			exp: &Record{
+				Input: mustBech32("xyz", []byte("hi")),
+				From:  FromValBech32,
+				Bech32: []Bech32Record{
+					{HRP: "abc", Address: mustBech32("abc", []byte("hi"))},
+					{HRP: "def", Address: mustBech32("def", []byte("hi"))},
+				},
+				Base64: strPtr("aGk="),
+				Hex:    strPtr("6869"),
+				Raw:    strPtr("hi"),
+			},
+		},
+		{
+			name:  "empty input",
+			cfg:   &CmdConfig{FromVal: FromValDetect, ToBase64: true},
+			input: "",
+			exp:   &Record{Input: "", Base64: strPtr("")},
+		},
+		{
+			name:   "cannot decode",
+			cfg:    &CmdConfig{FromVal: FromValHex, ToHRPs: []string{"abc"}},
+			input:  "x",
+			exp:    &Record{Input: "x", From: FromValHex, Error: `could not decode "x" as hex: encoding/hex: invalid byte: U+0078 'x'`},
+			expErr: `could not decode "x" as hex: encoding/hex: invalid byte: U+0078 'x'`,
+		},
+		{
+			name:  "other encodings",
+			cfg:   &CmdConfig{FromVal: FromValHex, ToBase64URL: true, ToBase32: true, ToBase58: true, ToBase58Check: true, ToEVM: true},
+			input: "0b0b0bfbff",
+			exp: &Record{
+				Input:       "0b0b0bfbff",
+				From:        FromValHex,
+				Base64URL:   strPtr("CwsL-_8="),
+				Base32:      strPtr("BMFQX677"),
+				Base58:      strPtr("2FGE29L"),
+				Base58Check: strPtr("99rtw7scaFh1"),
+				EVM:         strPtr("0x0b0B0BfBfF"),
+			},
+		},
+		{
+			name:   "cannot detect",
+			cfg:    &CmdConfig{FromVal: FromValDetect},
+			input:  "0a0b",
+			exp:    &Record{Input: "0a0b", From: FromValDetect, Error: `could not detect "0a0b" type between "base64" "hex"`},
+			expErr: `could not detect "0a0b" type between "base64" "hex"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rec, err := NewRecord(tc.cfg, tc.input)
+			AssertErrorContents(t, err, tc.expErr, "NewRecord error")
+			assert.Equal(t, tc.exp, rec, "NewRecord result")
+		})
+	}
+}
+
+func TestHeaderAndRow(t *testing.T) {
+	tests := []struct {
+		name      string
+		cfg       *CmdConfig
+		rec       *Record
+		expHeader []string
+		expRow    []string
+	}{
+		{
+			name:      "no output types",
+			cfg:       &CmdConfig{},
+			rec:       &Record{Input: "0a", From: FromValHex, Hex: strPtr("0A")},
+			expHeader: []string{"input", "from", "hex", "error"},
+			expRow:    []string{"0a", "hex", "0A", ""},
+		},
+		{
+			name: "all output types",
+			cfg:  &CmdConfig{ToHRPs: []string{"abc", "def"}, ToBase64: true, ToHex: true, ToRaw: true},
+			rec: &Record{
+				Input:  "aGk=",
+				From:   FromValBase64,
+				Bech32: []Bech32Record{{HRP: "abc", Address: "abc1"}, {HRP: "def", Address: "def1"}},
+				Base64: strPtr("aGk="),
+				Hex:    strPtr("6869"),
+				Raw:    strPtr("hi"),
+			},
+			expHeader: []string{"input", "from", "abc", "def", "base64", "hex", "raw", "error"},
+			expRow:    []string{"aGk=", "base64", "abc1", "def1", "aGk=", "6869", "hi", ""},
+		},
+		{
+			name: "other encodings",
+			cfg:  &CmdConfig{ToBase64URL: true, ToBase32: true, ToBase58: true, ToBase58Check: true, ToEVM: true},
+			rec: &Record{
+				Input:       "0b0b0bfbff",
+				From:        FromValHex,
+				Base64URL:   strPtr("CwsL-_8="),
+				Base32:      strPtr("BMFQX677"),
+				Base58:      strPtr("2FGE29L"),
+				Base58Check: strPtr("99rtw7scaFh1"),
+				EVM:         strPtr("0x0b0B0BfBfF"),
+			},
+			expHeader: []string{"input", "from", "base64url", "base32", "base58", "base58check", "evm", "error"},
+			expRow:    []string{"0b0b0bfbff", "hex", "CwsL-_8=", "BMFQX677", "2FGE29L", "99rtw7scaFh1", "0x0b0B0BfBfF", ""},
+		},
+		{
+			name:      "error",
+			cfg:       &CmdConfig{ToHRPs: []string{"abc", "def"}, ToBase64: true, ToRaw: true},
+			rec:       &Record{Input: "x", From: FromValDetect, Error: "bad input"},
+			expHeader: []string{"input", "from", "abc", "def", "base64", "raw", "error"},
+			expRow:    []string{"x", "detect", "", "", "", "", "bad input"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			header := Header(tc.cfg)
+			assert.Equal(t, tc.expHeader, header, "Header")
+			row := tc.rec.Row(tc.cfg)
+			assert.Equal(t, tc.expRow, row, "Row")
+		})
+	}
+}
+
+func TestPrintRecords(t *testing.T) {
+	goodIn := mustBech32("xyz", []byte("hi"))
+	goodOut := mustBech32("abc", []byte("hi"))
+	badErr := `could not decode "x" as bech32: decoding bech32 failed: invalid bech32 string length 1`
+
+	tests := []struct {
+		name   string
+		cfg    *CmdConfig
+		expErr string
+		expOut []string
+	}{
+		{
+			name: "json",
+			cfg:  &CmdConfig{OutputFmt: OutputFmtJSON, FromVal: FromValBech32, ToHRPs: []string{"abc"}, Count: 1, Inputs: []string{goodIn}},
+			expOut: []string{
+				`{"input":"` + goodIn + `","from":"bech32","bech32":[{"hrp":"abc","address":"` + goodOut + `"}]}`,
+			},
+		},
+		{
+			name:   "json bad input",
+			cfg:    &CmdConfig{OutputFmt: OutputFmtJSON, FromVal: FromValBech32, ToHRPs: []string{"abc"}, Count: 3, Inputs: []string{goodIn, "x", goodIn}},
+			expErr: badErr,
+			expOut: []string{
+				`{"input":"` + goodIn + `","from":"bech32","bech32":[{"hrp":"abc","address":"` + goodOut + `"}]}`,
+			},
+		},
+		{
+			name: "json bad input keep going",
+			cfg: &CmdConfig{
+				OutputFmt: OutputFmtJSON, KeepGoing: true, FromVal: FromValBech32, ToHRPs: []string{"abc"},
+				Count: 3, Inputs: []string{goodIn, "x", goodIn},
+			},
+			expErr: "1 of 3 inputs could not be converted",
+			expOut: []string{
+				`{"input":"` + goodIn + `","from":"bech32","bech32":[{"hrp":"abc","address":"` + goodOut + `"}]}`,
+				`{"input":"x","from":"bech32","error":"could not decode \"x\" as bech32: decoding bech32 failed: invalid bech32 string length 1"}`,
+				`{"input":"` + goodIn + `","from":"bech32","bech32":[{"hrp":"abc","address":"` + goodOut + `"}]}`,
+			},
+		},
+		{
+			name: "csv",
+			cfg: &CmdConfig{
+				OutputFmt: OutputFmtCSV, FromVal: FromValBech32, ToHRPs: []string{"abc"}, ToHex: true,
+				Count: 2, Inputs: []string{goodIn, goodIn},
+			},
+			expOut: []string{
+				"input,from,abc,hex,error",
+				goodIn + ",bech32," + goodOut + ",6869,",
+				goodIn + ",bech32," + goodOut + ",6869,",
+			},
+		},
+		{
+			name: "csv bad input",
+			cfg: &CmdConfig{
+				OutputFmt: OutputFmtCSV, FromVal: FromValBech32, ToHRPs: []string{"abc"}, ToHex: true,
+				Count: 2, Inputs: []string{goodIn, "x"},
+			},
+			expErr: badErr,
+			expOut: []string{
+				"input,from,abc,hex,error",
+				goodIn + ",bech32," + goodOut + ",6869,",
+			},
+		},
+		{
+			name: "csv bad input keep going",
+			cfg: &CmdConfig{
+				OutputFmt: OutputFmtCSV, KeepGoing: true, FromVal: FromValBech32, ToHRPs: []string{"abc"}, ToHex: true,
+				Count: 3, Inputs: []string{"x", goodIn, "y"},
+			},
+			expErr: "2 of 3 inputs could not be converted",
+			expOut: []string{
+				"input,from,abc,hex,error",
+				`x,bech32,,,"could not decode ""x"" as bech32: decoding bech32 failed: invalid bech32 string length 1"`,
+				goodIn + ",bech32," + goodOut + ",6869,",
+				`y,bech32,,,"could not decode ""y"" as bech32: decoding bech32 failed: invalid bech32 string length 1"`,
+			},
+		},
+		{
+			name: "tsv",
+			cfg: &CmdConfig{
+				OutputFmt: OutputFmtTSV, KeepGoing: true, FromVal: FromValBech32, ToBase64: true,
+				Count: 2, Inputs: []string{goodIn, "x"},
+			},
+			expErr: "1 of 2 inputs could not be converted",
+			expOut: []string{
+				"input\tfrom\tbase64\terror",
+				goodIn + "\tbech32\taGk=\t",
+				"x\tbech32\t\t" + `"could not decode ""x"" as bech32: decoding bech32 failed: invalid bech32 string length 1"`,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var buffer bytes.Buffer
+			tc.cfg.Writer = &buffer
+
+			err := PrintRecords(tc.cfg)
+			AssertErrorContents(t, err, tc.expErr, "PrintRecords error")
+			outLines := strings.Split(strings.TrimSuffix(buffer.String(), "\n"), "\n")
+			assert.Equal(t, tc.expOut, outLines, "PrintRecords printed output")
+		})
+	}
+}
+
+func TestConvertAndPrintAllKeepGoing(t *testing.T) {
+	tests := []struct {
+		name      string
+		cfg       *CmdConfig
+		expErr    string
+		expOut    []string
+		expStderr []string
+	}{
+		{
+			name: "text keep going",
+			cfg: &CmdConfig{
+				KeepGoing: true, FromVal: FromValHex, ToBase64: true,
+				Count: 3, Inputs: []string{"0a", "x", "0b"},
+			},
+			expErr:    "1 of 3 inputs could not be converted",
+			expOut:    []string{"[1/3] 0a => Cg==", "[3/3] 0b => Cw=="},
+			expStderr: []string{`Error: could not decode "x" as hex: encoding/hex: invalid byte: U+0078 'x'`},
+		},
+		{
+			name: "text keep going all good",
+			cfg: &CmdConfig{
+				KeepGoing: true, FromVal: FromValHex, ToBase64: true,
+				Count: 2, Inputs: []string{"0a", "0b"},
+			},
+			expOut:    []string{"[1/2] 0a => Cg==", "[2/2] 0b => Cw=="},
+			expStderr: []string{""},
+		},
+		{
+			name: "json",
+			cfg: &CmdConfig{
+				OutputFmt: OutputFmtJSON, FromVal: FromValHex, ToBase64: true,
+				Count: 1, Inputs: []string{"0a"},
+			},
+			expOut:    []string{`{"input":"0a","from":"hex","base64":"Cg=="}`},
+			expStderr: []string{""},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var outBuffer, errBuffer bytes.Buffer
+			tc.cfg.Writer = &outBuffer
+			tc.cfg.ErrWriter = &errBuffer
+
+			err := ConvertAndPrintAll(tc.cfg)
+			AssertErrorContents(t, err, tc.expErr, "ConvertAndPrintAll error")
+			outLines := strings.Split(strings.TrimSuffix(outBuffer.String(), "\n"), "\n")
+			assert.Equal(t, tc.expOut, outLines, "ConvertAndPrintAll printed output")
+			errLines := strings.Split(strings.TrimSuffix(errBuffer.String(), "\n"), "\n")
+			assert.Equal(t, tc.expStderr, errLines, "ConvertAndPrintAll printed errors")
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/ripemd160"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+// A KeyType is a type of public key.
+type KeyType string
+
+// String returns this KeyType as a string.
+func (t KeyType) String() string {
+	return string(t)
+}
+
+const (
+	// KeyTypeSecp256k1 is a secp256k1 public key (used for most Cosmos accounts).
+	KeyTypeSecp256k1 KeyType = "secp256k1"
+	// KeyTypeEd25519 is an ed25519 public key (used for Cosmos validator consensus keys).
+	KeyTypeEd25519 KeyType = "ed25519"
+)
+
+// aminoPrefixes are the leading bytes of amino encoded public keys, e.g. the legacy cosmospub1addwnpepq... keys.
+// Each is the amino type prefix followed by the length of the key.
+var aminoPrefixes = []struct {
+	keyType KeyType
+	prefix  []byte
+}{
+	{keyType: KeyTypeSecp256k1, prefix: []byte{0xeb, 0x5a, 0xe9, 0x87, 0x21}},
+	{keyType: KeyTypeEd25519, prefix: []byte{0x16, 0x24, 0xde, 0x64, 0x20}},
+}
+
+// ParsePubKey identifies the type of the provided public key and returns it in its compressed form.
+// Amino encoded keys have their amino prefix removed.
+// Uncompressed (65 byte) secp256k1 keys are converted to their compressed (33 byte) form.
+func ParsePubKey(bz []byte) (KeyType, []byte, error) {
+	for _, ap := range aminoPrefixes {
+		if len(bz) == len(ap.prefix)+int(ap.prefix[len(ap.prefix)-1]) && bytes.HasPrefix(bz, ap.prefix) {
+			key := bz[len(ap.prefix):]
+			if ap.keyType == KeyTypeSecp256k1 {
+				return parseSecp256k1(key)
+			}
+			return ap.keyType, key, nil
+		}
+	}
+
+	switch len(bz) {
+	case 33, 65:
+		return parseSecp256k1(bz)
+	case 32:
+		return KeyTypeEd25519, bz, nil
+	}
+	return "", nil, fmt.Errorf("invalid public key length %d: must be 33 or 65 for %s or 32 for %s",
+		len(bz), KeyTypeSecp256k1, KeyTypeEd25519)
+}
+
+// parseSecp256k1 makes sure the provided key is a secp256k1 key and returns it in compressed form.
+func parseSecp256k1(bz []byte) (KeyType, []byte, error) {
+	switch {
+	case len(bz) == 33 && (bz[0] == 0x02 || bz[0] == 0x03):
+		return KeyTypeSecp256k1, bz, nil
+	case len(bz) == 33:
+		return "", nil, fmt.Errorf("invalid compressed %s public key: first byte must be 0x02 or 0x03, not 0x%02x",
+			KeyTypeSecp256k1, bz[0])
+	case len(bz) == 65 && bz[0] == 0x04:
+		// The compressed form is just the X coordinate with a prefix indicating whether Y is even or odd.
+		rv := make([]byte, 33)
+		rv[0] = 0x02 + bz[64]&1
+		copy(rv[1:], bz[1:33])
+		return KeyTypeSecp256k1, rv, nil
+	case len(bz) == 65:
+		return "", nil, fmt.Errorf("invalid uncompressed %s public key: first byte must be 0x04, not 0x%02x",
+			KeyTypeSecp256k1, bz[0])
+	}
+	return "", nil, fmt.Errorf("invalid %s public key length %d: must be 33 or 65", KeyTypeSecp256k1, len(bz))
+}
+
+// PubKeyAddr gets the account address of the provided (compressed) public key.
+// For secp256k1 keys, it's RIPEMD160(SHA256(key)). For ed25519 keys, it's the first 20 bytes of SHA256(key).
+func PubKeyAddr(keyType KeyType, key []byte) []byte {
+	sha := sha256.Sum256(key)
+	if keyType == KeyTypeEd25519 {
+		return sha[:20]
+	}
+	hasher := ripemd160.New()
+	hasher.Write(sha[:])
+	return hasher.Sum(nil)
+}
+
+// ModuleAddr gets the address of the module account with the provided name.
+// It's the first 20 bytes of SHA256(name).
+func ModuleAddr(name string) []byte {
+	sha := sha256.Sum256([]byte(name))
+	return sha[:20]
+}
+
+// GetPubKeyAddrBytes decodes the provided public key and gets its account address.
+// The public key can be bech32 (e.g. cosmospub1...), hex, or base64.
+func GetPubKeyAddrBytes(input string) ([]byte, error) {
+	decoders := []struct {
+		name   FromVal
+		decode func(string) ([]byte, error)
+	}{
+		{name: FromValBech32, decode: func(str string) ([]byte, error) {
+			_, bz, err := bech32.DecodeAndConvert(str)
+			return bz, err
+		}},
+		{name: FromValBase64, decode: base64.StdEncoding.DecodeString},
+		{name: FromValHex, decode: hex.DecodeString},
+	}
+
+	var addr []byte
+	var okTypes []string
+	var keyErrs []string
+	for _, d := range decoders {
+		bz, err := d.decode(input)
+		if err != nil {
+			continue
+		}
+		keyType, key, err := ParsePubKey(bz)
+		if err != nil {
+			keyErrs = append(keyErrs, fmt.Sprintf("as %s: %v", d.name, err))
+			continue
+		}
+		okTypes = append(okTypes, d.name.String())
+		addr = PubKeyAddr(keyType, key)
+	}
+
+	switch {
+	case len(okTypes) == 1:
+		return addr, nil
+	case len(okTypes) > 1:
+		return nil, fmt.Errorf(`could not detect public key %q type between "%s"`, input, strings.Join(okTypes, `" "`))
+	case len(keyErrs) > 0:
+		return nil, fmt.Errorf("could not get public key from %q %s", input, strings.Join(keyErrs, ", "))
+	}
+	return nil, fmt.Errorf("could not decode public key %q as bech32, hex, or base64", input)
+}
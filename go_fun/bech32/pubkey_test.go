@@ -0,0 +1,253 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Some public keys (and their addresses) used in the pubkey tests.
+const (
+	// secpKeyHex is the secp256k1 generator point, compressed.
+	secpKeyHex = "0279BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798"
+	// secpKeyUncompressedHex is the secp256k1 generator point, uncompressed.
+	secpKeyUncompressedHex = "0479BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798" +
+		"483ADA7726A3C4655DA4FBFC0E1108A8FD17B448A68554199C47D08FFB10D4B8"
+	// secpKeyB64 is secpKeyHex as base64.
+	secpKeyB64 = "Anm+Zn753LusVaBilc6HCwcCm/zbLc4o2VnygVsW+BeY"
+	// secpAddrHex is the address of secpKeyHex (it's the same as the BIP-173 example address).
+	secpAddrHex = "751E76E8199196D454941C45D1B3A323F1433BD6"
+	// edKeyHex is an ed25519 key with the bytes 0x01 through 0x20.
+	edKeyHex = "0102030405060708090A0B0C0D0E0F101112131415161718191A1B1C1D1E1F20"
+	// edKeyB64 is edKeyHex as base64.
+	edKeyB64 = "AQIDBAUGBwgJCgsMDQ4PEBESExQVFhcYGRobHB0eHyA="
+	// edAddrHex is the address of edKeyHex.
+	edAddrHex = "AE216C2EF5247A3782C135EFA279A3E4CDC61094"
+)
+
+// mustHex calls hex.DecodeString and panics on error.
+func mustHex(str string) []byte {
+	rv, err := hex.DecodeString(str)
+	if err != nil {
+		panic(err)
+	}
+	return rv
+}
+
+// withPrefix returns a new slice with the prefix followed by the bytes.
+func withPrefix(prefix []byte, bz []byte) []byte {
+	return append(append([]byte{}, prefix...), bz...)
+}
+
+func TestParsePubKey(t *testing.T) {
+	secpAmino := []byte{0xeb, 0x5a, 0xe9, 0x87, 0x21}
+	edAmino := []byte{0x16, 0x24, 0xde, 0x64, 0x20}
+
+	tests := []struct {
+		name       string
+		bz         []byte
+		expKeyType KeyType
+		expKey     []byte
+		expErr     string
+	}{
+		{
+			name:   "nil",
+			bz:     nil,
+			expErr: "invalid public key length 0: must be 33 or 65 for secp256k1 or 32 for ed25519",
+		},
+		{
+			name:   "20 bytes",
+			bz:     bytes.Repeat([]byte{2}, 20),
+			expErr: "invalid public key length 20: must be 33 or 65 for secp256k1 or 32 for ed25519",
+		},
+		{
+			name:       "secp256k1 compressed even",
+			bz:         mustHex(secpKeyHex),
+			expKeyType: KeyTypeSecp256k1,
+			expKey:     mustHex(secpKeyHex),
+		},
+		{
+			name:       "secp256k1 compressed odd",
+			bz:         withPrefix([]byte{3}, bytes.Repeat([]byte{9}, 32)),
+			expKeyType: KeyTypeSecp256k1,
+			expKey:     withPrefix([]byte{3}, bytes.Repeat([]byte{9}, 32)),
+		},
+		{
+			name:   "secp256k1 compressed bad prefix",
+			bz:     withPrefix([]byte{4}, bytes.Repeat([]byte{9}, 32)),
+			expErr: "invalid compressed secp256k1 public key: first byte must be 0x02 or 0x03, not 0x04",
+		},
+		{
+			name:       "secp256k1 uncompressed even",
+			bz:         mustHex(secpKeyUncompressedHex),
+			expKeyType: KeyTypeSecp256k1,
+			expKey:     mustHex(secpKeyHex),
+		},
+		{
+			name:       "secp256k1 uncompressed odd",
+			bz:         withPrefix([]byte{4}, append(bytes.Repeat([]byte{9}, 32), bytes.Repeat([]byte{7}, 32)...)),
+			expKeyType: KeyTypeSecp256k1,
+			expKey:     withPrefix([]byte{3}, bytes.Repeat([]byte{9}, 32)),
+		},
+		{
+			name:   "secp256k1 uncompressed bad prefix",
+			bz:     withPrefix([]byte{2}, bytes.Repeat([]byte{9}, 64)),
+			expErr: "invalid uncompressed secp256k1 public key: first byte must be 0x04, not 0x02",
+		},
+		{
+			name:       "secp256k1 amino",
+			bz:         withPrefix(secpAmino, mustHex(secpKeyHex)),
+			expKeyType: KeyTypeSecp256k1,
+			expKey:     mustHex(secpKeyHex),
+		},
+		{
+			name:   "secp256k1 amino bad key",
+			bz:     withPrefix(secpAmino, withPrefix([]byte{5}, bytes.Repeat([]byte{9}, 32))),
+			expErr: "invalid compressed secp256k1 public key: first byte must be 0x02 or 0x03, not 0x05",
+		},
+		{
+			name:       "ed25519",
+			bz:         mustHex(edKeyHex),
+			expKeyType: KeyTypeEd25519,
+			expKey:     mustHex(edKeyHex),
+		},
+		{
+			name:       "ed25519 amino",
+			bz:         withPrefix(edAmino, mustHex(edKeyHex)),
+			expKeyType: KeyTypeEd25519,
+			expKey:     mustHex(edKeyHex),
+		},
+		{
+			name:   "ed25519 amino wrong length",
+			bz:     withPrefix(edAmino, mustHex(edKeyHex)[:31]),
+			expErr: "invalid public key length 36: must be 33 or 65 for secp256k1 or 32 for ed25519",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			keyType, key, err := ParsePubKey(tc.bz)
+			AssertErrorContents(t, err, tc.expErr, "ParsePubKey error")
+			assert.Equal(t, tc.expKeyType, keyType, "ParsePubKey key type")
+			assert.Equal(t, tc.expKey, key, "ParsePubKey key")
+		})
+	}
+}
+
+func TestPubKeyAddr(t *testing.T) {
+	tests := []struct {
+		name    string
+		keyType KeyType
+		key     []byte
+		exp     []byte
+	}{
+		{name: "secp256k1", keyType: KeyTypeSecp256k1, key: mustHex(secpKeyHex), exp: mustHex(secpAddrHex)},
+		{name: "ed25519", keyType: KeyTypeEd25519, key: mustHex(edKeyHex), exp: mustHex(edAddrHex)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			addr := PubKeyAddr(tc.keyType, tc.key)
+			assert.Equal(t, tc.exp, addr, "PubKeyAddr")
+		})
+	}
+}
+
+func TestModuleAddr(t *testing.T) {
+	// These are the well-known module account addresses on the Cosmos Hub.
+	tests := []struct {
+		name string
+		exp  string
+	}{
+		{name: "gov", exp: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"},
+		{name: "distribution", exp: "cosmos1jv65s3grqf6v6jl3dp4t6c9t9rk99cd88lyufl"},
+		{name: "fee_collector", exp: "cosmos17xpfvakm2amg962yls6f84z3kell8c5lserqta"},
+		{name: "bonded_tokens_pool", exp: "cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			addr := ModuleAddr(tc.name)
+			assert.Equal(t, tc.exp, mustBech32("cosmos", addr), "ModuleAddr as bech32")
+		})
+	}
+}
+
+func TestGetPubKeyAddrBytes(t *testing.T) {
+	secpAmino := []byte{0xeb, 0x5a, 0xe9, 0x87, 0x21}
+	edAmino := []byte{0x16, 0x24, 0xde, 0x64, 0x20}
+
+	tests := []struct {
+		name   string
+		input  string
+		exp    []byte
+		expErr string
+	}{
+		{
+			name:   "not a key",
+			input:  "notakey",
+			expErr: `could not decode public key "notakey" as bech32, hex, or base64`,
+		},
+		{
+			name:   "hex wrong length",
+			input:  "0a0b0c",
+			expErr: `could not get public key from "0a0b0c" as hex: invalid public key length 3: must be 33 or 65 for secp256k1 or 32 for ed25519`,
+		},
+		{
+			name:  "hex secp256k1",
+			input: secpKeyHex,
+			exp:   mustHex(secpAddrHex),
+		},
+		{
+			name:  "hex secp256k1 uncompressed",
+			input: secpKeyUncompressedHex,
+			exp:   mustHex(secpAddrHex),
+		},
+		{
+			name:  "base64 secp256k1",
+			input: secpKeyB64,
+			exp:   mustHex(secpAddrHex),
+		},
+		{
+			name:  "bech32 secp256k1 amino",
+			input: mustBech32("cosmospub", withPrefix(secpAmino, mustHex(secpKeyHex))),
+			exp:   mustHex(secpAddrHex),
+		},
+		{
+			name:  "bech32 secp256k1 plain",
+			input: mustBech32("cosmospub", mustHex(secpKeyHex)),
+			exp:   mustHex(secpAddrHex),
+		},
+		{
+			// The hex is also valid base64, but it's not a valid key that way.
+			name:  "hex ed25519",
+			input: edKeyHex,
+			exp:   mustHex(edAddrHex),
+		},
+		{
+			name:  "base64 ed25519",
+			input: edKeyB64,
+			exp:   mustHex(edAddrHex),
+		},
+		{
+			name:  "bech32 ed25519 amino",
+			input: mustBech32("cosmosvalconspub", withPrefix(edAmino, mustHex(edKeyHex))),
+			exp:   mustHex(edAddrHex),
+		},
+		{
+			name:   "bech32 address instead of key",
+			input:  mustBech32("cosmos", mustHex(secpAddrHex)),
+			expErr: `could not get public key from "` + mustBech32("cosmos", mustHex(secpAddrHex)) + `" as bech32: invalid public key length 20: must be 33 or 65 for secp256k1 or 32 for ed25519`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			addr, err := GetPubKeyAddrBytes(tc.input)
+			AssertErrorContents(t, err, tc.expErr, "GetPubKeyAddrBytes error")
+			assert.Equal(t, tc.exp, addr, "GetPubKeyAddrBytes address")
+		})
+	}
+}
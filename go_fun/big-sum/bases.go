@@ -0,0 +1,224 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+const (
+	// MinBase is the smallest base that can be used for input or output.
+	MinBase = 2
+	// MaxBase is the largest base that can be used for input or output.
+	MaxBase = 36
+	// DefaultBase is the base used for input and output when one isn't provided.
+	DefaultBase = 10
+)
+
+// SumBases will parse each arg as a number in inBase and return the sum of all those numbers as a string in outBase.
+// An arg can have a 0b, 0o, or 0x prefix (after any sign) to override the inBase for just that arg, unless that
+// letter is also a digit in inBase (e.g. 0b101 is hexadecimal when inBase is 16).
+//
+// All the math is done with exact rationals. The result will have enough fractional digits to represent the
+// most precise arg. If the sum can be represented exactly in outBase, enough digits are used to do so.
+// Otherwise, the result is rounded (half away from zero) to that number of fractional digits.
+func SumBases(args []string, inBase, outBase int) (string, error) {
+	if err := validateBase(inBase); err != nil {
+		return "", fmt.Errorf("invalid input base: %w", err)
+	}
+	if err := validateBase(outBase); err != nil {
+		return "", fmt.Errorf("invalid output base: %w", err)
+	}
+
+	total := new(big.Rat)
+	fractDigits := 0
+	for _, arg := range args {
+		if len(arg) == 0 {
+			continue
+		}
+		val, base, argFractDigits, err := parseInBase(arg, inBase)
+		if err != nil {
+			return "", err
+		}
+		verbosef("+ %25s from %q (base %d)", val.RatString(), arg, base)
+		total.Add(total, val)
+		verbosef("= %25s", total.RatString())
+
+		if argFractDigits > 0 {
+			if d := digitsNeeded(argFractDigits, base, outBase); d > fractDigits {
+				fractDigits = d
+			}
+		}
+	}
+
+	return formatInBase(total, outBase, fractDigits), nil
+}
+
+// validateBase returns an error if the provided base cannot be used.
+func validateBase(base int) error {
+	if base < MinBase || base > MaxBase {
+		return fmt.Errorf("%d is not between %d and %d", base, MinBase, MaxBase)
+	}
+	return nil
+}
+
+// parseBase converts the provided flag value into a base, returning an error if it's not a valid base.
+func parseBase(flag, val string) (int, error) {
+	rv, err := strconv.Atoi(val)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s value %q: not an integer", flag, val)
+	}
+	if err = validateBase(rv); err != nil {
+		return 0, fmt.Errorf("invalid %s value %q: %w", flag, val, err)
+	}
+	return rv, nil
+}
+
+// radixPrefixes maps the letter of a radix prefix (the x in 0x) to the base it indicates.
+var radixPrefixes = map[byte]int{'b': 2, 'o': 8, 'x': 16}
+
+// getRadixPrefixBase returns the base indicated by the radix prefix on the provided (unsigned) value.
+// If the value does not have a radix prefix that applies in inBase, this returns 0.
+func getRadixPrefixBase(val string, inBase int) int {
+	if len(val) < 2 || val[0] != '0' {
+		return 0
+	}
+	letter := val[1] | 0x20 // lower-case it.
+	base, ok := radixPrefixes[letter]
+	if !ok || digitValue(letter) < inBase {
+		return 0
+	}
+	return base
+}
+
+// hasRadixPrefixedFraction returns true if any of the provided args have both a radix prefix and a decimal point.
+// Those can't be handled by Sum, so SumBases is needed.
+func hasRadixPrefixedFraction(args []string) bool {
+	for _, arg := range args {
+		if strings.Contains(arg, ".") && getRadixPrefixBase(strings.TrimLeft(arg, "+-"), DefaultBase) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// digitValue returns the numerical value of the provided digit character.
+// Letters (upper or lower case) have the values 10 through 35.
+// If the provided character is not a digit or letter, MaxBase is returned (i.e. it's not valid in any base).
+func digitValue(c byte) int {
+	switch {
+	case '0' <= c && c <= '9':
+		return int(c - '0')
+	case 'a' <= c && c <= 'z':
+		return int(c-'a') + 10
+	case 'A' <= c && c <= 'Z':
+		return int(c-'A') + 10
+	}
+	return MaxBase
+}
+
+// parseInBase parses the provided arg as a number in the provided base (or the base indicated by its radix prefix).
+// Commas and underscores are ignored so that people can provide numbers with separators in them.
+// Returns the value, the base that was actually used, and the number of fractional digits in the arg.
+func parseInBase(arg string, inBase int) (*big.Rat, int, int, error) {
+	val := strings.NewReplacer(",", "", "_", "").Replace(arg)
+
+	neg := false
+	if len(val) > 0 && (val[0] == '-' || val[0] == '+') {
+		neg = val[0] == '-'
+		val = val[1:]
+	}
+
+	base := inBase
+	if prefixBase := getRadixPrefixBase(val, inBase); prefixBase != 0 {
+		base = prefixBase
+		val = val[2:]
+	}
+
+	whole, fract, _ := strings.Cut(val, ".")
+	if len(whole) == 0 && len(fract) == 0 {
+		return nil, 0, 0, fmt.Errorf("could not parse %q as base %d number: no digits", arg, base)
+	}
+	digits := whole + fract
+	for i := 0; i < len(digits); i++ {
+		if digitValue(digits[i]) >= base {
+			return nil, 0, 0, fmt.Errorf("could not parse %q as base %d number: invalid digit %q", arg, base, digits[i])
+		}
+	}
+
+	num, ok := new(big.Int).SetString(digits, base)
+	if !ok {
+		return nil, 0, 0, fmt.Errorf("could not parse %q as base %d number", arg, base)
+	}
+	if neg {
+		num.Neg(num)
+	}
+	denom := new(big.Int).Exp(big.NewInt(int64(base)), big.NewInt(int64(len(fract))), nil)
+	return new(big.Rat).SetFrac(num, denom), base, len(fract), nil
+}
+
+// digitsNeeded returns the number of fractional digits in outBase needed to have
+// at least the same precision as the provided number of fractional digits in inBase.
+func digitsNeeded(digits, inBase, outBase int) int {
+	if inBase == outBase {
+		return digits
+	}
+	// Subtract a tiny bit before rounding up so that exact results (e.g. 4 for each hex digit
+	// into binary) aren't bumped up by floating point error.
+	need := float64(digits) * math.Log(float64(inBase)) / math.Log(float64(outBase))
+	return int(math.Ceil(need - 1e-9))
+}
+
+// terminatingDigits returns the number of fractional digits needed to represent the provided denominator exactly in base.
+// If it can't be represented exactly, returns -1.
+func terminatingDigits(denom *big.Int, base int) int {
+	d := new(big.Int).Set(denom)
+	b := big.NewInt(int64(base))
+	one := big.NewInt(1)
+	gcd := new(big.Int)
+	rv := 0
+	// Each digit lets us remove one base's worth of factors from the denominator.
+	for d.Cmp(one) != 0 {
+		gcd.GCD(nil, nil, d, b)
+		if gcd.Cmp(one) == 0 {
+			return -1
+		}
+		d.Quo(d, gcd)
+		rv++
+	}
+	return rv
+}
+
+// formatInBase returns the provided value as a string in the provided base with at least minFractDigits fractional digits.
+func formatInBase(val *big.Rat, base, minFractDigits int) string {
+	fractDigits := minFractDigits
+	if exact := terminatingDigits(val.Denom(), base); exact > fractDigits {
+		fractDigits = exact
+	}
+
+	// Work with the absolute value, scaled so that all the digits we want are in the integer portion.
+	// Then round to the nearest integer (half away from zero) and split it back into whole and fractional parts.
+	bigBase := big.NewInt(int64(base))
+	scale := new(big.Int).Exp(bigBase, big.NewInt(int64(fractDigits)), nil)
+	scaledNum := new(big.Int).Mul(new(big.Int).Abs(val.Num()), scale)
+	scaled, rem := new(big.Int).QuoRem(scaledNum, val.Denom(), new(big.Int))
+	if rem.Lsh(rem, 1).Cmp(val.Denom()) >= 0 {
+		scaled.Add(scaled, big.NewInt(1))
+	}
+	whole, fract := new(big.Int).QuoRem(scaled, scale, new(big.Int))
+
+	var rv strings.Builder
+	if val.Sign() < 0 && scaled.Sign() != 0 {
+		rv.WriteByte('-')
+	}
+	rv.WriteString(whole.Text(base))
+	if fractDigits > 0 {
+		fractStr := fract.Text(base)
+		rv.WriteByte('.')
+		rv.WriteString(strings.Repeat("0", fractDigits-len(fractStr)))
+		rv.WriteString(fractStr)
+	}
+	return rv.String()
+}
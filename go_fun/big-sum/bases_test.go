@@ -0,0 +1,242 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSumBases(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		inBase   int
+		outBase  int
+		exp      string
+		expInErr []string
+	}{
+		{
+			name:     "input base too small",
+			args:     []string{"1"},
+			inBase:   1,
+			outBase:  10,
+			expInErr: []string{"invalid input base: 1 is not between 2 and 36"},
+		},
+		{
+			name:     "output base too large",
+			args:     []string{"1"},
+			inBase:   10,
+			outBase:  37,
+			expInErr: []string{"invalid output base: 37 is not between 2 and 36"},
+		},
+		{
+			name:    "nil args",
+			args:    nil,
+			inBase:  16,
+			outBase: 16,
+			exp:     "0",
+		},
+		{
+			name:    "empty strings",
+			args:    []string{"", ""},
+			inBase:  10,
+			outBase: 10,
+			exp:     "0",
+		},
+		{
+			name:     "invalid digit for base",
+			args:     []string{"1f", "12"},
+			inBase:   10,
+			outBase:  10,
+			expInErr: []string{"could not parse \"1f\" as base 10 number: invalid digit 'f'"},
+		},
+		{
+			name:     "invalid digit for prefix base",
+			args:     []string{"0b102"},
+			inBase:   10,
+			outBase:  10,
+			expInErr: []string{"could not parse \"0b102\" as base 2 number: invalid digit '2'"},
+		},
+		{
+			name:     "two decimal points",
+			args:     []string{"1.2.3"},
+			inBase:   10,
+			outBase:  10,
+			expInErr: []string{"could not parse \"1.2.3\" as base 10 number: invalid digit '.'"},
+		},
+		{
+			name:     "just a sign",
+			args:     []string{"-"},
+			inBase:   10,
+			outBase:  10,
+			expInErr: []string{"could not parse \"-\" as base 10 number: no digits"},
+		},
+		{
+			name:    "decimal ints",
+			args:    []string{"12,345", "-45", "1_000"},
+			inBase:  10,
+			outBase: 10,
+			exp:     "13300",
+		},
+		{
+			name:    "decimal floats keep trailing zeros",
+			args:    []string{"1.50", "2.25"},
+			inBase:  10,
+			outBase: 10,
+			exp:     "3.75",
+		},
+		{
+			name:    "hex ints",
+			args:    []string{"ff", "FF", "1"},
+			inBase:  16,
+			outBase: 16,
+			exp:     "1ff",
+		},
+		{
+			name:    "hex ints to decimal",
+			args:    []string{"ff", "0x10"},
+			inBase:  16,
+			outBase: 10,
+			exp:     "271",
+		},
+		{
+			name:    "mixed prefixes to decimal",
+			args:    []string{"0b101", "0o17", "0x1F", "10"},
+			inBase:  10,
+			outBase: 10,
+			exp:     "61",
+		},
+		{
+			name:    "0b is hex digits in base 16",
+			args:    []string{"0b101", "0o17"},
+			inBase:  16,
+			outBase: 16,
+			exp:     "b110",
+		},
+		{
+			name:    "negative prefixed values",
+			args:    []string{"-0x10", "+0b1"},
+			inBase:  10,
+			outBase: 10,
+			exp:     "-15",
+		},
+		{
+			name:    "hex fraction to decimal",
+			args:    []string{"0x1f.8"},
+			inBase:  10,
+			outBase: 10,
+			exp:     "31.50",
+		},
+		{
+			name:    "binary fractions to decimal are exact",
+			args:    []string{"0.01", "0.001"},
+			inBase:  2,
+			outBase: 10,
+			exp:     "0.375",
+		},
+		{
+			name:    "hex fraction to binary",
+			args:    []string{"0.8", "-1.8"},
+			inBase:  16,
+			outBase: 2,
+			exp:     "-1.0000",
+		},
+		{
+			name:    "decimal to hex is rounded",
+			args:    []string{"0.1"},
+			inBase:  10,
+			outBase: 16,
+			exp:     "0.2",
+		},
+		{
+			name:    "base 3 to base 10 is rounded",
+			args:    []string{"0.1", "0.01"},
+			inBase:  3,
+			outBase: 10,
+			exp:     "0.4",
+		},
+		{
+			name:    "base 3 to base 6 is exact",
+			args:    []string{"0.1"},
+			inBase:  3,
+			outBase: 6,
+			exp:     "0.2",
+		},
+		{
+			name:    "hex fraction to octal",
+			args:    []string{"0.ffff"},
+			inBase:  16,
+			outBase: 8,
+			exp:     "0.777774",
+		},
+		{
+			name:    "negative base 3 to base 2 is rounded",
+			args:    []string{"-0.01"},
+			inBase:  3,
+			outBase: 2,
+			exp:     "-0.0010",
+		},
+		{
+			name:    "base 36",
+			args:    []string{"zz", "1"},
+			inBase:  36,
+			outBase: 36,
+			exp:     "100",
+		},
+		{
+			name:    "large hex byte counts",
+			args:    []string{"0xffffffffffffffffffffffff", "0x1"},
+			inBase:  10,
+			outBase: 16,
+			exp:     "1000000000000000000000000",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var act string
+			var err error
+			testFunc := func() {
+				act, err = SumBases(tc.args, tc.inBase, tc.outBase)
+			}
+			require.NotPanics(t, testFunc, "SumBases(%q, %d, %d)", tc.args, tc.inBase, tc.outBase)
+			if len(tc.expInErr) == 0 {
+				assert.NoError(t, err, "SumBases(%q, %d, %d) error", tc.args, tc.inBase, tc.outBase)
+			} else {
+				for _, exp := range tc.expInErr {
+					assert.ErrorContains(t, err, exp, "SumBases(%q, %d, %d) error", tc.args, tc.inBase, tc.outBase)
+				}
+			}
+			assert.Equal(t, tc.exp, act, "SumBases(%q, %d, %d) result", tc.args, tc.inBase, tc.outBase)
+		})
+	}
+}
+
+func TestParseBase(t *testing.T) {
+	tests := []struct {
+		name   string
+		val    string
+		exp    int
+		expErr string
+	}{
+		{name: "two", val: "2", exp: 2},
+		{name: "sixteen", val: "16", exp: 16},
+		{name: "thirty-six", val: "36", exp: 36},
+		{name: "one", val: "1", expErr: "invalid --in-base value \"1\": 1 is not between 2 and 36"},
+		{name: "thirty-seven", val: "37", expErr: "invalid --in-base value \"37\": 37 is not between 2 and 36"},
+		{name: "not a number", val: "hex", expErr: "invalid --in-base value \"hex\": not an integer"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			act, err := parseBase("--in-base", tc.val)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "parseBase(%q) error", tc.val)
+			} else {
+				assert.NoError(t, err, "parseBase(%q) error", tc.val)
+			}
+			assert.Equal(t, tc.exp, act, "parseBase(%q) result", tc.val)
+		})
+	}
+}
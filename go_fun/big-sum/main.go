@@ -16,10 +16,18 @@ func PrintUsage(stdout io.Writer) {
 	fmt.Fprintf(stdout, `big-sum: Add a bunch of numbers together with nearly infinite precision.
 
 Usage: big-sum <number 1> [<number 2> ...] [--pipe|-] [--pretty|-p] [--verbose|-v]
+               [--in-base <base>] [--out-base <base>]
   or : <stuff> | big-sum
 
 The --pipe or - flag is implied if there are no arguments provided.
 The --pretty or -p flag will add commas to the result.
+The --in-base flag defines the base (2 to 36) of the provided numbers. Default is 10.
+The --out-base flag defines the base (2 to 36) of the result. Default is 10.
+
+Any number can have a 0b, 0o, or 0x prefix to indicate that it is binary, octal, or hexadecimal.
+These override the --in-base for that number unless the letter is also a digit in that base.
+E.g. With --in-base 16, 0b101 is the hexadecimal number b101, but 0o17 is octal.
+Fractional values are allowed in any base, e.g. 0x1f.8 is 31.5.
 
 Warning: In rare circumstances, floating point numbers may result in unwanted rounding.
 `)
@@ -894,7 +902,12 @@ func mainE(argsIn []string, stdout io.Writer, stdin io.Reader) error {
 		return err
 	}
 
-	answer, err := Sum(args.Values)
+	var answer string
+	if args.InBase != 0 || args.OutBase != 0 || hasRadixPrefixedFraction(args.Values) {
+		answer, err = SumBases(args.Values, orDefaultBase(args.InBase), orDefaultBase(args.OutBase))
+	} else {
+		answer, err = Sum(args.Values)
+	}
 	if err != nil {
 		return err
 	}
@@ -907,8 +920,18 @@ func mainE(argsIn []string, stdout io.Writer, stdin io.Reader) error {
 
 // sumParams are the parameters defined by command-line arguments on how to behave and execute.
 type sumParams struct {
-	Values []string
-	Pretty bool
+	Values  []string
+	Pretty  bool
+	InBase  int
+	OutBase int
+}
+
+// orDefaultBase returns the provided base, or DefaultBase if it's zero (i.e. wasn't provided).
+func orDefaultBase(base int) int {
+	if base == 0 {
+		return DefaultBase
+	}
+	return base
 }
 
 // processFlags will handle all the flags in the provided args. It will also read stdin if called for.
@@ -937,6 +960,21 @@ func processFlags(argsIn []string, stdout io.Writer, stdin io.Reader) (*sumParam
 			}
 			stdin = nil
 			rv.Values = append(rv.Values, newArgs...)
+		case equalFoldOneOf(arg, "--in-base", "--out-base"):
+			verbosef("[%d]: base flag identified, %q", i, rawArg)
+			if i+1 >= len(argsIn) {
+				return nil, true, fmt.Errorf("no value provided after %s", arg)
+			}
+			i++
+			base, err := parseBase(arg, strings.TrimSpace(argsIn[i]))
+			if err != nil {
+				return nil, true, err
+			}
+			if strings.EqualFold(arg, "--in-base") {
+				rv.InBase = base
+			} else {
+				rv.OutBase = base
+			}
 		default:
 			verbosef("[%d]: number identified, %q", i, rawArg)
 			rv.Values = append(rv.Values, strings.Fields(arg)...)
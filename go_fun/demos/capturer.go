@@ -12,15 +12,38 @@ import (
 
 func main() {
 	fmt.Println(" --- Before capture start --- ")
-	output, err := capturer.CaptureOutput(doCrazyOutput)
+	rec, err := capturer.RecordOutput(doCrazyOutput)
 	if err != nil {
 		panic(err)
 	}
 	fmt.Println(" --- After capture end --- ")
+	output := rec.Output()
 	fmt.Printf("\n\n")
 	fmt.Printf("Captured stdout:\n%s\n\n", indent(output.Stdout))
 	fmt.Printf("Captured stderr:\n%s\n\n", indent(output.Stderr))
 	fmt.Printf("Captured combined:\n%s\n\n", indent(output.Combined))
+	fmt.Printf("Captured events:\n%s\n\n", indent(events(rec)))
+
+	if len(os.Args) > 1 {
+		castFile, err := os.Create(os.Args[1])
+		if err != nil {
+			panic(err)
+		}
+		defer castFile.Close()
+		if err = rec.WriteAsciicast(castFile, 0, 0); err != nil {
+			panic(err)
+		}
+		fmt.Printf("Asciicast written to %s\n", os.Args[1])
+	}
+}
+
+func events(rec *capturer.Recording) string {
+	var sb strings.Builder
+	for _, event := range rec.Events {
+		pre := fmt.Sprintf("[%6dms %s] ", rec.Offset(event).Milliseconds(), event.Stream)
+		sb.WriteString(prefixLines(strings.TrimSuffix(string(event.Data), "\n"), pre) + "\n")
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
 }
 
 func indent(str string) string {
@@ -1,7 +1,6 @@
 package capturer
 
 import (
-	"bytes"
 	"io"
 	"os"
 )
@@ -24,6 +23,14 @@ type BufferedPipe struct {
 	BufferReader io.Reader
 	// Error is the last error encountered by this BufferedPipe.
 	Error error
+	// MaxBytes is the maximum number of bytes to keep in the buffer. If zero or less, there is no limit.
+	// It must be set before starting. Replication is not affected by this limit.
+	MaxBytes int
+	// Overflow is what happens once the buffer has MaxBytes. It must be set before starting.
+	Overflow Overflow
+	// Dropped is the number of bytes dropped from the buffer because of MaxBytes.
+	// It is only set once collected.
+	Dropped int64
 
 	// buffer is the channel used to communicate buffer contents.
 	buffer chan []byte
@@ -76,10 +83,11 @@ func (p *BufferedPipe) Start() {
 	p.panicIfStarted("cannot restart")
 	p.buffer = make(chan []byte)
 	go func() {
-		var b bytes.Buffer
-		if _, p.Error = io.Copy(&b, p.BufferReader); p.Error != nil {
+		b := newLimitedBuffer(p.MaxBytes, p.Overflow)
+		if _, p.Error = io.Copy(b, p.BufferReader); p.Error != nil {
 			b.WriteString("buffer error: " + p.Error.Error())
 		}
+		p.Dropped = b.Dropped()
 		p.buffer <- b.Bytes()
 	}()
 	p.started = true
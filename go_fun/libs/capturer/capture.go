@@ -1,6 +1,7 @@
 package capturer
 
 import (
+	"io"
 	"os"
 )
 
@@ -12,25 +13,40 @@ type CapturedOutput struct {
 }
 
 // CaptureOutput capture all the things written to stdout and stderr during some code execution.
+//
+// It works by swapping out os.Stdout and os.Stderr while the runner runs, so output from other goroutines is captured too.
+// Do not use this in tests that call t.Parallel(), and do not use it at the same time as CaptureOutputFD.
+// Output written straight to the file descriptors (e.g. by cgo code or child processes) is not captured;
+// use CaptureOutputFD for that. For code that accepts an io.Writer, CaptureWriters is safe for parallel tests.
 func CaptureOutput(runner func()) (CapturedOutput, error) {
-	// Create a buffered pipe for the combined stdout stderr.
-	combinedPipe, err := StartNewBufferedPipe("combined")
+	rec, err := RecordOutput(runner)
 	if err != nil {
 		return CapturedOutput{}, err
 	}
-	defer combinedPipe.Close()
+	return rec.Output(), nil
+}
+
+// RecordOutput records all the things written to stdout and stderr during some code execution.
+// It works the same way as CaptureOutput, but provides the ordered, timestamped events instead.
+// Use Options.RecordOutput for callbacks or size limits.
+func RecordOutput(runner func()) (*Recording, error) {
+	return recordOutput(Options{}, runner)
+}
 
-	// Create a buffered pipe for stdout and replicate it to the current stdout and the combined buffered pipe.
-	stdoutPipe, err := StartNewBufferedPipe("stdout", os.Stdout, combinedPipe)
+// recordOutput records all the things written to stdout and stderr during some code execution, using the provided Options.
+func recordOutput(opts Options, runner func()) (*Recording, error) {
+	rec := newRecorder(opts)
+
+	// Create buffered pipes for stdout and stderr that replicate to the current ones and the recorder.
+	stdoutPipe, err := startStreamPipe(rec, StreamStdout, os.Stdout)
 	if err != nil {
-		return CapturedOutput{}, err
+		return nil, err
 	}
 	defer stdoutPipe.Close()
 
-	// Create a buffered pipe for stderr and replicate it to the current stderr and the combined buffered pipe.
-	stderrPipe, err := StartNewBufferedPipe("stderr", os.Stderr, combinedPipe)
+	stderrPipe, err := startStreamPipe(rec, StreamStderr, os.Stderr)
 	if err != nil {
-		return CapturedOutput{}, err
+		return nil, err
 	}
 	defer stderrPipe.Close()
 
@@ -47,17 +63,45 @@ func CaptureOutput(runner func()) (CapturedOutput, error) {
 	// Run the stuff we want to capture.
 	runner()
 
-	// Collect all the output.
-	// Since writes go to the stdout buffered pipe, then stdout, then the combined buffered pipe (and same with stderr),
-	// this is ordered to collect the combined buffered pipe last.
-	// That way, there isn't a writer open that is replicating to a closed combined pipe.
-	stdoutBz := stdoutPipe.Collect()
-	stderrBz := stderrPipe.Collect()
-	combinedBz := combinedPipe.Collect()
-
-	return CapturedOutput{
-		Stdout:   string(stdoutBz),
-		Stderr:   string(stderrBz),
-		Combined: string(combinedBz),
-	}, nil
+	// Wait for everything to make it through the pipes.
+	if err = collectStreamPipes(stdoutPipe, stderrPipe); err != nil {
+		return nil, err
+	}
+	return rec.finish(), nil
+}
+
+// startStreamPipe creates and starts a BufferedPipe for a stream.
+// It replicates to the provided writer (unless it's nil), then to the recorder.
+// The recorder has everything needed, so the pipe's own buffer is kept to a minimum.
+func startStreamPipe(rec *recorder, stream Stream, replicateTo io.Writer) (*BufferedPipe, error) {
+	p, err := NewBufferedPipe(stream.String())
+	if err != nil {
+		return nil, err
+	}
+	if replicateTo != nil {
+		p.AddReplicationTo(replicateTo)
+	}
+	p.AddReplicationTo(rec.Writer(stream))
+	limitStreamPipe(&p)
+	p.Start()
+	return &p, nil
+}
+
+// limitStreamPipe sets the buffer limit of a stream pipe, whose contents aren't needed.
+// A ring of a few bytes is kept so that any buffer error message can still be seen.
+func limitStreamPipe(p *BufferedPipe) {
+	p.MaxBytes = 1024
+	p.Overflow = OverflowRing
+}
+
+// collectStreamPipes collects each of the provided pipes, returning the first error any of them encountered.
+func collectStreamPipes(pipes ...*BufferedPipe) error {
+	var err error
+	for _, p := range pipes {
+		p.Collect()
+		if err == nil && p.Error != nil {
+			err = p.Error
+		}
+	}
+	return err
 }
@@ -0,0 +1,109 @@
+package capturer
+
+import (
+	"errors"
+)
+
+// ErrFDCaptureUnsupported is returned by CaptureOutputFD on systems where it is not available.
+var ErrFDCaptureUnsupported = errors.New("file descriptor capture is only supported on linux")
+
+// CaptureOutputFD captures all the things written to the stdout and stderr file descriptors during some code execution.
+//
+// Unlike CaptureOutput, os.Stdout and os.Stderr are left alone; the file descriptors behind them (1 and 2) are
+// redirected instead. So this also captures output written directly to those file descriptors, e.g. by cgo code,
+// or by child processes that inherit them. C stdio buffers are not flushed for you, though.
+// Any child process still holding the file descriptors must exit before this can return.
+//
+// This is only available on linux. Elsewhere, ErrFDCaptureUnsupported is returned and the runner is not run.
+//
+// The file descriptors belong to the whole process, so output from other goroutines is captured too.
+// Do not use this in tests that call t.Parallel(), and do not use it at the same time as CaptureOutput.
+// See CaptureWriters for an alternative that is safe for parallel tests.
+func CaptureOutputFD(runner func()) (CapturedOutput, error) {
+	rec, err := RecordOutputFD(runner)
+	if err != nil {
+		return CapturedOutput{}, err
+	}
+	return rec.Output(), nil
+}
+
+// RecordOutputFD records all the things written to the stdout and stderr file descriptors during some code execution.
+// It works the same way as CaptureOutputFD, but provides the ordered, timestamped events instead.
+// Use Options.RecordOutputFD for callbacks or size limits.
+func RecordOutputFD(runner func()) (*Recording, error) {
+	return recordOutputFD(Options{}, runner)
+}
+
+// recordOutputFD records all the things written to the stdout and stderr file descriptors during some code execution,
+// using the provided Options.
+func recordOutputFD(opts Options, runner func()) (*Recording, error) {
+	rec := newRecorder(opts)
+
+	// Create buffered pipes for stdout and stderr. They can't be started until we know where to replicate to.
+	stdoutPipe, err := NewBufferedPipe(StreamStdout.String())
+	if err != nil {
+		return nil, err
+	}
+	defer stdoutPipe.Close()
+
+	stderrPipe, err := NewBufferedPipe(StreamStderr.String())
+	if err != nil {
+		return nil, err
+	}
+	defer stderrPipe.Close()
+
+	// Point the stdout and stderr file descriptors at our buffered pipes.
+	// The returned files are where they used to point (e.g. the terminal), which is where we replicate to.
+	origStdout, restoreStdout, err := redirectFD(1, "stdout", stdoutPipe.Writer)
+	if err != nil {
+		return nil, err
+	}
+	defer origStdout.Close()
+
+	origStderr, restoreStderr, err := redirectFD(2, "stderr", stderrPipe.Writer)
+	if err != nil {
+		_ = restoreStdout()
+		return nil, err
+	}
+	defer origStderr.Close()
+
+	// The restore is deferred too, so that a panicking runner doesn't leave the file descriptors redirected.
+	restored := false
+	restore := func() error {
+		if restored {
+			return nil
+		}
+		restored = true
+		errOut := restoreStdout()
+		errErr := restoreStderr()
+		if errOut != nil {
+			return errOut
+		}
+		return errErr
+	}
+	defer func() {
+		_ = restore()
+	}()
+
+	stdoutPipe.AddReplicationTo(origStdout, rec.Writer(StreamStdout))
+	limitStreamPipe(&stdoutPipe)
+	stdoutPipe.Start()
+	stderrPipe.AddReplicationTo(origStderr, rec.Writer(StreamStderr))
+	limitStreamPipe(&stderrPipe)
+	stderrPipe.Start()
+
+	// Run the stuff we want to capture.
+	runner()
+
+	// The file descriptors must be put back before collecting.
+	// Otherwise, they'd still be open writers on our pipes, and the collection would never finish.
+	if err = restore(); err != nil {
+		return nil, err
+	}
+
+	// Wait for everything to make it through the pipes.
+	if err = collectStreamPipes(&stdoutPipe, &stderrPipe); err != nil {
+		return nil, err
+	}
+	return rec.finish(), nil
+}
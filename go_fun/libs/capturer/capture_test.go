@@ -0,0 +1,129 @@
+package capturer
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"sync"
+	"syscall"
+	"testing"
+)
+
+func TestCaptureOutput(t *testing.T) {
+	output, err := CaptureOutput(func() {
+		fmt.Fprint(os.Stdout, "out 1\n")
+		fmt.Fprint(os.Stderr, "err 1\n")
+		fmt.Fprint(os.Stdout, "out 2\n")
+	})
+	if err != nil {
+		t.Fatalf("CaptureOutput error: %v", err)
+	}
+	if exp := "out 1\nout 2\n"; output.Stdout != exp {
+		t.Errorf("Stdout = %q, expected %q", output.Stdout, exp)
+	}
+	if exp := "err 1\n"; output.Stderr != exp {
+		t.Errorf("Stderr = %q, expected %q", output.Stderr, exp)
+	}
+	if exp := 18; len(output.Combined) != exp {
+		t.Errorf("len(Combined) = %d, expected %d: %q", len(output.Combined), exp, output.Combined)
+	}
+}
+
+func TestCaptureOutputFD(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		_, err := CaptureOutputFD(func() {})
+		if err != ErrFDCaptureUnsupported {
+			t.Fatalf("CaptureOutputFD error = %v, expected %v", err, ErrFDCaptureUnsupported)
+		}
+		return
+	}
+
+	origStdout, origStderr := os.Stdout, os.Stderr
+	output, err := CaptureOutputFD(func() {
+		fmt.Fprint(os.Stdout, "go out\n")
+		fmt.Fprint(os.Stderr, "go err\n")
+		_, _ = syscall.Write(1, []byte("fd out\n"))
+		_, _ = syscall.Write(2, []byte("fd err\n"))
+
+		cmd := exec.Command("sh", "-c", "echo child out; echo child err >&2")
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		if cmdErr := cmd.Run(); cmdErr != nil {
+			t.Errorf("child process error: %v", cmdErr)
+		}
+	})
+	if err != nil {
+		t.Fatalf("CaptureOutputFD error: %v", err)
+	}
+	if exp := "go out\nfd out\nchild out\n"; output.Stdout != exp {
+		t.Errorf("Stdout = %q, expected %q", output.Stdout, exp)
+	}
+	if exp := "go err\nfd err\nchild err\n"; output.Stderr != exp {
+		t.Errorf("Stderr = %q, expected %q", output.Stderr, exp)
+	}
+	if exp := len(output.Stdout) + len(output.Stderr); len(output.Combined) != exp {
+		t.Errorf("len(Combined) = %d, expected %d: %q", len(output.Combined), exp, output.Combined)
+	}
+	if os.Stdout != origStdout || os.Stderr != origStderr {
+		t.Errorf("os.Stdout or os.Stderr was changed")
+	}
+
+	// Once done, the file descriptors should be back to normal.
+	output, err = CaptureOutputFD(func() {})
+	if err != nil {
+		t.Fatalf("second CaptureOutputFD error: %v", err)
+	}
+	if len(output.Combined) != 0 {
+		t.Errorf("second Combined = %q, expected empty", output.Combined)
+	}
+}
+
+func TestCaptureWriters(t *testing.T) {
+	t.Parallel()
+
+	output := CaptureWriters(func(stdout, stderr io.Writer) {
+		fmt.Fprint(stdout, "out 1\n")
+		fmt.Fprint(stderr, "err 1\n")
+		fmt.Fprint(stdout, "out 2\n")
+	})
+	if exp := "out 1\nout 2\n"; output.Stdout != exp {
+		t.Errorf("Stdout = %q, expected %q", output.Stdout, exp)
+	}
+	if exp := "err 1\n"; output.Stderr != exp {
+		t.Errorf("Stderr = %q, expected %q", output.Stderr, exp)
+	}
+	if exp := "out 1\nerr 1\nout 2\n"; output.Combined != exp {
+		t.Errorf("Combined = %q, expected %q", output.Combined, exp)
+	}
+}
+
+func TestCaptureWritersConcurrent(t *testing.T) {
+	t.Parallel()
+
+	output := CaptureWriters(func(stdout, stderr io.Writer) {
+		var wg sync.WaitGroup
+		for i := 0; i < 50; i++ {
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				fmt.Fprint(stdout, "out\n")
+			}()
+			go func() {
+				defer wg.Done()
+				fmt.Fprint(stderr, "err\n")
+			}()
+		}
+		wg.Wait()
+	})
+	if exp := 200; len(output.Stdout) != exp {
+		t.Errorf("len(Stdout) = %d, expected %d", len(output.Stdout), exp)
+	}
+	if exp := 200; len(output.Stderr) != exp {
+		t.Errorf("len(Stderr) = %d, expected %d", len(output.Stderr), exp)
+	}
+	if exp := 400; len(output.Combined) != exp {
+		t.Errorf("len(Combined) = %d, expected %d", len(output.Combined), exp)
+	}
+}
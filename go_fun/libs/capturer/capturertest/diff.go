@@ -0,0 +1,154 @@
+package capturertest
+
+import (
+	"fmt"
+	"strings"
+)
+
+// diffContext is the number of unchanged lines shown around each change in a unified diff.
+const diffContext = 3
+
+// diffOp is a single line of a diff.
+type diffOp struct {
+	// kind is ' ' for an unchanged line, '-' for a removed line, or '+' for an added line.
+	kind byte
+	// line is the line (without the line ending).
+	line string
+	// aLine and bLine are the (zero based) line numbers of this line in each of the inputs.
+	// For an added line, aLine is where it would go, and vice versa for a removed line.
+	aLine int
+	bLine int
+}
+
+// UnifiedDiff gets a unified diff (like diff -u) that turns a into b.
+// The names are used in the header lines. An empty string is returned if a and b are the same.
+//
+// The diff is found using the longest common subsequence of lines, which is fine for the size of most golden files,
+// but uses memory proportional to the number of lines in a times the number of lines in b.
+func UnifiedDiff(aName, bName, a, b string) string {
+	if a == b {
+		return ""
+	}
+	ops := diffLines(splitLines(a), splitLines(b))
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", aName, bName)
+	for start := 0; start < len(ops); {
+		// Find the next change.
+		for start < len(ops) && ops[start].kind == ' ' {
+			start++
+		}
+		if start == len(ops) {
+			break
+		}
+		// A hunk includes the context before the change, and continues until
+		// there are more than twice the context of unchanged lines in a row.
+		hunkStart := start - diffContext
+		if hunkStart < 0 {
+			hunkStart = 0
+		}
+		end := start
+		for unchanged := 0; end < len(ops) && unchanged <= 2*diffContext; end++ {
+			if ops[end].kind == ' ' {
+				unchanged++
+			} else {
+				unchanged = 0
+			}
+		}
+		// Back up to only include the context after the last change.
+		for end > start && ops[end-1].kind == ' ' {
+			end--
+		}
+		hunkEnd := end + diffContext
+		if hunkEnd > len(ops) {
+			hunkEnd = len(ops)
+		}
+		writeHunk(&sb, ops[hunkStart:hunkEnd])
+		start = hunkEnd
+	}
+	return sb.String()
+}
+
+// writeHunk writes the header and lines of a single hunk of a unified diff.
+func writeHunk(sb *strings.Builder, ops []diffOp) {
+	aCount, bCount := 0, 0
+	for _, op := range ops {
+		if op.kind != '+' {
+			aCount++
+		}
+		if op.kind != '-' {
+			bCount++
+		}
+	}
+	fmt.Fprintf(sb, "@@ -%s +%s @@\n", hunkRange(ops[0].aLine, aCount), hunkRange(ops[0].bLine, bCount))
+	for _, op := range ops {
+		sb.WriteByte(op.kind)
+		sb.WriteString(op.line)
+		sb.WriteByte('\n')
+	}
+}
+
+// hunkRange gets the range of lines in a hunk header, e.g. "3,7".
+// Line numbers are one based, except when there are no lines, in which case it's the line before.
+func hunkRange(start, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
+
+// splitLines splits the provided string into lines.
+// A final line ending does not create an extra empty line, and a missing final line ending is noted.
+func splitLines(str string) []string {
+	if len(str) == 0 {
+		return nil
+	}
+	noEOL := !strings.HasSuffix(str, "\n")
+	lines := strings.Split(strings.TrimSuffix(str, "\n"), "\n")
+	if noEOL {
+		lines[len(lines)-1] += "\n\\ No newline at end of file"
+	}
+	return lines
+}
+
+// diffLines gets the diff operations that turn a into b.
+func diffLines(a, b []string) []diffOp {
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	rv := make([]diffOp, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			rv = append(rv, diffOp{kind: ' ', line: a[i], aLine: i, bLine: j})
+			i++
+			j++
+		case j == len(b) || (i < len(a) && lcs[i+1][j] >= lcs[i][j+1]):
+			rv = append(rv, diffOp{kind: '-', line: a[i], aLine: i, bLine: j})
+			i++
+		default:
+			rv = append(rv, diffOp{kind: '+', line: b[j], aLine: i, bLine: j})
+			j++
+		}
+	}
+	return rv
+}
@@ -0,0 +1,86 @@
+package capturertest
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	// numbered gets lines "1\n" through "n\n", applying the provided changes (line number => new line).
+	numbered := func(n int, changes map[int]string) string {
+		var sb strings.Builder
+		for i := 1; i <= n; i++ {
+			line, changed := changes[i]
+			if !changed {
+				line = strings.Repeat("x", i%5) + string(rune('a'+i%26))
+			}
+			if len(line) > 0 {
+				sb.WriteString(line + "\n")
+			}
+		}
+		return sb.String()
+	}
+
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		exp  string
+	}{
+		{name: "same", a: "one\ntwo\n", b: "one\ntwo\n", exp: ""},
+		{
+			name: "both empty",
+			a:    "",
+			b:    "",
+			exp:  "",
+		},
+		{
+			name: "from empty",
+			a:    "",
+			b:    "one\ntwo\n",
+			exp:  "--- a\n+++ b\n@@ -0,0 +1,2 @@\n+one\n+two\n",
+		},
+		{
+			name: "to empty",
+			a:    "one\n",
+			b:    "",
+			exp:  "--- a\n+++ b\n@@ -1 +0,0 @@\n-one\n",
+		},
+		{
+			name: "changed line",
+			a:    "one\ntwo\nthree\n",
+			b:    "one\n2\nthree\n",
+			exp:  "--- a\n+++ b\n@@ -1,3 +1,3 @@\n one\n-two\n+2\n three\n",
+		},
+		{
+			name: "missing final newline",
+			a:    "one\ntwo\n",
+			b:    "one\ntwo",
+			exp:  "--- a\n+++ b\n@@ -1,2 +1,2 @@\n one\n-two\n+two\n\\ No newline at end of file\n",
+		},
+		{
+			name: "two hunks",
+			a:    numbered(20, nil),
+			b:    numbered(20, map[int]string{2: "changed", 18: ""}),
+			exp: "--- a\n+++ b\n" +
+				"@@ -1,5 +1,5 @@\n xb\n-xxc\n+changed\n xxxd\n xxxxe\n f\n" +
+				"@@ -15,6 +15,5 @@\n p\n xq\n xxr\n-xxxs\n xxxxt\n u\n",
+		},
+		{
+			name: "close changes in one hunk",
+			a:    numbered(12, nil),
+			b:    numbered(12, map[int]string{3: "three", 9: "nine"}),
+			exp: "--- a\n+++ b\n" +
+				"@@ -1,12 +1,12 @@\n xb\n xxc\n-xxxd\n+three\n xxxxe\n f\n xg\n xxh\n xxxi\n-xxxxj\n+nine\n k\n xl\n xxm\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			act := UnifiedDiff("a", "b", tc.a, tc.b)
+			if act != tc.exp {
+				t.Errorf("UnifiedDiff result:\n%s\nexpected:\n%s", act, tc.exp)
+			}
+		})
+	}
+}
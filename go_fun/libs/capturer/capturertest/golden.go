@@ -0,0 +1,83 @@
+// Package capturertest has helpers for testing output captured with the capturer package against golden files.
+//
+// Golden files are kept in the testdata directory of the package being tested.
+// Run the tests with the -update flag to rewrite them with the actual output, e.g. go test ./... -update
+// Since this package defines the -update flag, test packages that use it cannot define their own.
+package capturertest
+
+import (
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"spicylemon/libs/capturer"
+)
+
+// update is the -update flag, which causes golden files to be rewritten instead of compared against.
+var update = flag.Bool("update", false, "rewrite golden files with the actual output")
+
+// GoldenDir is the directory that golden files are in, relative to the package being tested.
+const GoldenDir = "testdata"
+
+// GoldenPath gets the path to the golden file with the provided name.
+func GoldenPath(name string) string {
+	return filepath.Join(GoldenDir, name+".golden")
+}
+
+// AssertGolden compares the actual string against the golden file with the provided name.
+// The normalizers are applied to the actual string before comparing.
+// If they're different, the test fails with a unified diff.
+// With the -update flag, the golden file is rewritten instead (and the test doesn't fail).
+//
+// Returns true if the actual string matches the golden file (or it was updated).
+func AssertGolden(t testing.TB, name, actual string, normalizers ...Normalizer) bool {
+	t.Helper()
+	path := GoldenPath(name)
+	actual = Normalize(actual, normalizers...)
+
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("could not create golden file directory: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(actual), 0o644); err != nil {
+			t.Fatalf("could not update golden file: %v", err)
+		}
+		t.Logf("updated golden file %s", path)
+		return true
+	}
+
+	expected, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			t.Errorf("golden file %s does not exist: run the tests with -update to create it", path)
+			return false
+		}
+		t.Errorf("could not read golden file: %v", err)
+		return false
+	}
+
+	if diff := UnifiedDiff(path, "actual", string(expected), actual); len(diff) > 0 {
+		t.Errorf("output does not match golden file %s (run the tests with -update to rewrite it):\n%s", path, diff)
+		return false
+	}
+	return true
+}
+
+// AssertOutput runs the runner using capturer.CaptureOutput, then compares its stdout and stderr against
+// the golden files with the provided name and a .stdout or .stderr suffix, e.g. "name.stdout".
+// The normalizers are applied to the output before comparing.
+// The combined output isn't compared since its ordering can differ between runs.
+//
+// The captured output is returned (without any normalization).
+func AssertOutput(t testing.TB, name string, runner func(), normalizers ...Normalizer) capturer.CapturedOutput {
+	t.Helper()
+	output, err := capturer.CaptureOutput(runner)
+	if err != nil {
+		t.Fatalf("could not capture output: %v", err)
+	}
+	AssertGolden(t, name+".stdout", output.Stdout, normalizers...)
+	AssertGolden(t, name+".stderr", output.Stderr, normalizers...)
+	return output
+}
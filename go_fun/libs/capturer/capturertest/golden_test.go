@@ -0,0 +1,74 @@
+package capturertest
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeTB is a testing.TB that records failures instead of failing.
+type fakeTB struct {
+	testing.TB
+	errors []string
+}
+
+func (f *fakeTB) Helper() {}
+
+func (f *fakeTB) Errorf(format string, args ...interface{}) {
+	f.errors = append(f.errors, fmt.Sprintf(format, args...))
+}
+
+func (f *fakeTB) Fatalf(format string, args ...interface{}) {
+	panic(fmt.Sprintf(format, args...))
+}
+
+func (f *fakeTB) Logf(string, ...interface{}) {}
+
+func TestAssertOutput(t *testing.T) {
+	output := AssertOutput(t, "assert_output", func() {
+		fmt.Fprintf(os.Stdout, "\x1b[32mstarted\x1b[0m at %s\n", time.Now().Format(time.RFC3339))
+		fmt.Fprintln(os.Stdout, "done")
+		fmt.Fprintln(os.Stderr, "a warning")
+	}, NormalizeANSI, NormalizeTimestamps)
+
+	// The returned output isn't normalized.
+	if !strings.HasPrefix(output.Stdout, "\x1b[32mstarted") {
+		t.Errorf("returned Stdout = %q, expected it to not be normalized", output.Stdout)
+	}
+}
+
+func TestAssertGoldenMismatch(t *testing.T) {
+	if *update {
+		t.Skip("not applicable with -update")
+	}
+
+	tb := &fakeTB{}
+	if AssertGolden(tb, "mismatch", "line one\nline 2\nline three\n") {
+		t.Errorf("AssertGolden = true, expected false")
+	}
+	if len(tb.errors) != 1 {
+		t.Fatalf("errors = %q, expected 1 error", tb.errors)
+	}
+	exp := "output does not match golden file testdata/mismatch.golden (run the tests with -update to rewrite it):\n" +
+		"--- testdata/mismatch.golden\n+++ actual\n@@ -1,3 +1,3 @@\n line one\n-line two\n+line 2\n line three\n"
+	if tb.errors[0] != exp {
+		t.Errorf("error:\n%s\nexpected:\n%s", tb.errors[0], exp)
+	}
+}
+
+func TestAssertGoldenMissing(t *testing.T) {
+	if *update {
+		t.Skip("not applicable with -update")
+	}
+
+	tb := &fakeTB{}
+	if AssertGolden(tb, "does_not_exist", "anything") {
+		t.Errorf("AssertGolden = true, expected false")
+	}
+	exp := []string{"golden file testdata/does_not_exist.golden does not exist: run the tests with -update to create it"}
+	if fmt.Sprintf("%q", tb.errors) != fmt.Sprintf("%q", exp) {
+		t.Errorf("errors = %q, expected %q", tb.errors, exp)
+	}
+}
@@ -0,0 +1,80 @@
+package capturertest
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// A Normalizer changes output so that it's the same between runs, e.g. by replacing timestamps with a placeholder.
+type Normalizer func(string) string
+
+const (
+	// TimestampPlaceholder is what NormalizeTimestamps replaces timestamps with.
+	TimestampPlaceholder = "<TIMESTAMP>"
+	// TempPathPlaceholder is what NormalizeTempPaths replaces temporary paths with.
+	TempPathPlaceholder = "<TEMP_PATH>"
+)
+
+var (
+	// timestampRx matches dates with times, e.g. 2006-01-02T15:04:05Z or 2006-01-02 15:04:05.000 -0700.
+	// It also matches the default format of a time.Time, e.g. 2006-01-02 15:04:05.999999999 -0700 MST.
+	timestampRx = regexp.MustCompile(
+		`\d{4}-\d{2}-\d{2}[T ]\d{2}:\d{2}:\d{2}(\.\d+)?( ?(Z|[+-]\d{2}:?\d{2}))?( [A-Z]{3,5})?( m=[+-]\d+\.\d+)?`,
+	)
+	// ansiRx matches ANSI escape sequences, e.g. the ones that change the color of text.
+	ansiRx = regexp.MustCompile("\x1b\\[[0-9;?]*[ -/]*[@-~]")
+)
+
+// NormalizeTimestamps replaces dates with times (e.g. 2006-01-02T15:04:05Z) with TimestampPlaceholder.
+func NormalizeTimestamps(str string) string {
+	return timestampRx.ReplaceAllString(str, TimestampPlaceholder)
+}
+
+// NormalizeANSI removes ANSI escape sequences (e.g. colors) from the output.
+func NormalizeANSI(str string) string {
+	return ansiRx.ReplaceAllString(str, "")
+}
+
+// NormalizeTempPaths replaces each path in the system's temporary directory with TempPathPlaceholder.
+// The whole path is replaced since they usually have random parts, e.g. those from t.TempDir().
+// Use ReplacePath to keep the rest of the path.
+func NormalizeTempPaths(str string) string {
+	for _, dir := range tempDirs() {
+		rx := regexp.MustCompile(regexp.QuoteMeta(dir) + `([/\\][^\s"'` + "`" + `]*)?`)
+		str = rx.ReplaceAllString(str, TempPathPlaceholder)
+	}
+	return str
+}
+
+// tempDirs gets the system's temporary directory, both as it is and with any symlinks resolved.
+// The longest is first, so that it's replaced before any shorter one that it contains.
+func tempDirs() []string {
+	dir := strings.TrimRight(os.TempDir(), `/\`)
+	rv := []string{dir}
+	if resolved, err := filepath.EvalSymlinks(dir); err == nil && resolved != dir {
+		if len(resolved) > len(dir) {
+			rv = []string{resolved, dir}
+		} else {
+			rv = append(rv, resolved)
+		}
+	}
+	return rv
+}
+
+// ReplacePath creates a Normalizer that replaces the provided path with the provided placeholder.
+// The rest of any longer path is kept, e.g. ReplacePath(t.TempDir(), "<DIR>") turns "<temp dir>/a.txt" into "<DIR>/a.txt".
+func ReplacePath(path, placeholder string) Normalizer {
+	return func(str string) string {
+		return strings.ReplaceAll(str, path, placeholder)
+	}
+}
+
+// Normalize applies each of the provided normalizers (in order) to the provided string.
+func Normalize(str string, normalizers ...Normalizer) string {
+	for _, normalizer := range normalizers {
+		str = normalizer(str)
+	}
+	return str
+}
@@ -0,0 +1,58 @@
+package capturertest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestNormalizeTimestamps(t *testing.T) {
+	when := time.Date(2023, 5, 4, 3, 2, 1, 123456789, time.FixedZone("MDT", -6*60*60))
+	tests := []struct {
+		name string
+		str  string
+		exp  string
+	}{
+		{name: "rfc3339", str: "at " + when.Format(time.RFC3339) + ".", exp: "at <TIMESTAMP>."},
+		{name: "rfc3339 nano", str: when.UTC().Format(time.RFC3339Nano) + " done", exp: "<TIMESTAMP> done"},
+		{name: "time string", str: "[" + when.String() + "]", exp: "[<TIMESTAMP>]"},
+		{name: "log format", str: "2023/05/04 is not a timestamp, 2023-05-04 03:02:01 is", exp: "2023/05/04 is not a timestamp, <TIMESTAMP> is"},
+		{name: "date only", str: "on 2023-05-04", exp: "on 2023-05-04"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if act := NormalizeTimestamps(tc.str); act != tc.exp {
+				t.Errorf("NormalizeTimestamps(%q) = %q, expected %q", tc.str, act, tc.exp)
+			}
+		})
+	}
+}
+
+func TestNormalizeANSI(t *testing.T) {
+	str := "\x1b[1;31mError:\x1b[0m something \x1b[32mgreen\x1b[m\x1b[2K"
+	exp := "Error: something green"
+	if act := NormalizeANSI(str); act != exp {
+		t.Errorf("NormalizeANSI(%q) = %q, expected %q", str, act, exp)
+	}
+}
+
+func TestNormalizeTempPaths(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "out.txt")
+	str := "wrote " + file + " and '" + os.TempDir() + "'\n"
+	exp := "wrote <TEMP_PATH> and '<TEMP_PATH>'\n"
+	if act := NormalizeTempPaths(str); act != exp {
+		t.Errorf("NormalizeTempPaths(%q) = %q, expected %q", str, act, exp)
+	}
+}
+
+func TestReplacePath(t *testing.T) {
+	dir := t.TempDir()
+	str := "wrote " + filepath.Join(dir, "out.txt")
+	exp := "wrote " + filepath.Join("<DIR>", "out.txt")
+	if act := Normalize(str, ReplacePath(dir, "<DIR>")); act != exp {
+		t.Errorf("ReplacePath result = %q, expected %q", act, exp)
+	}
+}
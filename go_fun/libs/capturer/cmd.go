@@ -0,0 +1,93 @@
+package capturer
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+	"time"
+)
+
+// CmdResult is the result of running a command using CaptureCmd.
+type CmdResult struct {
+	// Output is the captured output of the command.
+	Output CapturedOutput
+	// Recording has the ordered, timestamped events of the command's output.
+	Recording *Recording
+	// ExitCode is the exit code of the command, or -1 if it was killed by a signal.
+	ExitCode int
+	// Signal is the signal that killed the command, or nil if it exited on its own.
+	Signal os.Signal
+	// Duration is how long the command ran.
+	Duration time.Duration
+}
+
+// CaptureCmd runs the provided command, capturing its stdout and stderr.
+//
+// If cmd.Stdout or cmd.Stderr are already set, the output is replicated to them, the same as AddReplicationTo does.
+// E.g. set them to os.Stdout and os.Stderr to also see the output in the terminal.
+// To feed the command some input, set cmd.Stdin (e.g. to a strings.Reader).
+//
+// The command must not have been started yet. This waits for the command to finish.
+// Any child processes that still hold the command's stdout or stderr must also exit before this can return.
+//
+// The returned error is only for problems running the command or capturing its output (e.g. the command not existing).
+// A non-zero exit code or a signal is reported in the CmdResult instead of as an error.
+func CaptureCmd(cmd *exec.Cmd) (*CmdResult, error) {
+	return recordCmd(Options{}, cmd)
+}
+
+// CaptureCmd runs the provided command, capturing its stdout and stderr, using these Options.
+// See also: the package level CaptureCmd function.
+func (o Options) CaptureCmd(cmd *exec.Cmd) (*CmdResult, error) {
+	return recordCmd(o, cmd)
+}
+
+// recordCmd runs the provided command, capturing its stdout and stderr, using the provided Options.
+func recordCmd(opts Options, cmd *exec.Cmd) (*CmdResult, error) {
+	rec := newRecorder(opts)
+
+	// Create buffered pipes for stdout and stderr that replicate to whatever the command already had.
+	stdoutPipe, err := startStreamPipe(rec, StreamStdout, cmd.Stdout)
+	if err != nil {
+		return nil, err
+	}
+	defer stdoutPipe.Close()
+
+	stderrPipe, err := startStreamPipe(rec, StreamStderr, cmd.Stderr)
+	if err != nil {
+		return nil, err
+	}
+	defer stderrPipe.Close()
+
+	// Since these are files, the command writes straight to them.
+	cmd.Stdout = stdoutPipe.Writer
+	cmd.Stderr = stderrPipe.Writer
+
+	start := time.Now()
+	if err = cmd.Start(); err != nil {
+		_ = collectStreamPipes(stdoutPipe, stderrPipe)
+		return nil, err
+	}
+	waitErr := cmd.Wait()
+	duration := time.Since(start)
+
+	// Wait for everything to make it through the pipes.
+	if err = collectStreamPipes(stdoutPipe, stderrPipe); err != nil {
+		return nil, err
+	}
+	// An ExitError just means a non-zero exit code or a signal, both of which are in the result.
+	if _, isExitErr := waitErr.(*exec.ExitError); waitErr != nil && !isExitErr {
+		return nil, waitErr
+	}
+
+	rv := &CmdResult{
+		Recording: rec.finish(),
+		ExitCode:  cmd.ProcessState.ExitCode(),
+		Duration:  duration,
+	}
+	rv.Output = rv.Recording.Output()
+	if status, ok := cmd.ProcessState.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+		rv.Signal = status.Signal()
+	}
+	return rv, nil
+}
@@ -0,0 +1,114 @@
+package capturer
+
+import (
+	"bytes"
+	"os/exec"
+	"runtime"
+	"strings"
+	"syscall"
+	"testing"
+)
+
+func TestCaptureCmd(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("these commands need a unix shell")
+	}
+
+	tests := []struct {
+		name        string
+		script      string
+		stdin       string
+		expStdout   string
+		expStderr   string
+		expCombined string
+		expExitCode int
+		expSignal   bool
+	}{
+		{
+			name:        "success",
+			script:      "echo one; echo two >&2; echo three",
+			expStdout:   "one\nthree\n",
+			expStderr:   "two\n",
+			expCombined: "one\ntwo\nthree\n",
+		},
+		{
+			name:        "exit code",
+			script:      "echo failing >&2; exit 3",
+			expStderr:   "failing\n",
+			expCombined: "failing\n",
+			expExitCode: 3,
+		},
+		{
+			name:        "stdin",
+			script:      "tr a-z A-Z",
+			stdin:       "shout\n",
+			expStdout:   "SHOUT\n",
+			expCombined: "SHOUT\n",
+		},
+		{
+			name:        "signal",
+			script:      "echo bye; kill -TERM $$",
+			expStdout:   "bye\n",
+			expCombined: "bye\n",
+			expExitCode: -1,
+			expSignal:   true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Sleeping between the writes makes sure they're read from the pipes in order.
+			script := strings.ReplaceAll(tc.script, "; ", "; sleep 0.05; ")
+			cmd := exec.Command("sh", "-c", script)
+			var replicated bytes.Buffer
+			cmd.Stdout = &replicated
+			if len(tc.stdin) > 0 {
+				cmd.Stdin = strings.NewReader(tc.stdin)
+			}
+
+			result, err := CaptureCmd(cmd)
+			if err != nil {
+				t.Fatalf("CaptureCmd error: %v", err)
+			}
+			if result.Output.Stdout != tc.expStdout {
+				t.Errorf("Stdout = %q, expected %q", result.Output.Stdout, tc.expStdout)
+			}
+			if result.Output.Stderr != tc.expStderr {
+				t.Errorf("Stderr = %q, expected %q", result.Output.Stderr, tc.expStderr)
+			}
+			if result.Output.Combined != tc.expCombined {
+				t.Errorf("Combined = %q, expected %q", result.Output.Combined, tc.expCombined)
+			}
+			if replicated.String() != tc.expStdout {
+				t.Errorf("replicated stdout = %q, expected %q", replicated.String(), tc.expStdout)
+			}
+			if result.ExitCode != tc.expExitCode {
+				t.Errorf("ExitCode = %d, expected %d", result.ExitCode, tc.expExitCode)
+			}
+			switch {
+			case tc.expSignal && result.Signal != syscall.SIGTERM:
+				t.Errorf("Signal = %v, expected %v", result.Signal, syscall.SIGTERM)
+			case !tc.expSignal && result.Signal != nil:
+				t.Errorf("Signal = %v, expected nil", result.Signal)
+			}
+			if result.Duration <= 0 {
+				t.Errorf("Duration = %s, expected more than zero", result.Duration)
+			}
+		})
+	}
+}
+
+func TestCaptureCmdNotFound(t *testing.T) {
+	t.Parallel()
+
+	result, err := CaptureCmd(exec.Command("this-command-does-not-exist-anywhere"))
+	if err == nil {
+		t.Errorf("CaptureCmd error = nil, expected an error")
+	}
+	if result != nil {
+		t.Errorf("CaptureCmd result = %#v, expected nil", result)
+	}
+}
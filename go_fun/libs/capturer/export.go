@@ -0,0 +1,85 @@
+package capturer
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultCastWidth is the terminal width used in an asciicast when one isn't provided.
+	DefaultCastWidth = 80
+	// DefaultCastHeight is the terminal height used in an asciicast when one isn't provided.
+	DefaultCastHeight = 24
+)
+
+// jsonRecording is the JSON form of a Recording.
+type jsonRecording struct {
+	Start  time.Time   `json:"start"`
+	End    time.Time   `json:"end"`
+	Events []jsonEvent `json:"events"`
+}
+
+// jsonEvent is the JSON form of an Event.
+type jsonEvent struct {
+	Stream Stream    `json:"stream"`
+	Time   time.Time `json:"time"`
+	Offset float64   `json:"offset"`
+	Data   string    `json:"data"`
+}
+
+// WriteJSON writes this Recording to the provided writer as an indented JSON object.
+// Each event has its stream, time, offset (seconds since the start), and data.
+// The data is written as a string, so any bytes that aren't valid UTF-8 are replaced.
+func (r *Recording) WriteJSON(w io.Writer) error {
+	out := jsonRecording{Start: r.Start, End: r.End, Events: make([]jsonEvent, len(r.Events))}
+	for i, event := range r.Events {
+		out.Events[i] = jsonEvent{
+			Stream: event.Stream,
+			Time:   event.Time,
+			Offset: r.Offset(event).Seconds(),
+			Data:   string(event.Data),
+		}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
+
+// castHeader is the header line of an asciicast v2 file.
+type castHeader struct {
+	Version   int   `json:"version"`
+	Width     int   `json:"width"`
+	Height    int   `json:"height"`
+	Timestamp int64 `json:"timestamp"`
+}
+
+// WriteAsciicast writes this Recording to the provided writer in the asciicast v2 format.
+// The result can be played back using asciinema, e.g. asciinema play output.cast.
+// If the width or height is zero, DefaultCastWidth or DefaultCastHeight is used.
+//
+// The asciicast format doesn't distinguish stdout from stderr, so all events are written as output.
+// Line feeds are written as carriage return line feeds, the same as a terminal would do.
+func (r *Recording) WriteAsciicast(w io.Writer, width, height int) error {
+	if width == 0 {
+		width = DefaultCastWidth
+	}
+	if height == 0 {
+		height = DefaultCastHeight
+	}
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	err := enc.Encode(castHeader{Version: 2, Width: width, Height: height, Timestamp: r.Start.Unix()})
+	if err != nil {
+		return err
+	}
+	toCRLF := strings.NewReplacer("\r\n", "\r\n", "\n", "\r\n")
+	for _, event := range r.Events {
+		err = enc.Encode([]interface{}{r.Offset(event).Seconds(), "o", toCRLF.Replace(string(event.Data))})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+//go:build linux
+// +build linux
+
+package capturer
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+// redirectFD points the provided file descriptor at the provided file.
+// It returns a new file for where the file descriptor used to point, and a function that points it back there.
+// The returned file should be closed after the restore function has been called.
+func redirectFD(fd int, name string, to *os.File) (*os.File, func() error, error) {
+	saved, err := syscall.Dup(fd)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not duplicate %s file descriptor %d: %w", name, fd, err)
+	}
+	if err = syscall.Dup3(int(to.Fd()), fd, 0); err != nil {
+		_ = syscall.Close(saved)
+		return nil, nil, fmt.Errorf("could not redirect %s file descriptor %d: %w", name, fd, err)
+	}
+	restore := func() error {
+		if err := syscall.Dup3(saved, fd, 0); err != nil {
+			return fmt.Errorf("could not restore %s file descriptor %d: %w", name, fd, err)
+		}
+		return nil
+	}
+	return os.NewFile(uintptr(saved), name), restore, nil
+}
@@ -0,0 +1,13 @@
+//go:build !linux
+// +build !linux
+
+package capturer
+
+import (
+	"os"
+)
+
+// redirectFD is only supported on linux. Everywhere else, it returns ErrFDCaptureUnsupported.
+func redirectFD(_ int, _ string, _ *os.File) (*os.File, func() error, error) {
+	return nil, nil, ErrFDCaptureUnsupported
+}
@@ -0,0 +1,92 @@
+package capturer
+
+import (
+	"io"
+)
+
+var _ io.Writer = &limitedBuffer{}
+
+// limitedBuffer is a buffer that holds at most max bytes.
+// If max is zero or less, there is no limit.
+type limitedBuffer struct {
+	// max is the maximum number of bytes to hold.
+	max int
+	// overflow is what to do once max is reached.
+	overflow Overflow
+	// buf holds the bytes. Once full, a ring buffer uses it circularly.
+	buf []byte
+	// start is the index in buf of the oldest byte. It is only non-zero for a full ring buffer.
+	start int
+	// dropped is the number of bytes that have been dropped due to the limit.
+	dropped int64
+}
+
+// newLimitedBuffer creates a new limitedBuffer with the provided limit.
+func newLimitedBuffer(max int, overflow Overflow) *limitedBuffer {
+	return &limitedBuffer{max: max, overflow: overflow}
+}
+
+// Write implements the io.Writer interface on this limitedBuffer.
+// It always reports that all the bytes were written, even if some were dropped.
+func (b *limitedBuffer) Write(bz []byte) (int, error) {
+	n := len(bz)
+	switch {
+	case b.max <= 0:
+		b.buf = append(b.buf, bz...)
+	case b.overflow == OverflowRing:
+		b.writeRing(bz)
+	default:
+		if room := b.max - len(b.buf); room < len(bz) {
+			b.dropped += int64(len(bz) - room)
+			bz = bz[:room]
+		}
+		b.buf = append(b.buf, bz...)
+	}
+	return n, nil
+}
+
+// writeRing writes the provided bytes to this limitedBuffer, dropping the oldest bytes as needed.
+func (b *limitedBuffer) writeRing(bz []byte) {
+	// If there's more than will fit, everything already here is dropped, and only the end of the new stuff is kept.
+	if len(bz) >= b.max {
+		b.dropped += int64(len(b.buf) + len(bz) - b.max)
+		b.buf = append(b.buf[:0], bz[len(bz)-b.max:]...)
+		b.start = 0
+		return
+	}
+	// Fill up any remaining room first.
+	if room := b.max - len(b.buf); room > 0 {
+		if room > len(bz) {
+			room = len(bz)
+		}
+		b.buf = append(b.buf, bz[:room]...)
+		bz = bz[room:]
+	}
+	// Then overwrite the oldest bytes.
+	for len(bz) > 0 {
+		k := copy(b.buf[b.start:], bz)
+		b.dropped += int64(k)
+		b.start = (b.start + k) % b.max
+		bz = bz[k:]
+	}
+}
+
+// WriteString writes the provided string to this limitedBuffer.
+func (b *limitedBuffer) WriteString(str string) {
+	_, _ = b.Write([]byte(str))
+}
+
+// Bytes gets the bytes in this limitedBuffer, oldest first.
+func (b *limitedBuffer) Bytes() []byte {
+	if b.start == 0 {
+		return b.buf
+	}
+	rv := make([]byte, 0, len(b.buf))
+	rv = append(rv, b.buf[b.start:]...)
+	return append(rv, b.buf[:b.start]...)
+}
+
+// Dropped gets the number of bytes that have been dropped from this limitedBuffer because of its limit.
+func (b *limitedBuffer) Dropped() int64 {
+	return b.dropped
+}
@@ -0,0 +1,47 @@
+package capturer
+
+import (
+	"testing"
+)
+
+func TestLimitedBuffer(t *testing.T) {
+	tests := []struct {
+		name       string
+		max        int
+		overflow   Overflow
+		writes     []string
+		exp        string
+		expDropped int64
+	}{
+		{name: "no limit", max: 0, writes: []string{"abc", "def"}, exp: "abcdef"},
+		{name: "negative limit", max: -1, overflow: OverflowRing, writes: []string{"abc", "def"}, exp: "abcdef"},
+		{name: "truncate under", max: 10, writes: []string{"abc", "def"}, exp: "abcdef"},
+		{name: "truncate exact", max: 6, writes: []string{"abc", "def"}, exp: "abcdef"},
+		{name: "truncate over", max: 4, writes: []string{"abc", "def", "ghi"}, exp: "abcd", expDropped: 5},
+		{name: "truncate big write", max: 4, writes: []string{"abcdefgh"}, exp: "abcd", expDropped: 4},
+		{name: "ring under", max: 10, overflow: OverflowRing, writes: []string{"abc", "def"}, exp: "abcdef"},
+		{name: "ring exact", max: 6, overflow: OverflowRing, writes: []string{"abc", "def"}, exp: "abcdef"},
+		{name: "ring over", max: 4, overflow: OverflowRing, writes: []string{"abc", "def"}, exp: "cdef", expDropped: 2},
+		{name: "ring wraps", max: 4, overflow: OverflowRing, writes: []string{"abc", "de", "fgh", "i"}, exp: "fghi", expDropped: 5},
+		{name: "ring big write", max: 4, overflow: OverflowRing, writes: []string{"ab", "cdefgh"}, exp: "efgh", expDropped: 4},
+		{name: "ring after wrap", max: 5, overflow: OverflowRing, writes: []string{"abcd", "ef", "g", "hij"}, exp: "fghij", expDropped: 5},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			b := newLimitedBuffer(tc.max, tc.overflow)
+			for _, w := range tc.writes {
+				n, err := b.Write([]byte(w))
+				if err != nil || n != len(w) {
+					t.Fatalf("Write(%q) = %d, %v, expected %d, nil", w, n, err, len(w))
+				}
+			}
+			if act := string(b.Bytes()); act != tc.exp {
+				t.Errorf("Bytes() = %q, expected %q", act, tc.exp)
+			}
+			if act := b.Dropped(); act != tc.expDropped {
+				t.Errorf("Dropped() = %d, expected %d", act, tc.expDropped)
+			}
+		})
+	}
+}
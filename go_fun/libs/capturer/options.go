@@ -0,0 +1,70 @@
+package capturer
+
+import (
+	"io"
+)
+
+// Overflow defines what happens to output once a size limit has been reached.
+type Overflow int
+
+const (
+	// OverflowTruncate keeps the first output up to the limit and drops everything after it.
+	OverflowTruncate Overflow = iota
+	// OverflowRing keeps the most recent output up to the limit, dropping the oldest output to make room.
+	OverflowRing
+)
+
+// String returns a human readable name of this Overflow.
+func (o Overflow) String() string {
+	switch o {
+	case OverflowTruncate:
+		return "truncate"
+	case OverflowRing:
+		return "ring"
+	}
+	return "unknown"
+}
+
+// Options are the optional settings for recording output.
+// The zero value has no callbacks and no size limit, which is what the package level Record* functions use.
+//
+// Callbacks are called while the runner is still running, as the output is captured.
+// They are never called concurrently; each is called in the same order as the events are recorded.
+// Callbacks must not write to the stdout or stderr being captured, and should return quickly,
+// since further output is not captured until they do.
+type Options struct {
+	// OnChunk, if set, is called with each chunk of output as it is captured.
+	// The data must not be modified, and must be copied if it is needed after the callback returns.
+	OnChunk func(stream Stream, data []byte)
+	// OnLine, if set, is called with each line of output as it is captured.
+	// The line does not include the line ending (\n or \r\n).
+	// A final line without a line ending is provided once the capture ends.
+	OnLine func(stream Stream, line string)
+
+	// MaxBytes is the maximum number of bytes kept in the Recording (of both streams together).
+	// If zero or less, there is no limit. The callbacks get all the output, even when some is dropped.
+	MaxBytes int
+	// Overflow is what happens once MaxBytes has been reached.
+	Overflow Overflow
+}
+
+// RecordOutput records all the things written to stdout and stderr during some code execution, using these Options.
+// See also: the package level RecordOutput function.
+func (o Options) RecordOutput(runner func()) (*Recording, error) {
+	return recordOutput(o, runner)
+}
+
+// RecordOutputFD records all the things written to the stdout and stderr file descriptors during some code execution,
+// using these Options.
+// See also: the package level RecordOutputFD function.
+func (o Options) RecordOutputFD(runner func()) (*Recording, error) {
+	return recordOutputFD(o, runner)
+}
+
+// RecordWriters records everything written to the writers provided to the runner, using these Options.
+// See also: the package level RecordWriters function.
+func (o Options) RecordWriters(runner func(stdout, stderr io.Writer)) *Recording {
+	rec := newRecorder(o)
+	runner(rec.Writer(StreamStdout), rec.Writer(StreamStderr))
+	return rec.finish()
+}
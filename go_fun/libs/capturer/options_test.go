@@ -0,0 +1,135 @@
+package capturer
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestOptionsCallbacks(t *testing.T) {
+	t.Parallel()
+
+	var chunks []string
+	var lines []string
+	opts := Options{
+		OnChunk: func(stream Stream, data []byte) {
+			chunks = append(chunks, fmt.Sprintf("%s:%s", stream, data))
+		},
+		OnLine: func(stream Stream, line string) {
+			lines = append(lines, fmt.Sprintf("%s:%s", stream, line))
+		},
+	}
+	opts.RecordWriters(func(stdout, stderr io.Writer) {
+		fmt.Fprint(stdout, "one\ntw")
+		fmt.Fprint(stderr, "err one\r\n")
+		fmt.Fprint(stdout, "o\n\nthree")
+		fmt.Fprint(stderr, "err two")
+	})
+
+	expChunks := []string{"stdout:one\ntw", "stderr:err one\r\n", "stdout:o\n\nthree", "stderr:err two"}
+	if !reflect.DeepEqual(chunks, expChunks) {
+		t.Errorf("chunks = %q, expected %q", chunks, expChunks)
+	}
+	expLines := []string{"stdout:one", "stderr:err one", "stdout:two", "stdout:", "stdout:three", "stderr:err two"}
+	if !reflect.DeepEqual(lines, expLines) {
+		t.Errorf("lines = %q, expected %q", lines, expLines)
+	}
+}
+
+func TestOptionsOnLineWhileRunning(t *testing.T) {
+	gotLine := make(chan string, 1)
+	opts := Options{
+		OnLine: func(stream Stream, line string) {
+			gotLine <- line
+		},
+	}
+	rec, err := opts.RecordOutput(func() {
+		fmt.Fprintln(os.Stdout, "progress: 50%")
+		select {
+		case line := <-gotLine:
+			if line != "progress: 50%" {
+				t.Errorf("OnLine line = %q, expected %q", line, "progress: 50%")
+			}
+		case <-time.After(5 * time.Second):
+			t.Errorf("OnLine was not called while the runner was running")
+		}
+	})
+	if err != nil {
+		t.Fatalf("RecordOutput error: %v", err)
+	}
+	if exp := "progress: 50%\n"; rec.Stdout() != exp {
+		t.Errorf("Stdout() = %q, expected %q", rec.Stdout(), exp)
+	}
+}
+
+func TestOptionsMaxBytes(t *testing.T) {
+	t.Parallel()
+
+	writes := func(stdout, stderr io.Writer) {
+		fmt.Fprint(stdout, "abc")
+		fmt.Fprint(stderr, "defg")
+		fmt.Fprint(stdout, "hi")
+		fmt.Fprint(stderr, "jklmnop")
+	}
+	tests := []struct {
+		name        string
+		opts        Options
+		expCombined string
+		expStderr   string
+		expDropped  int64
+		expEvents   int
+	}{
+		{
+			name:        "no limit",
+			opts:        Options{},
+			expCombined: "abcdefghijklmnop",
+			expStderr:   "defgjklmnop",
+			expEvents:   4,
+		},
+		{
+			name:        "truncate",
+			opts:        Options{MaxBytes: 8},
+			expCombined: "abcdefgh",
+			expStderr:   "defg",
+			expDropped:  8,
+			expEvents:   3,
+		},
+		{
+			name:        "ring",
+			opts:        Options{MaxBytes: 8, Overflow: OverflowRing},
+			expCombined: "ijklmnop",
+			expStderr:   "jklmnop",
+			expDropped:  8,
+			expEvents:   2,
+		},
+		{
+			name:        "ring smaller than write",
+			opts:        Options{MaxBytes: 3, Overflow: OverflowRing},
+			expCombined: "nop",
+			expStderr:   "nop",
+			expDropped:  13,
+			expEvents:   1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := tc.opts.RecordWriters(writes)
+			if act := rec.Combined(); act != tc.expCombined {
+				t.Errorf("Combined() = %q, expected %q", act, tc.expCombined)
+			}
+			if act := rec.Stderr(); act != tc.expStderr {
+				t.Errorf("Stderr() = %q, expected %q", act, tc.expStderr)
+			}
+			if rec.Dropped != tc.expDropped {
+				t.Errorf("Dropped = %d, expected %d", rec.Dropped, tc.expDropped)
+			}
+			if len(rec.Events) != tc.expEvents {
+				t.Errorf("len(Events) = %d, expected %d", len(rec.Events), tc.expEvents)
+			}
+		})
+	}
+}
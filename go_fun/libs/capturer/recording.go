@@ -0,0 +1,227 @@
+package capturer
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+// A Stream identifies where some output was written.
+type Stream string
+
+// String returns this Stream as a string.
+func (s Stream) String() string {
+	return string(s)
+}
+
+const (
+	// StreamStdout identifies output written to stdout.
+	StreamStdout Stream = "stdout"
+	// StreamStderr identifies output written to stderr.
+	StreamStderr Stream = "stderr"
+)
+
+// Event is a single chunk of captured output.
+type Event struct {
+	// Stream is where the output was written.
+	Stream Stream
+	// Time is when the output was captured.
+	Time time.Time
+	// Data is the output.
+	Data []byte
+}
+
+// Recording is the ordered list of output events captured during some code execution.
+type Recording struct {
+	// Start is when the capture started.
+	Start time.Time
+	// End is when the capture ended.
+	End time.Time
+	// Events are all the captured events, in the order they were captured.
+	Events []Event
+	// Dropped is the number of bytes that were captured but aren't in the Events because of the Options.MaxBytes limit.
+	Dropped int64
+}
+
+// Stdout replays the events of this Recording to get everything written to stdout.
+func (r *Recording) Stdout() string {
+	return r.replay(StreamStdout)
+}
+
+// Stderr replays the events of this Recording to get everything written to stderr.
+func (r *Recording) Stderr() string {
+	return r.replay(StreamStderr)
+}
+
+// Combined replays the events of this Recording to get everything written to either stdout or stderr.
+func (r *Recording) Combined() string {
+	return r.replay("")
+}
+
+// Output replays the events of this Recording into a CapturedOutput.
+func (r *Recording) Output() CapturedOutput {
+	return CapturedOutput{
+		Stdout:   r.Stdout(),
+		Stderr:   r.Stderr(),
+		Combined: r.Combined(),
+	}
+}
+
+// Offset gets the amount of time between the start of this Recording and the provided event.
+func (r *Recording) Offset(event Event) time.Duration {
+	return event.Time.Sub(r.Start)
+}
+
+// replay concatenates the data of all events from the provided stream, or all events if the stream is empty.
+func (r *Recording) replay(stream Stream) string {
+	var sb strings.Builder
+	for _, event := range r.Events {
+		if len(stream) == 0 || event.Stream == stream {
+			sb.Write(event.Data)
+		}
+	}
+	return sb.String()
+}
+
+// recorder builds a Recording from writes to its stream writers.
+type recorder struct {
+	mu   sync.Mutex
+	opts Options
+	rec  Recording
+	done bool
+	// size is the total number of bytes in the recorded events.
+	size int
+	// partial has the start of a line (that doesn't have its line ending yet) for each stream.
+	partial map[Stream][]byte
+}
+
+// newRecorder creates a new recorder with the provided Options, starting the clock on its Recording.
+func newRecorder(opts Options) *recorder {
+	return &recorder{
+		opts:    opts,
+		rec:     Recording{Start: time.Now()},
+		partial: make(map[Stream][]byte),
+	}
+}
+
+// Writer gets an io.Writer that records each write as an event from the provided stream.
+func (r *recorder) Writer(stream Stream) io.Writer {
+	return &recordWriter{r: r, stream: stream}
+}
+
+// record adds an event with the provided data to the Recording, and calls any callbacks.
+// The time is taken while locked so that the events are always in time order.
+func (r *recorder) record(stream Stream, bz []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.done || len(bz) == 0 {
+		return
+	}
+	event := Event{Stream: stream, Time: time.Now(), Data: make([]byte, len(bz))}
+	copy(event.Data, bz)
+
+	if r.opts.OnChunk != nil {
+		r.opts.OnChunk(stream, event.Data)
+	}
+	if r.opts.OnLine != nil {
+		r.sendLines(stream, event.Data)
+	}
+	r.add(event)
+}
+
+// sendLines calls the OnLine callback with each complete line, holding onto any partial line for later.
+func (r *recorder) sendLines(stream Stream, data []byte) {
+	for {
+		i := bytes.IndexByte(data, '\n')
+		if i < 0 {
+			break
+		}
+		line := append(r.partial[stream], data[:i]...)
+		r.partial[stream] = nil
+		r.opts.OnLine(stream, strings.TrimSuffix(string(line), "\r"))
+		data = data[i+1:]
+	}
+	if len(data) > 0 {
+		r.partial[stream] = append(r.partial[stream], data...)
+	}
+}
+
+// add adds the provided event to the Recording, applying the MaxBytes limit.
+func (r *recorder) add(event Event) {
+	max := r.opts.MaxBytes
+	if max <= 0 {
+		r.rec.Events = append(r.rec.Events, event)
+		r.size += len(event.Data)
+		return
+	}
+
+	if r.opts.Overflow != OverflowRing {
+		room := max - r.size
+		if room < len(event.Data) {
+			r.rec.Dropped += int64(len(event.Data) - room)
+			event.Data = event.Data[:room]
+		}
+		if len(event.Data) > 0 {
+			r.rec.Events = append(r.rec.Events, event)
+			r.size += len(event.Data)
+		}
+		return
+	}
+
+	if len(event.Data) > max {
+		r.rec.Dropped += int64(len(event.Data) - max)
+		event.Data = event.Data[len(event.Data)-max:]
+	}
+	r.rec.Events = append(r.rec.Events, event)
+	r.size += len(event.Data)
+	// Drop the oldest stuff until we're back under the limit.
+	for r.size > max {
+		first := &r.rec.Events[0]
+		excess := r.size - max
+		if len(first.Data) > excess {
+			first.Data = first.Data[excess:]
+			r.size -= excess
+			r.rec.Dropped += int64(excess)
+			break
+		}
+		r.size -= len(first.Data)
+		r.rec.Dropped += int64(len(first.Data))
+		r.rec.Events = r.rec.Events[1:]
+	}
+}
+
+// finish stops the clock, sends any partial lines, and returns the Recording. Anything written after this is ignored.
+func (r *recorder) finish() *Recording {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.done {
+		r.done = true
+		r.rec.End = time.Now()
+		if r.opts.OnLine != nil {
+			for _, stream := range []Stream{StreamStdout, StreamStderr} {
+				if line := r.partial[stream]; len(line) > 0 {
+					r.opts.OnLine(stream, strings.TrimSuffix(string(line), "\r"))
+				}
+			}
+			r.partial = make(map[Stream][]byte)
+		}
+	}
+	rv := r.rec
+	return &rv
+}
+
+var _ io.Writer = &recordWriter{}
+
+// recordWriter is an io.Writer that records each write as an event of one stream.
+type recordWriter struct {
+	r      *recorder
+	stream Stream
+}
+
+// Write implements the io.Writer interface on this recordWriter.
+func (w *recordWriter) Write(bz []byte) (int, error) {
+	w.r.record(w.stream, bz)
+	return len(bz), nil
+}
@@ -0,0 +1,121 @@
+package capturer
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+)
+
+// testRecording creates a Recording with known times.
+func testRecording() *Recording {
+	start := time.Date(2023, 5, 4, 3, 2, 1, 0, time.UTC)
+	return &Recording{
+		Start: start,
+		End:   start.Add(2 * time.Second),
+		Events: []Event{
+			{Stream: StreamStdout, Time: start.Add(100 * time.Millisecond), Data: []byte("out 1\n")},
+			{Stream: StreamStderr, Time: start.Add(250 * time.Millisecond), Data: []byte("err 1\n")},
+			{Stream: StreamStdout, Time: start.Add(1500 * time.Millisecond), Data: []byte("out \"2\"\n")},
+		},
+	}
+}
+
+func TestRecordingReplay(t *testing.T) {
+	rec := testRecording()
+	exp := CapturedOutput{
+		Stdout:   "out 1\nout \"2\"\n",
+		Stderr:   "err 1\n",
+		Combined: "out 1\nerr 1\nout \"2\"\n",
+	}
+	if act := rec.Output(); act != exp {
+		t.Errorf("Output() = %#v, expected %#v", act, exp)
+	}
+	if act := rec.Offset(rec.Events[1]); act != 250*time.Millisecond {
+		t.Errorf("Offset(Events[1]) = %s, expected 250ms", act)
+	}
+}
+
+func TestRecordingWriteJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := testRecording().WriteJSON(&buf); err != nil {
+		t.Fatalf("WriteJSON error: %v", err)
+	}
+	exp := `{
+  "start": "2023-05-04T03:02:01Z",
+  "end": "2023-05-04T03:02:03Z",
+  "events": [
+    {
+      "stream": "stdout",
+      "time": "2023-05-04T03:02:01.1Z",
+      "offset": 0.1,
+      "data": "out 1\n"
+    },
+    {
+      "stream": "stderr",
+      "time": "2023-05-04T03:02:01.25Z",
+      "offset": 0.25,
+      "data": "err 1\n"
+    },
+    {
+      "stream": "stdout",
+      "time": "2023-05-04T03:02:02.5Z",
+      "offset": 1.5,
+      "data": "out \"2\"\n"
+    }
+  ]
+}
+`
+	if act := buf.String(); act != exp {
+		t.Errorf("WriteJSON wrote:\n%s\nexpected:\n%s", act, exp)
+	}
+}
+
+func TestRecordingWriteAsciicast(t *testing.T) {
+	var buf bytes.Buffer
+	if err := testRecording().WriteAsciicast(&buf, 0, 40); err != nil {
+		t.Fatalf("WriteAsciicast error: %v", err)
+	}
+	exp := `{"version":2,"width":80,"height":40,"timestamp":1683169321}
+[0.1,"o","out 1\r\n"]
+[0.25,"o","err 1\r\n"]
+[1.5,"o","out \"2\"\r\n"]
+`
+	if act := buf.String(); act != exp {
+		t.Errorf("WriteAsciicast wrote:\n%s\nexpected:\n%s", act, exp)
+	}
+}
+
+func TestRecordWriters(t *testing.T) {
+	t.Parallel()
+
+	var late io.Writer
+	rec := RecordWriters(func(stdout, stderr io.Writer) {
+		fmt.Fprint(stdout, "out 1\n")
+		fmt.Fprint(stderr, "err 1\n")
+		fmt.Fprint(stdout, "")
+		fmt.Fprint(stdout, "out 2\n")
+		late = stdout
+	})
+	fmt.Fprint(late, "too late\n")
+
+	expStreams := []Stream{StreamStdout, StreamStderr, StreamStdout}
+	if len(rec.Events) != len(expStreams) {
+		t.Fatalf("len(Events) = %d, expected %d", len(rec.Events), len(expStreams))
+	}
+	for i, event := range rec.Events {
+		if event.Stream != expStreams[i] {
+			t.Errorf("Events[%d].Stream = %q, expected %q", i, event.Stream, expStreams[i])
+		}
+		if event.Time.Before(rec.Start) || event.Time.After(rec.End) {
+			t.Errorf("Events[%d].Time = %s, expected between %s and %s", i, event.Time, rec.Start, rec.End)
+		}
+		if i > 0 && event.Time.Before(rec.Events[i-1].Time) {
+			t.Errorf("Events[%d].Time = %s, expected after %s", i, event.Time, rec.Events[i-1].Time)
+		}
+	}
+	if exp := "out 1\nerr 1\nout 2\n"; rec.Combined() != exp {
+		t.Errorf("Combined() = %q, expected %q", rec.Combined(), exp)
+	}
+}
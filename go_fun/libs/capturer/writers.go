@@ -0,0 +1,26 @@
+package capturer
+
+import (
+	"io"
+)
+
+// CaptureWriters runs the runner, providing it writers to use in place of stdout and stderr, and captures
+// everything written to them. It is for code that accepts an io.Writer instead of using os.Stdout or os.Stderr.
+//
+// Nothing global is changed, so this is safe to use in tests that call t.Parallel().
+// Only what is written to the provided writers is captured; anything written to os.Stdout or os.Stderr is not.
+// Unlike CaptureOutput, the output is not replicated anywhere else.
+//
+// The writers are safe for concurrent use, and each write is added to the combined output as a whole.
+// So the ordering of the combined output is exactly the order that the writes happened.
+// The writers should not be used once the runner has returned; anything written to them then is ignored.
+func CaptureWriters(runner func(stdout, stderr io.Writer)) CapturedOutput {
+	return RecordWriters(runner).Output()
+}
+
+// RecordWriters records everything written to the writers provided to the runner.
+// It works the same way as CaptureWriters, but provides the ordered, timestamped events instead.
+// Each write is its own event. Use Options.RecordWriters for callbacks or size limits.
+func RecordWriters(runner func(stdout, stderr io.Writer)) *Recording {
+	return Options{}.RecordWriters(runner)
+}
@@ -0,0 +1,229 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	to_words "github.com/SpicyLemon/to-words"
+)
+
+// PrintUsage outputs a multi-line string with info on how to run this program.
+func PrintUsage(stdout io.Writer) {
+	fmt.Fprintf(stdout, `to-words: Convert numbers into English words.
+
+Usage: to-words <number 1> [<number 2> ...] [--pipe|-] [--ith|-i] [--style <style>] [--lang <tag>]
+  or : to-words --rewrite|-r [<text> ...] [--style <style>]
+  or : <stuff> | to-words [--rewrite|-r]
+
+Each number is converted and printed on its own line.
+Numbers can be whole numbers, decimals, fractions (e.g. "3/4" or "1 1/2"), or
+scientific notation (e.g. "1.5e10" or "3x10^4"). Commas in numbers are ignored.
+
+The --pipe or - flag causes each line of stdin to be read as a number.
+  It is implied if there are no arguments provided.
+The --ith or -i flag outputs the sequence number, e.g. 21 => "twenty-first". Only whole numbers are allowed.
+The --style flag defines how the fractional part of a decimal is read. Default is digit.
+  digit:       1.25 => "one point two five"
+  place-value: 1.25 => "one and twenty-five hundredths"
+  fraction:    1.25 => "one and a quarter"
+The --lang flag defines the language to use for whole numbers, e.g. en-GB, es, fr, de. Default is en-US.
+
+The --rewrite or -r flag changes the mode so that the arguments are treated as free text.
+  All numbers, ordinals (e.g. "21st") and scientific notation in the text are replaced
+  with their spoken forms. If no text is provided, stdin is rewritten line by line.
+`)
+}
+
+// toWordsParams are the things defined by the command-line arguments.
+type toWordsParams struct {
+	Values  []string
+	Pipe    bool
+	Ith     bool
+	Rewrite bool
+	Style   to_words.SpokenStyle
+	Lang    to_words.Language
+}
+
+// styleNames are the names of each spoken style as used with the --style flag.
+var styleNames = map[string]to_words.SpokenStyle{
+	"digit":       to_words.DigitStyle,
+	"place-value": to_words.PlaceValueStyle,
+	"fraction":    to_words.FractionStyle,
+}
+
+// processFlags parses the provided args into the params.
+// Returns the params, whether processing should stop (e.g. usage was printed), and any error.
+func processFlags(argsIn []string, stdout io.Writer, stdin io.Reader) (*toWordsParams, bool, error) {
+	rv := &toWordsParams{}
+	for i := 0; i < len(argsIn); i++ {
+		arg := strings.TrimSpace(argsIn[i])
+		switch {
+		case equalFoldOneOf(arg, "--help", "-h", "help"):
+			PrintUsage(stdout)
+			return nil, true, nil
+		case equalFoldOneOf(arg, "--pipe", "-"):
+			rv.Pipe = true
+		case equalFoldOneOf(arg, "--ith", "-i"):
+			rv.Ith = true
+		case equalFoldOneOf(arg, "--rewrite", "-r"):
+			rv.Rewrite = true
+		case equalFoldOneOf(arg, "--style", "--lang"):
+			if i+1 >= len(argsIn) {
+				return nil, true, fmt.Errorf("no value provided after %s", arg)
+			}
+			i++
+			val := strings.TrimSpace(argsIn[i])
+			if strings.EqualFold(arg, "--style") {
+				style, ok := styleNames[strings.ToLower(val)]
+				if !ok {
+					return nil, true, fmt.Errorf("unknown style %q: must be one of digit, place-value, fraction", val)
+				}
+				rv.Style = style
+				continue
+			}
+			lang, err := to_words.GetLanguage(val)
+			if err != nil {
+				return nil, true, err
+			}
+			rv.Lang = lang
+		default:
+			rv.Values = append(rv.Values, arg)
+		}
+	}
+
+	if rv.Rewrite && (rv.Ith || rv.Lang != nil) {
+		return nil, true, errors.New("the --ith and --lang flags cannot be used with --rewrite")
+	}
+	if len(rv.Values) == 0 && !rv.Pipe {
+		if stdin == nil {
+			// If we don't have stdin, and no args were provided, print help.
+			PrintUsage(stdout)
+			return nil, true, nil
+		}
+		rv.Pipe = true
+	}
+	if rv.Pipe && stdin == nil {
+		return nil, true, errors.New("no stdin available")
+	}
+
+	return rv, false, nil
+}
+
+// convert converts a single value into words as dictated by the params.
+func (p *toWordsParams) convert(val string) (string, error) {
+	val = strings.ReplaceAll(val, ",", "")
+	switch {
+	case p.Ith && p.Lang != nil:
+		words, err := to_words.StringToWordsIn(p.Lang, val, to_words.Masculine)
+		if err != nil {
+			return "", err
+		}
+		return to_words.WordsToIthIn(p.Lang, words, to_words.Masculine), nil
+	case p.Ith:
+		words, err := to_words.StringToWords(val)
+		if err != nil {
+			return "", err
+		}
+		return to_words.WordsToIth(words), nil
+	case p.Lang != nil:
+		return to_words.StringToWordsIn(p.Lang, val, to_words.Masculine)
+	}
+	return to_words.StringToSpokenStyle(val, p.Style)
+}
+
+// mainE is the main program logic, returning any error encountered.
+func mainE(argsIn []string, stdout io.Writer, stdin io.Reader) error {
+	params, stopNow, err := processFlags(argsIn, stdout, stdin)
+	if stopNow || err != nil {
+		return err
+	}
+
+	if params.Rewrite {
+		if len(params.Values) > 0 {
+			text, err := to_words.RewriteNumbers(strings.Join(params.Values, " "), params.Style)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(stdout, text)
+		}
+		if !params.Pipe {
+			return nil
+		}
+		return eachLine(stdin, func(line string) error {
+			text, err := to_words.RewriteNumbers(line, params.Style)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(stdout, text)
+			return nil
+		})
+	}
+
+	for _, val := range params.Values {
+		words, err := params.convert(val)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(stdout, words)
+	}
+	if !params.Pipe {
+		return nil
+	}
+	return eachLine(stdin, func(line string) error {
+		line = strings.TrimSpace(line)
+		if len(line) == 0 {
+			return nil
+		}
+		words, err := params.convert(line)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(stdout, words)
+		return nil
+	})
+}
+
+// eachLine calls the provided function with each line read from stdin, stopping at the first error.
+func eachLine(stdin io.Reader, f func(line string) error) error {
+	scanner := bufio.NewScanner(stdin)
+	for scanner.Scan() {
+		if err := f(scanner.Text()); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("error reading from stdin: %w", err)
+	}
+	return nil
+}
+
+// equalFoldOneOf returns true if the arg is equal to any of the provided options (case insensitive).
+func equalFoldOneOf(arg string, options ...string) bool {
+	for _, opt := range options {
+		if strings.EqualFold(arg, opt) {
+			return true
+		}
+	}
+	return false
+}
+
+// isCharDev returns true if the provided file is NOT a character device (i.e. something is being piped in).
+func isCharDev(stdin *os.File) bool {
+	stat, err := stdin.Stat()
+	return err == nil && (stat.Mode()&os.ModeCharDevice) == 0
+}
+
+func main() {
+	var stdin io.Reader
+	if isCharDev(os.Stdin) {
+		stdin = os.Stdin
+	}
+	if err := mainE(os.Args[1:], os.Stdout, stdin); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
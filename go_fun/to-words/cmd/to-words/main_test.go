@@ -0,0 +1,96 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrintUsage(t *testing.T) {
+	var w bytes.Buffer
+	testFunc := func() {
+		PrintUsage(&w)
+	}
+	require.NotPanics(t, testFunc, "PrintUsage(w)")
+	assert.Contains(t, w.String(), "to-words", "usage message should contain \"to-words\"")
+}
+
+func TestMainE(t *testing.T) {
+	tests := []struct {
+		name      string
+		argsIn    []string
+		stdin     *string
+		expErr    string
+		expStdout string
+		expUsage  bool
+	}{
+		{name: "no args no stdin", argsIn: nil, expUsage: true},
+		{name: "help", argsIn: []string{"1", "--help"}, expUsage: true},
+		{name: "pipe without stdin", argsIn: []string{"-"}, expErr: "no stdin available"},
+		{name: "style without value", argsIn: []string{"--style"}, expErr: "no value provided after --style"},
+		{name: "unknown style", argsIn: []string{"--style", "x", "1"}, expErr: "unknown style \"x\": must be one of digit, place-value, fraction"},
+		{name: "unknown lang", argsIn: []string{"--lang", "xx", "1"}, expErr: "unknown language \"xx\""},
+		{name: "rewrite with ith", argsIn: []string{"-r", "-i", "1"}, expErr: "the --ith and --lang flags cannot be used with --rewrite"},
+		{name: "not a number", argsIn: []string{"x"}, expErr: "not a float \"x\""},
+		{name: "ith of a decimal", argsIn: []string{"-i", "1.5"}, expErr: "cannot split \"1.5\" into groups: not a number"},
+		{name: "one number", argsIn: []string{"21"}, expStdout: "twenty-one\n"},
+		{
+			name:      "several values",
+			argsIn:    []string{"1,234", "-1.25", "3/4", "1 1/2", "6e5"},
+			expStdout: "one thousand two hundred thirty-four\nnegative one point two five\nthree-quarters\none and a half\nsix times ten to the five\n",
+		},
+		{name: "style", argsIn: []string{"--style", "place-value", "1.25"}, expStdout: "one and twenty-five hundredths\n"},
+		{name: "ith", argsIn: []string{"--ith", "32"}, expStdout: "thirty-second\n"},
+		{name: "lang", argsIn: []string{"--lang", "fr", "80"}, expStdout: "quatre-vingts\n"},
+		{name: "lang ith", argsIn: []string{"--lang", "de", "-i", "3"}, expStdout: "dritte\n"},
+		{name: "implied pipe", stdin: ptr("1\n\n 2 \n"), expStdout: "one\ntwo\n"},
+		{name: "args and pipe", argsIn: []string{"1", "-"}, stdin: ptr("2\n"), expStdout: "one\ntwo\n"},
+		{name: "args without pipe", argsIn: []string{"1"}, stdin: ptr("2\n"), expStdout: "one\n"},
+		{name: "pipe error", stdin: ptr("1\nx\n3\n"), expStdout: "one\n", expErr: "not a float \"x\""},
+		{
+			name:      "rewrite args",
+			argsIn:    []string{"-r", "On", "the", "21st,", "3", "people", "paid", "2.5"},
+			expStdout: "On the twenty-first, three people paid two point five\n",
+		},
+		{
+			name:      "rewrite stdin",
+			argsIn:    []string{"--rewrite", "--style", "fraction"},
+			stdin:     ptr("It took 2.5 hours.\nThe 3rd try.\n"),
+			expStdout: "It took two and a half hours.\nThe third try.\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			var stdin io.Reader
+			if tc.stdin != nil {
+				stdin = strings.NewReader(*tc.stdin)
+			}
+			var err error
+			testFunc := func() {
+				err = mainE(tc.argsIn, &stdout, stdin)
+			}
+			require.NotPanics(t, testFunc, "mainE(%q)", tc.argsIn)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "mainE(%q) error", tc.argsIn)
+			} else {
+				assert.NoError(t, err, "mainE(%q) error", tc.argsIn)
+			}
+			if tc.expUsage {
+				assert.Contains(t, stdout.String(), "Usage: to-words", "mainE(%q) stdout", tc.argsIn)
+			} else {
+				assert.Equal(t, tc.expStdout, stdout.String(), "mainE(%q) stdout", tc.argsIn)
+			}
+		})
+	}
+}
+
+// ptr returns a pointer to the provided string.
+func ptr(s string) *string {
+	return &s
+}
@@ -0,0 +1,248 @@
+package to_words
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Currency defines the names of the units of a currency and how many digits its minor unit has.
+type Currency struct {
+	// Code is the ISO-4217 code of this currency, e.g. "USD".
+	Code string
+	// Major is the singular name of the major unit, e.g. "dollar".
+	Major string
+	// MajorPlural is the plural name of the major unit, e.g. "dollars".
+	MajorPlural string
+	// Minor is the singular name of the minor unit, e.g. "cent". Empty if the currency doesn't have a minor unit.
+	Minor string
+	// MinorPlural is the plural name of the minor unit, e.g. "cents". Empty if the currency doesn't have a minor unit.
+	MinorPlural string
+	// MinorDigits is the number of digits of the minor unit, e.g. 2 for USD, 0 for JPY, 3 for KWD.
+	MinorDigits int
+}
+
+// Currencies are the known currencies, keyed by ISO-4217 code.
+var Currencies = map[string]Currency{
+	"AUD": {Code: "AUD", Major: "dollar", MajorPlural: "dollars", Minor: "cent", MinorPlural: "cents", MinorDigits: 2},
+	"BHD": {Code: "BHD", Major: "dinar", MajorPlural: "dinars", Minor: "fils", MinorPlural: "fils", MinorDigits: 3},
+	"BRL": {Code: "BRL", Major: "real", MajorPlural: "reais", Minor: "centavo", MinorPlural: "centavos", MinorDigits: 2},
+	"CAD": {Code: "CAD", Major: "dollar", MajorPlural: "dollars", Minor: "cent", MinorPlural: "cents", MinorDigits: 2},
+	"CHF": {Code: "CHF", Major: "franc", MajorPlural: "francs", Minor: "centime", MinorPlural: "centimes", MinorDigits: 2},
+	"CNY": {Code: "CNY", Major: "yuan", MajorPlural: "yuan", Minor: "fen", MinorPlural: "fen", MinorDigits: 2},
+	"EUR": {Code: "EUR", Major: "euro", MajorPlural: "euros", Minor: "cent", MinorPlural: "cents", MinorDigits: 2},
+	"GBP": {Code: "GBP", Major: "pound", MajorPlural: "pounds", Minor: "penny", MinorPlural: "pence", MinorDigits: 2},
+	"INR": {Code: "INR", Major: "rupee", MajorPlural: "rupees", Minor: "paisa", MinorPlural: "paise", MinorDigits: 2},
+	"JOD": {Code: "JOD", Major: "dinar", MajorPlural: "dinars", Minor: "fils", MinorPlural: "fils", MinorDigits: 3},
+	"JPY": {Code: "JPY", Major: "yen", MajorPlural: "yen", MinorDigits: 0},
+	"KRW": {Code: "KRW", Major: "won", MajorPlural: "won", MinorDigits: 0},
+	"KWD": {Code: "KWD", Major: "dinar", MajorPlural: "dinars", Minor: "fils", MinorPlural: "fils", MinorDigits: 3},
+	"MXN": {Code: "MXN", Major: "peso", MajorPlural: "pesos", Minor: "centavo", MinorPlural: "centavos", MinorDigits: 2},
+	"NZD": {Code: "NZD", Major: "dollar", MajorPlural: "dollars", Minor: "cent", MinorPlural: "cents", MinorDigits: 2},
+	"SEK": {Code: "SEK", Major: "krona", MajorPlural: "kronor", Minor: "öre", MinorPlural: "öre", MinorDigits: 2},
+	"USD": {Code: "USD", Major: "dollar", MajorPlural: "dollars", Minor: "cent", MinorPlural: "cents", MinorDigits: 2},
+}
+
+// GetCurrency gets the Currency with the provided ISO-4217 code (case insensitive), e.g. "USD".
+// Returns an error if there isn't a known currency for the provided code.
+// See also: MustGetCurrency.
+func GetCurrency(code string) (Currency, error) {
+	rv, ok := Currencies[strings.ToUpper(code)]
+	if !ok {
+		return Currency{}, fmt.Errorf("unknown currency code %q", code)
+	}
+	return rv, nil
+}
+
+// MustGetCurrency gets the Currency with the provided ISO-4217 code (case insensitive), e.g. "USD".
+// Panics if there isn't a known currency for the provided code.
+// See also: GetCurrency.
+func MustGetCurrency(code string) Currency {
+	rv, err := GetCurrency(code)
+	if err != nil {
+		panic(err)
+	}
+	return rv
+}
+
+// amountRx matches a currency amount: an optional "-", a whole number, and an optional fractional portion.
+//
+// Match groups:
+//
+// 1. The negative indicator (if there is one).
+// 2. The whole number portion.
+// 3. The fractional portion (without the ".").
+var amountRx = regexp.MustCompile(`^(-?)([[:digit:]]+)(?:\.([[:digit:]]*))?$`)
+
+// currencyAmount is an amount of a currency split up into its parts.
+type currencyAmount struct {
+	// isNeg is whether the amount is negative.
+	isNeg bool
+	// major is the whole number of major units (without leading zeros).
+	major string
+	// minor is the number of minor units, padded with zeros to the currency's minor digits.
+	minor string
+}
+
+// parseAmount splits up the provided amount into its major and minor parts for the provided currency.
+// Returns an error if the amount isn't a number or has more fractional digits than the currency allows.
+func parseAmount(amount string, cur Currency) (*currencyAmount, error) {
+	matches := amountRx.FindStringSubmatch(amount)
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("invalid %s amount %q: not a number", cur.Code, amount)
+	}
+	fract := strings.TrimRight(matches[3], "0")
+	if len(fract) > cur.MinorDigits {
+		return nil, fmt.Errorf("invalid %s amount %q: cannot have more than %d fractional digits", cur.Code, amount, cur.MinorDigits)
+	}
+	rv := &currencyAmount{
+		isNeg: matches[1] == "-",
+		major: strings.TrimLeft(matches[2], "0"),
+		minor: fract + strings.Repeat("0", cur.MinorDigits-len(fract)),
+	}
+	if len(rv.major) == 0 {
+		rv.major = "0"
+	}
+	if rv.major == "0" && strings.Trim(rv.minor, "0") == "" {
+		rv.isNeg = false
+	}
+	return rv, nil
+}
+
+// CurrencyToWords converts the provided amount of the currency with the provided ISO-4217 code into English words.
+// The minor units are omitted if there aren't any.
+//
+// Examples:
+//   - "1234.56", "USD" => "one thousand two hundred thirty-four dollars and fifty-six cents"
+//   - "1", "EUR" => "one euro"
+//   - "0.01", "GBP" => "zero pounds and one penny"
+//   - "1500", "JPY" => "one thousand five hundred yen"
+//   - "2.5", "KWD" => "two dinars and five hundred fils"
+//   - "-3.10", "CAD" => "negative three dollars and ten cents"
+//
+// Returns an error if the currency isn't known, the amount is not a number,
+// or the amount has more fractional digits than the currency has minor digits.
+// See also: MustCurrencyToWords, CurrencyToChequeWords.
+func CurrencyToWords(amount, code string) (string, error) {
+	cur, err := GetCurrency(code)
+	if err != nil {
+		return "", err
+	}
+	amt, err := parseAmount(amount, cur)
+	if err != nil {
+		return "", err
+	}
+
+	major, err := StringToWords(amt.major)
+	if err != nil {
+		return "", err
+	}
+	rv := major + " " + pluralize(amt.major, cur.Major, cur.MajorPlural)
+	if strings.Trim(amt.minor, "0") != "" {
+		minorNum := strings.TrimLeft(amt.minor, "0")
+		minor, err := StringToWords(minorNum)
+		if err != nil {
+			return "", err
+		}
+		rv += " and " + minor + " " + pluralize(minorNum, cur.Minor, cur.MinorPlural)
+	}
+	if amt.isNeg {
+		rv = "negative " + rv
+	}
+	return rv, nil
+}
+
+// MustCurrencyToWords converts the provided amount of the currency with the provided ISO-4217 code into English words.
+// The minor units are omitted if there aren't any.
+//
+// Examples:
+//   - "1234.56", "USD" => "one thousand two hundred thirty-four dollars and fifty-six cents"
+//   - "1", "EUR" => "one euro"
+//   - "0.01", "GBP" => "zero pounds and one penny"
+//   - "1500", "JPY" => "one thousand five hundred yen"
+//   - "2.5", "KWD" => "two dinars and five hundred fils"
+//   - "-3.10", "CAD" => "negative three dollars and ten cents"
+//
+// Panics if the currency isn't known, the amount is not a number,
+// or the amount has more fractional digits than the currency has minor digits.
+// See also: CurrencyToWords.
+func MustCurrencyToWords(amount, code string) string {
+	rv, err := CurrencyToWords(amount, code)
+	if err != nil {
+		panic(err)
+	}
+	return rv
+}
+
+// CurrencyToChequeWords converts the provided amount of the currency with the provided ISO-4217 code into
+// English words the way they're written on a cheque: The major units are words, and the minor units are a fraction.
+// Currencies without a minor unit don't get a fraction.
+//
+// Examples:
+//   - "1234.56", "USD" => "one thousand two hundred thirty-four and 56/100 dollars"
+//   - "1", "EUR" => "one and 00/100 euro"
+//   - "0.01", "GBP" => "zero and 01/100 pounds"
+//   - "1500", "JPY" => "one thousand five hundred yen"
+//   - "2.5", "KWD" => "two and 500/1000 dinars"
+//
+// Returns an error if the currency isn't known, the amount is not a number,
+// or the amount has more fractional digits than the currency has minor digits.
+// See also: MustCurrencyToChequeWords, CurrencyToWords.
+func CurrencyToChequeWords(amount, code string) (string, error) {
+	cur, err := GetCurrency(code)
+	if err != nil {
+		return "", err
+	}
+	amt, err := parseAmount(amount, cur)
+	if err != nil {
+		return "", err
+	}
+
+	rv, err := StringToWords(amt.major)
+	if err != nil {
+		return "", err
+	}
+	if cur.MinorDigits > 0 {
+		rv += " and " + amt.minor + "/1" + strings.Repeat("0", cur.MinorDigits)
+	}
+	// It's only singular if it's exactly one, e.g. "one and 00/100 dollar", but "one and 50/100 dollars".
+	unit := cur.MajorPlural
+	if amt.major == "1" && strings.Trim(amt.minor, "0") == "" {
+		unit = cur.Major
+	}
+	rv += " " + unit
+	if amt.isNeg {
+		rv = "negative " + rv
+	}
+	return rv, nil
+}
+
+// MustCurrencyToChequeWords converts the provided amount of the currency with the provided ISO-4217 code into
+// English words the way they're written on a cheque: The major units are words, and the minor units are a fraction.
+// Currencies without a minor unit don't get a fraction.
+//
+// Examples:
+//   - "1234.56", "USD" => "one thousand two hundred thirty-four and 56/100 dollars"
+//   - "1", "EUR" => "one and 00/100 euro"
+//   - "0.01", "GBP" => "zero and 01/100 pounds"
+//   - "1500", "JPY" => "one thousand five hundred yen"
+//   - "2.5", "KWD" => "two and 500/1000 dinars"
+//
+// Panics if the currency isn't known, the amount is not a number,
+// or the amount has more fractional digits than the currency has minor digits.
+// See also: CurrencyToChequeWords.
+func MustCurrencyToChequeWords(amount, code string) string {
+	rv, err := CurrencyToChequeWords(amount, code)
+	if err != nil {
+		panic(err)
+	}
+	return rv
+}
+
+// pluralize returns singular if the provided number string is "1", otherwise it returns plural.
+func pluralize(num, singular, plural string) string {
+	if num == "1" {
+		return singular
+	}
+	return plural
+}
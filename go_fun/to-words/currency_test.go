@@ -0,0 +1,194 @@
+package to_words
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCurrencies(t *testing.T) {
+	for key, cur := range Currencies {
+		t.Run(key, func(t *testing.T) {
+			assert.Equal(t, key, cur.Code, "Code")
+			assert.NotEmpty(t, cur.Major, "Major")
+			assert.NotEmpty(t, cur.MajorPlural, "MajorPlural")
+			if cur.MinorDigits > 0 {
+				assert.NotEmpty(t, cur.Minor, "Minor")
+				assert.NotEmpty(t, cur.MinorPlural, "MinorPlural")
+			} else {
+				assert.Empty(t, cur.Minor, "Minor")
+				assert.Empty(t, cur.MinorPlural, "MinorPlural")
+			}
+		})
+	}
+}
+
+func TestGetCurrency(t *testing.T) {
+	tests := []struct {
+		code   string
+		exp    Currency
+		expErr string
+	}{
+		{code: "", expErr: "unknown currency code \"\""},
+		{code: "XYZ", expErr: "unknown currency code \"XYZ\""},
+		{code: "USD", exp: Currencies["USD"]},
+		{code: "usd", exp: Currencies["USD"]},
+		{code: "Jpy", exp: Currencies["JPY"]},
+	}
+
+	for _, tc := range tests {
+		t.Run("normal: "+tc.code, func(t *testing.T) {
+			var act Currency
+			var err error
+			testFunc := func() {
+				act, err = GetCurrency(tc.code)
+			}
+			require.NotPanics(t, testFunc, "GetCurrency(%q)", tc.code)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "GetCurrency(%q) error", tc.code)
+			} else {
+				assert.NoError(t, err, "GetCurrency(%q) error", tc.code)
+			}
+			assert.Equal(t, tc.exp, act, "GetCurrency(%q) result", tc.code)
+		})
+
+		t.Run("must: "+tc.code, func(t *testing.T) {
+			var act Currency
+			testFunc := func() {
+				act = MustGetCurrency(tc.code)
+			}
+			if len(tc.expErr) > 0 {
+				require.PanicsWithError(t, tc.expErr, testFunc, "MustGetCurrency(%q)", tc.code)
+			} else {
+				require.NotPanics(t, testFunc, "MustGetCurrency(%q)", tc.code)
+			}
+			assert.Equal(t, tc.exp, act, "MustGetCurrency(%q) result", tc.code)
+		})
+	}
+}
+
+func TestCurrencyToWords(t *testing.T) {
+	tests := []struct {
+		amount string
+		code   string
+		exp    string
+		expErr string
+	}{
+		{amount: "1", code: "XYZ", expErr: "unknown currency code \"XYZ\""},
+		{amount: "", code: "USD", expErr: "invalid USD amount \"\": not a number"},
+		{amount: "one", code: "USD", expErr: "invalid USD amount \"one\": not a number"},
+		{amount: ".5", code: "USD", expErr: "invalid USD amount \".5\": not a number"},
+		{amount: "1,234", code: "USD", expErr: "invalid USD amount \"1,234\": not a number"},
+		{amount: "1.234", code: "USD", expErr: "invalid USD amount \"1.234\": cannot have more than 2 fractional digits"},
+		{amount: "5.5", code: "JPY", expErr: "invalid JPY amount \"5.5\": cannot have more than 0 fractional digits"},
+		{amount: "1234.56", code: "USD", exp: "one thousand two hundred thirty-four dollars and fifty-six cents"},
+		{amount: "0", code: "USD", exp: "zero dollars"},
+		{amount: "-0.00", code: "USD", exp: "zero dollars"},
+		{amount: "1", code: "USD", exp: "one dollar"},
+		{amount: "1.", code: "USD", exp: "one dollar"},
+		{amount: "1.00", code: "USD", exp: "one dollar"},
+		{amount: "1.000", code: "USD", exp: "one dollar"},
+		{amount: "001.01", code: "USD", exp: "one dollar and one cent"},
+		{amount: "2.1", code: "USD", exp: "two dollars and ten cents"},
+		{amount: "0.99", code: "usd", exp: "zero dollars and ninety-nine cents"},
+		{amount: "-3.10", code: "CAD", exp: "negative three dollars and ten cents"},
+		{amount: "1", code: "EUR", exp: "one euro"},
+		{amount: "0.01", code: "GBP", exp: "zero pounds and one penny"},
+		{amount: "20.02", code: "GBP", exp: "twenty pounds and two pence"},
+		{amount: "1500", code: "JPY", exp: "one thousand five hundred yen"},
+		{amount: "1", code: "JPY", exp: "one yen"},
+		{amount: "2.5", code: "KWD", exp: "two dinars and five hundred fils"},
+		{amount: "1.001", code: "KWD", exp: "one dinar and one fils"},
+		{amount: "2", code: "BRL", exp: "two reais"},
+		{amount: "1", code: "SEK", exp: "one krona"},
+		{
+			amount: "12345678901234567890.12",
+			code:   "USD",
+			exp: "twelve quintillion three hundred forty-five quadrillion six hundred seventy-eight trillion " +
+				"nine hundred one billion two hundred thirty-four million five hundred sixty-seven thousand " +
+				"eight hundred ninety dollars and twelve cents",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run("normal: "+tc.code+" "+tc.amount, func(t *testing.T) {
+			var act string
+			var err error
+			testFunc := func() {
+				act, err = CurrencyToWords(tc.amount, tc.code)
+			}
+			require.NotPanics(t, testFunc, "CurrencyToWords(%q, %q)", tc.amount, tc.code)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "CurrencyToWords(%q, %q) error", tc.amount, tc.code)
+			} else {
+				assert.NoError(t, err, "CurrencyToWords(%q, %q) error", tc.amount, tc.code)
+			}
+			assert.Equal(t, tc.exp, act, "CurrencyToWords(%q, %q) result", tc.amount, tc.code)
+		})
+
+		t.Run("must: "+tc.code+" "+tc.amount, func(t *testing.T) {
+			var act string
+			testFunc := func() {
+				act = MustCurrencyToWords(tc.amount, tc.code)
+			}
+			if len(tc.expErr) > 0 {
+				require.PanicsWithError(t, tc.expErr, testFunc, "MustCurrencyToWords(%q, %q)", tc.amount, tc.code)
+			} else {
+				require.NotPanics(t, testFunc, "MustCurrencyToWords(%q, %q)", tc.amount, tc.code)
+			}
+			assert.Equal(t, tc.exp, act, "MustCurrencyToWords(%q, %q) result", tc.amount, tc.code)
+		})
+	}
+}
+
+func TestCurrencyToChequeWords(t *testing.T) {
+	tests := []struct {
+		amount string
+		code   string
+		exp    string
+		expErr string
+	}{
+		{amount: "1", code: "XYZ", expErr: "unknown currency code \"XYZ\""},
+		{amount: "x", code: "EUR", expErr: "invalid EUR amount \"x\": not a number"},
+		{amount: "1.234", code: "USD", expErr: "invalid USD amount \"1.234\": cannot have more than 2 fractional digits"},
+		{amount: "1234.56", code: "USD", exp: "one thousand two hundred thirty-four and 56/100 dollars"},
+		{amount: "1234", code: "USD", exp: "one thousand two hundred thirty-four and 00/100 dollars"},
+		{amount: "1", code: "EUR", exp: "one and 00/100 euro"},
+		{amount: "1.5", code: "EUR", exp: "one and 50/100 euros"},
+		{amount: "0.01", code: "GBP", exp: "zero and 01/100 pounds"},
+		{amount: "1500", code: "JPY", exp: "one thousand five hundred yen"},
+		{amount: "2.5", code: "KWD", exp: "two and 500/1000 dinars"},
+		{amount: "-7.25", code: "MXN", exp: "negative seven and 25/100 pesos"},
+	}
+
+	for _, tc := range tests {
+		t.Run("normal: "+tc.code+" "+tc.amount, func(t *testing.T) {
+			var act string
+			var err error
+			testFunc := func() {
+				act, err = CurrencyToChequeWords(tc.amount, tc.code)
+			}
+			require.NotPanics(t, testFunc, "CurrencyToChequeWords(%q, %q)", tc.amount, tc.code)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "CurrencyToChequeWords(%q, %q) error", tc.amount, tc.code)
+			} else {
+				assert.NoError(t, err, "CurrencyToChequeWords(%q, %q) error", tc.amount, tc.code)
+			}
+			assert.Equal(t, tc.exp, act, "CurrencyToChequeWords(%q, %q) result", tc.amount, tc.code)
+		})
+
+		t.Run("must: "+tc.code+" "+tc.amount, func(t *testing.T) {
+			var act string
+			testFunc := func() {
+				act = MustCurrencyToChequeWords(tc.amount, tc.code)
+			}
+			if len(tc.expErr) > 0 {
+				require.PanicsWithError(t, tc.expErr, testFunc, "MustCurrencyToChequeWords(%q, %q)", tc.amount, tc.code)
+			} else {
+				require.NotPanics(t, testFunc, "MustCurrencyToChequeWords(%q, %q)", tc.amount, tc.code)
+			}
+			assert.Equal(t, tc.exp, act, "MustCurrencyToChequeWords(%q, %q) result", tc.amount, tc.code)
+		})
+	}
+}
@@ -0,0 +1,235 @@
+package to_words
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+// fractionRx matches a fraction or mixed number.
+// Matches: "<num>/<den>"  "<whole> <num>/<den>"
+//
+// Match groups:
+//
+// 1. The negative indicator (if there is one).
+// 2. The whole number (if it's a mixed number).
+// 3. The numerator.
+// 4. The denominator.
+var fractionRx = regexp.MustCompile(`^(-?)(?:([[:digit:]]+) +)?([[:digit:]]+) */ *([[:digit:]]+)$`)
+
+// FractionToWords converts the provided fraction or mixed number into English words.
+// The fraction is not reduced, e.g. "2/4" => "two-quarters".
+//
+// Examples:
+//   - "1/2" => "one-half"
+//   - "3/4" => "three-quarters"
+//   - "2/3" => "two-thirds"
+//   - "21/100" => "twenty-one hundredths"
+//   - "1 1/2" => "one and a half"
+//   - "2 3/4" => "two and three-quarters"
+//   - "-5/8" => "negative five-eighths"
+//
+// Returns an error if the provided string is not a fraction or the denominator is zero.
+// See also: MustFractionToWords, FloatToSpokenStyle.
+func FractionToWords(str string) (string, error) {
+	matches := fractionRx.FindStringSubmatch(str)
+	if len(matches) == 0 {
+		return "", fmt.Errorf("not a fraction %q", str)
+	}
+	isNeg, whole, num, den := matches[1] == "-", matches[2], trimZeros(matches[3]), trimZeros(matches[4])
+	if den == "0" {
+		return "", fmt.Errorf("invalid fraction %q: denominator cannot be zero", str)
+	}
+
+	var rv string
+	var err error
+	if len(whole) == 0 {
+		rv, err = fractionWords(num, den, false)
+	} else {
+		rv, err = mixedWords(trimZeros(whole), num, den)
+	}
+	if err != nil {
+		return "", fmt.Errorf("invalid fraction %q: %w", str, err)
+	}
+	if isNeg {
+		return "negative " + rv, nil
+	}
+	return rv, nil
+}
+
+// MustFractionToWords converts the provided fraction or mixed number into English words.
+// The fraction is not reduced, e.g. "2/4" => "two-quarters".
+//
+// Examples:
+//   - "1/2" => "one-half"
+//   - "3/4" => "three-quarters"
+//   - "2/3" => "two-thirds"
+//   - "21/100" => "twenty-one hundredths"
+//   - "1 1/2" => "one and a half"
+//   - "2 3/4" => "two and three-quarters"
+//   - "-5/8" => "negative five-eighths"
+//
+// Panics if the provided string is not a fraction or the denominator is zero.
+// See also: FractionToWords.
+func MustFractionToWords(str string) string {
+	rv, err := FractionToWords(str)
+	if err != nil {
+		panic(err)
+	}
+	return rv
+}
+
+// trimZeros removes the leading zeros from the provided number string, leaving "0" if it's all zeros.
+func trimZeros(num string) string {
+	rv := strings.TrimLeft(num, "0")
+	if len(rv) == 0 {
+		return "0"
+	}
+	return rv
+}
+
+// mixedWords converts a mixed number into English words, e.g. "1", "1", "2" => "one and a half".
+// All of the provided numbers must be positive number strings without leading zeros.
+func mixedWords(whole, num, den string) (string, error) {
+	rv, err := StringToWords(whole)
+	if err != nil {
+		return "", err
+	}
+	if num == "0" {
+		return rv, nil
+	}
+	fract, err := fractionWords(num, den, true)
+	if err != nil {
+		return "", err
+	}
+	return rv + " and " + fract, nil
+}
+
+// fractionWords converts a fraction into English words, e.g. "3", "4" => "three-quarters".
+// If useA is true, a numerator of one is "a" (or "an") instead, e.g. "1", "2" => "a half", "1", "8" => "an eighth".
+// All of the provided numbers must be positive number strings without leading zeros.
+func fractionWords(num, den string, useA bool) (string, error) {
+	numWords, err := StringToWords(num)
+	if err != nil {
+		return "", err
+	}
+	if den == "1" {
+		return numWords, nil
+	}
+	denWords, err := denominatorWords(den, num != "1")
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case useA && num == "1" && strings.ContainsAny(denWords[:1], "aeiou") && !strings.HasPrefix(denWords, "one"):
+		return "an " + denWords, nil
+	case useA && num == "1":
+		return "a " + denWords, nil
+	case strings.ContainsAny(numWords+denWords, " -"):
+		return numWords + " " + denWords, nil
+	}
+	// Single word parts are hyphenated, e.g. "two-thirds".
+	return numWords + "-" + denWords, nil
+}
+
+// denominatorWords converts the provided denominator into English words, e.g. "3" => "third", "4" => "quarter".
+// If plural is true, the plural form is returned, e.g. "3" => "thirds", "2" => "halves".
+// The denominator must be a positive number string without leading zeros.
+func denominatorWords(den string, plural bool) (string, error) {
+	var rv string
+	switch den {
+	case "2":
+		if plural {
+			return "halves", nil
+		}
+		return "half", nil
+	case "4":
+		rv = "quarter"
+	default:
+		// Powers of ten use the place-value names, e.g. "thousandth" instead of "one thousandth".
+		if den[0] == '1' && strings.Trim(den[1:], "0") == "" {
+			return placeValueName(len(den)-1, plural), nil
+		}
+		words, err := StringToWords(den)
+		if err != nil {
+			return "", err
+		}
+		rv = WordsToIth(words)
+	}
+	if plural {
+		rv += "s"
+	}
+	return rv, nil
+}
+
+// placeValueName gets the name of the place value with the provided number of digits after the decimal point.
+// E.g. 1 => "tenth", 2 => "hundredth", 4 => "ten-thousandth". If plural is true, an "s" is added.
+func placeValueName(digits int, plural bool) string {
+	var rv string
+	base := MustGetQuantifier(digits / 3)
+	if len(base) == 0 {
+		rv = []string{"", "ten", "hundred"}[digits%3]
+	} else {
+		rv = []string{"", "ten-", "hundred-"}[digits%3] + base
+	}
+	rv += "th"
+	if plural {
+		rv += "s"
+	}
+	return rv
+}
+
+// placeValueWords converts the provided whole and fractional portions of a number into English words
+// using place-value names, e.g. "1", "25" => "one and twenty-five hundredths".
+// The whole portion is omitted if it's zero (or empty).
+func placeValueWords(whole, fract string) (string, error) {
+	var wholeWords string
+	if len(whole) > 0 && trimZeros(whole) != "0" {
+		var err error
+		wholeWords, err = StringToWords(trimZeros(whole))
+		if err != nil {
+			return "", err
+		}
+	}
+	if len(fract) == 0 {
+		if len(wholeWords) == 0 {
+			return "zero", nil
+		}
+		return wholeWords, nil
+	}
+
+	num := trimZeros(fract)
+	fractWords, err := StringToWords(num)
+	if err != nil {
+		return "", err
+	}
+	fractWords += " " + placeValueName(len(fract), num != "1")
+	if len(wholeWords) == 0 {
+		return fractWords, nil
+	}
+	return wholeWords + " and " + fractWords, nil
+}
+
+// reducedFractionWords converts the provided whole and fractional portions of a number into English words
+// as a reduced fraction or mixed number, e.g. "1", "5" => "one and a half", "", "75" => "three-quarters".
+func reducedFractionWords(whole, fract string) (string, error) {
+	num, ok := new(big.Int).SetString(trimZeros(whole+fract), 10)
+	if !ok {
+		return "", fmt.Errorf("could not parse %q", whole+"."+fract)
+	}
+	den := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(len(fract))), nil)
+	gcd := new(big.Int).GCD(nil, nil, num, den)
+	if gcd.Sign() != 0 {
+		num.Quo(num, gcd)
+		den.Quo(den, gcd)
+	}
+	wholeNum, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	switch {
+	case rem.Sign() == 0:
+		return StringToWords(wholeNum.String())
+	case wholeNum.Sign() == 0:
+		return fractionWords(rem.String(), den.String(), false)
+	}
+	return mixedWords(wholeNum.String(), rem.String(), den.String())
+}
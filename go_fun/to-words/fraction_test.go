@@ -0,0 +1,125 @@
+package to_words
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFractionToWords(t *testing.T) {
+	tests := []struct {
+		str    string
+		exp    string
+		expErr string
+	}{
+		{str: "", expErr: "not a fraction \"\""},
+		{str: "1.5", expErr: "not a fraction \"1.5\""},
+		{str: "1/", expErr: "not a fraction \"1/\""},
+		{str: "/2", expErr: "not a fraction \"/2\""},
+		{str: "1/2/3", expErr: "not a fraction \"1/2/3\""},
+		{str: "1 -1/2", expErr: "not a fraction \"1 -1/2\""},
+		{str: "1/-2", expErr: "not a fraction \"1/-2\""},
+		{str: "3/0", expErr: "invalid fraction \"3/0\": denominator cannot be zero"},
+		{str: "1 3/00", expErr: "invalid fraction \"1 3/00\": denominator cannot be zero"},
+		{str: "1/2", exp: "one-half"},
+		{str: "2/2", exp: "two-halves"},
+		{str: "1 / 2", exp: "one-half"},
+		{str: "1/3", exp: "one-third"},
+		{str: "2/3", exp: "two-thirds"},
+		{str: "1/4", exp: "one-quarter"},
+		{str: "3/4", exp: "three-quarters"},
+		{str: "2/4", exp: "two-quarters"},
+		{str: "5/8", exp: "five-eighths"},
+		{str: "0/5", exp: "zero-fifths"},
+		{str: "1/10", exp: "one-tenth"},
+		{str: "7/10", exp: "seven-tenths"},
+		{str: "21/100", exp: "twenty-one hundredths"},
+		{str: "1/100", exp: "one-hundredth"},
+		{str: "3/1000000", exp: "three-millionths"},
+		{str: "9/10000", exp: "nine ten-thousandths"},
+		{str: "1/12", exp: "one-twelfth"},
+		{str: "5/21", exp: "five twenty-firsts"},
+		{str: "1/101", exp: "one one hundred first"},
+		{str: "3/2", exp: "three-halves"},
+		{str: "7/1", exp: "seven"},
+		{str: "007/08", exp: "seven-eighths"},
+		{str: "-5/8", exp: "negative five-eighths"},
+		{str: "1 1/2", exp: "one and a half"},
+		{str: "2 3/4", exp: "two and three-quarters"},
+		{str: "3 1/4", exp: "three and a quarter"},
+		{str: "1 1/8", exp: "one and an eighth"},
+		{str: "1 1/11", exp: "one and an eleventh"},
+		{str: "1 1/3", exp: "one and a third"},
+		{str: "10 1/100", exp: "ten and a hundredth"},
+		{str: "4 0/7", exp: "four"},
+		{str: "0 1/2", exp: "zero and a half"},
+		{str: "-1 1/2", exp: "negative one and a half"},
+		{str: "12  5/6", exp: "twelve and five-sixths"},
+	}
+
+	for _, tc := range tests {
+		name := tc.str
+		if len(name) == 0 {
+			name = "empty string"
+		}
+		t.Run("normal: "+name, func(t *testing.T) {
+			var act string
+			var err error
+			testFunc := func() {
+				act, err = FractionToWords(tc.str)
+			}
+			require.NotPanics(t, testFunc, "FractionToWords(%q)", tc.str)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "FractionToWords(%q) error", tc.str)
+			} else {
+				assert.NoError(t, err, "FractionToWords(%q) error", tc.str)
+			}
+			assert.Equal(t, tc.exp, act, "FractionToWords(%q) result", tc.str)
+		})
+
+		t.Run("must: "+name, func(t *testing.T) {
+			var act string
+			testFunc := func() {
+				act = MustFractionToWords(tc.str)
+			}
+			if len(tc.expErr) > 0 {
+				require.PanicsWithError(t, tc.expErr, testFunc, "MustFractionToWords(%q)", tc.str)
+			} else {
+				require.NotPanics(t, testFunc, "MustFractionToWords(%q)", tc.str)
+			}
+			assert.Equal(t, tc.exp, act, "MustFractionToWords(%q) result", tc.str)
+		})
+	}
+}
+
+func TestPlaceValueName(t *testing.T) {
+	tests := []struct {
+		digits int
+		plural bool
+		exp    string
+	}{
+		{digits: 1, exp: "tenth"},
+		{digits: 1, plural: true, exp: "tenths"},
+		{digits: 2, exp: "hundredth"},
+		{digits: 3, exp: "thousandth"},
+		{digits: 4, exp: "ten-thousandth"},
+		{digits: 5, plural: true, exp: "hundred-thousandths"},
+		{digits: 6, exp: "millionth"},
+		{digits: 7, exp: "ten-millionth"},
+		{digits: 9, exp: "billionth"},
+		{digits: 11, plural: true, exp: "hundred-billionths"},
+		{digits: 48, exp: "quinquadecillionth"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.exp, func(t *testing.T) {
+			var act string
+			testFunc := func() {
+				act = placeValueName(tc.digits, tc.plural)
+			}
+			require.NotPanics(t, testFunc, "placeValueName(%d, %t)", tc.digits, tc.plural)
+			assert.Equal(t, tc.exp, act, "placeValueName(%d, %t) result", tc.digits, tc.plural)
+		})
+	}
+}
@@ -0,0 +1,381 @@
+package to_words
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// WordsToInt converts the provided English number words into an int.
+// This is the inverse of IntToWords and IthWords.
+//
+// Examples:
+//   - "zero" => 0
+//   - "forty-three" => 43
+//   - "one hundred eleven" => 111
+//   - "fifty-four thousand three hundred twenty-one" => 54,321
+//   - "negative twelve" => -12
+//   - "thirty-second" => 32
+//
+// Returns an error if the words are not a whole number, or the number doesn't fit in an int.
+// See also: MustWordsToInt, WordsToString.
+func WordsToInt(words string) (int, error) {
+	str, err := WordsToString(words)
+	if err != nil {
+		return 0, err
+	}
+	if !wholeNumRx.MatchString(str) {
+		return 0, fmt.Errorf("cannot convert %q to an int: not a whole number", words)
+	}
+	rv, err := strconv.Atoi(str)
+	if err != nil {
+		return 0, fmt.Errorf("cannot convert %q to an int: value %s out of range", words, str)
+	}
+	return rv, nil
+}
+
+// MustWordsToInt converts the provided English number words into an int.
+// This is the inverse of IntToWords and IthWords.
+//
+// Examples:
+//   - "zero" => 0
+//   - "forty-three" => 43
+//   - "one hundred eleven" => 111
+//   - "fifty-four thousand three hundred twenty-one" => 54,321
+//   - "negative twelve" => -12
+//   - "thirty-second" => 32
+//
+// Panics if the words are not a whole number, or the number doesn't fit in an int.
+// See also: WordsToInt.
+func MustWordsToInt(words string) int {
+	rv, err := WordsToInt(words)
+	if err != nil {
+		panic(err)
+	}
+	return rv
+}
+
+// WordsToString converts the provided English number words into a number string.
+// This is the inverse of StringToWords, StringToSpoken, IthWords, and the other *ToWords and *ToSpoken functions.
+// Case, commas, extra whitespace, and an "and" between words (e.g. "one hundred and five") are all ignored.
+// The words "minus" and "a" (e.g. "a hundred") are accepted as "negative" and "one".
+//
+// Examples:
+//   - "zero" => "0"
+//   - "fifty-four thousand three hundred twenty-one" => "54321"
+//   - "negative twelve" => "-12"
+//   - "thirty-second" => "32"
+//   - "point five" => ".5"
+//   - "negative ten point seven one three" => "-10.713"
+//   - "four times ten to the five" => "4e5"
+//   - "negative one point two times ten to the negative three" => "-1.2e-3"
+//
+// Returns an error if the words are not a number, or use a quantifier larger than "millinillion".
+// See also: MustWordsToString, WordsToInt.
+func WordsToString(words string) (string, error) {
+	tokens := tokenizeWords(words)
+	if len(tokens) == 0 {
+		return "", fmt.Errorf("cannot convert %q to a number: no words", words)
+	}
+
+	// Scientific notation is "<base> times ten to the <exponent>".
+	for i := 0; i+4 < len(tokens); i++ {
+		if tokens[i] == "times" && tokens[i+1] == "ten" && tokens[i+2] == "to" && tokens[i+3] == "the" {
+			base, err := decimalTokensToString(tokens[:i])
+			if err != nil {
+				return "", fmt.Errorf("cannot convert %q to a number: invalid base: %w", words, err)
+			}
+			exponent, err := decimalTokensToString(tokens[i+4:])
+			if err != nil {
+				return "", fmt.Errorf("cannot convert %q to a number: invalid exponent: %w", words, err)
+			}
+			return base + "e" + exponent, nil
+		}
+	}
+
+	rv, err := decimalTokensToString(tokens)
+	if err != nil {
+		return "", fmt.Errorf("cannot convert %q to a number: %w", words, err)
+	}
+	return rv, nil
+}
+
+// MustWordsToString converts the provided English number words into a number string.
+// This is the inverse of StringToWords, StringToSpoken, IthWords, and the other *ToWords and *ToSpoken functions.
+// Case, commas, extra whitespace, and an "and" between words (e.g. "one hundred and five") are all ignored.
+// The words "minus" and "a" (e.g. "a hundred") are accepted as "negative" and "one".
+//
+// Examples:
+//   - "zero" => "0"
+//   - "fifty-four thousand three hundred twenty-one" => "54321"
+//   - "negative twelve" => "-12"
+//   - "thirty-second" => "32"
+//   - "point five" => ".5"
+//   - "negative ten point seven one three" => "-10.713"
+//   - "four times ten to the five" => "4e5"
+//   - "negative one point two times ten to the negative three" => "-1.2e-3"
+//
+// Panics if the words are not a number.
+// See also: WordsToString.
+func MustWordsToString(words string) string {
+	rv, err := WordsToString(words)
+	if err != nil {
+		panic(err)
+	}
+	return rv
+}
+
+// tokenizeWords lower-cases the provided words and splits them on whitespace, hyphens, and commas.
+// Any "and" words are dropped.
+func tokenizeWords(words string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(words), func(r rune) bool {
+		return r == ' ' || r == '-' || r == ',' || r == '\t' || r == '\n' || r == '\r'
+	})
+	rv := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if field != "and" {
+			rv = append(rv, field)
+		}
+	}
+	return rv
+}
+
+// decimalTokensToString converts tokens from the likes of FloatToSpoken into a number string.
+// The whole part is parsed using wholeTokensToString and each word after "point" must be a single digit.
+func decimalTokensToString(tokens []string) (string, error) {
+	neg := false
+	if len(tokens) > 0 && (tokens[0] == "negative" || tokens[0] == "minus") {
+		neg = true
+		tokens = tokens[1:]
+	}
+
+	point := -1
+	for i, token := range tokens {
+		if token == "point" {
+			point = i
+			break
+		}
+	}
+
+	var whole, fract string
+	wholeTokens := tokens
+	if point >= 0 {
+		wholeTokens = tokens[:point]
+		fractTokens := tokens[point+1:]
+		if len(fractTokens) == 0 {
+			return "", errors.New("no digits after \"point\"")
+		}
+		digits := make([]byte, len(fractTokens))
+		for i, token := range fractTokens {
+			val, ok := unitWords[token]
+			if !ok || val > 9 {
+				return "", fmt.Errorf("unexpected word %q after \"point\": must be a single digit", token)
+			}
+			digits[i] = byte('0' + val)
+		}
+		fract = "." + string(digits)
+	}
+
+	if len(wholeTokens) > 0 {
+		var err error
+		whole, err = wholeTokensToString(wholeTokens)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if len(whole) == 0 && len(fract) == 0 {
+		return "", errors.New("no number words")
+	}
+	if neg {
+		return "-" + whole + fract, nil
+	}
+	return whole + fract, nil
+}
+
+// unitWords maps the words for the numbers zero through nineteen to their values.
+var unitWords = map[string]int{
+	"zero":      0,
+	"one":       1,
+	"two":       2,
+	"three":     3,
+	"four":      4,
+	"five":      5,
+	"six":       6,
+	"seven":     7,
+	"eight":     8,
+	"nine":      9,
+	"ten":       10,
+	"eleven":    11,
+	"twelve":    12,
+	"thirteen":  13,
+	"fourteen":  14,
+	"fifteen":   15,
+	"sixteen":   16,
+	"seventeen": 17,
+	"eighteen":  18,
+	"nineteen":  19,
+}
+
+// tensWords maps the words for the multiples of ten (from twenty to ninety) to their values.
+var tensWords = map[string]int{
+	"twenty":  20,
+	"thirty":  30,
+	"forty":   40,
+	"fifty":   50,
+	"sixty":   60,
+	"seventy": 70,
+	"eighty":  80,
+	"ninety":  90,
+}
+
+// ithWords is a map of sequence number (e.g. "first") to number string (e.g. "one").
+// It's the inverse of seqWords.
+var ithWords = func() map[string]string {
+	rv := make(map[string]string, len(seqWords))
+	for num, ith := range seqWords {
+		rv[ith] = num
+	}
+	return rv
+}()
+
+// fromIthWord converts the provided word from a sequence number back to a number word, e.g. "second" => "two".
+// Returns the provided word and false if it's not a sequence number word.
+func fromIthWord(word string) (string, bool) {
+	if num, ok := ithWords[word]; ok {
+		return num, true
+	}
+	if num, ok := strings.CutSuffix(word, "th"); ok {
+		if _, isUnit := unitWords[num]; isUnit {
+			return num, true
+		}
+		if num == "hundred" || quantifierID(num) > 0 {
+			return num, true
+		}
+	}
+	return word, false
+}
+
+// quantifierID returns the groupID of the provided quantifier word, e.g. "thousand" => 1, "million" => 2.
+// Returns -1 if the word isn't a known quantifier.
+func quantifierID(word string) int {
+	if len(word) == 0 {
+		return -1
+	}
+	for i, quant := range Quantifiers {
+		if quant == word {
+			return len(Quantifiers) - 1 - i
+		}
+	}
+	if n, ok := conwayWechslerIndex(word); ok {
+		return n + 1
+	}
+	return -1
+}
+
+// maxQuantifierID is the groupID of the largest quantifier allowed in words, "millinillion" (10^3003).
+// Without a limit, a single long Conway-Wechsler name could take ages and tons of memory to convert.
+const maxQuantifierID = 1001
+
+// wholeTokensToString converts tokens from the likes of IntToWords (without a "negative") into a number string.
+// The last token can be a sequence number word, e.g. "thirty", "second".
+func wholeTokensToString(tokens []string) (string, error) {
+	tokens = append([]string{}, tokens...)
+	last := len(tokens) - 1
+	tokens[last], _ = fromIthWord(tokens[last])
+
+	if len(tokens) == 1 && tokens[0] == "zero" {
+		return "0", nil
+	}
+
+	total := new(big.Int)
+	thousand := big.NewInt(1000)
+	// chunk is the value of the words since the last quantifier (e.g. "three hundred twenty-one").
+	// It is usually less than 1000, but can be more for things like "twelve hundred".
+	chunk := 0
+	// tens is the tens amount in the chunk (if any) that can still have a single digit added to it.
+	tens := 0
+	// lastQuant is the groupID of the most recent quantifier; each must be smaller than the one before it.
+	lastQuant := math.MaxInt
+	prev := ""
+	for i, token := range tokens {
+		next := ""
+		if i+1 < len(tokens) {
+			next = tokens[i+1]
+		}
+
+		if unit, ok := unitWords[token]; ok {
+			switch {
+			case token == "zero":
+				return "", errors.New("unexpected word \"zero\" in a larger number")
+			case tens > 0 && unit < 10:
+				chunk += unit
+				tens = 0
+			case chunk%100 != 0:
+				return "", unexpectedWordErr(token, prev)
+			default:
+				chunk += unit
+			}
+			prev = token
+			continue
+		}
+		if val, ok := tensWords[token]; ok {
+			if chunk%100 != 0 {
+				return "", unexpectedWordErr(token, prev)
+			}
+			chunk += val
+			tens = val
+			prev = token
+			continue
+		}
+		tens = 0
+
+		if token == "a" {
+			if chunk != 0 || (next != "hundred" && quantifierID(next) <= 0) {
+				return "", errors.New("unexpected word \"a\"")
+			}
+			chunk = 1
+			prev = token
+			continue
+		}
+
+		if token == "hundred" {
+			if chunk == 0 || chunk >= 100 {
+				return "", unexpectedWordErr(token, prev)
+			}
+			chunk *= 100
+			prev = token
+			continue
+		}
+
+		quant := quantifierID(token)
+		if quant <= 0 {
+			return "", unexpectedWordErr(token, "")
+		}
+		if quant > maxQuantifierID {
+			return "", fmt.Errorf("quantifier %q is too large: the largest allowed is \"millinillion\"", token)
+		}
+		if chunk == 0 || quant >= lastQuant {
+			return "", unexpectedWordErr(token, prev)
+		}
+		amount := new(big.Int).Exp(thousand, big.NewInt(int64(quant)), nil)
+		total.Add(total, amount.Mul(amount, big.NewInt(int64(chunk))))
+		chunk = 0
+		lastQuant = quant
+		prev = token
+	}
+
+	total.Add(total, big.NewInt(int64(chunk)))
+	return total.String(), nil
+}
+
+// unexpectedWordErr returns an error indicating that the provided word was not expected after the previous one.
+func unexpectedWordErr(word, prev string) error {
+	if len(prev) == 0 {
+		return fmt.Errorf("unexpected word %q", word)
+	}
+	return fmt.Errorf("unexpected word %q after %q", word, prev)
+}
@@ -0,0 +1,335 @@
+package to_words
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWordsToString(t *testing.T) {
+	tests := []struct {
+		name   string
+		words  string
+		exp    string
+		expErr string
+	}{
+		{
+			name:   "empty string",
+			words:  "",
+			expErr: "cannot convert \"\" to a number: no words",
+		},
+		{
+			name:   "just whitespace and hyphens",
+			words:  " - \t ",
+			expErr: "cannot convert \" - \\t \" to a number: no words",
+		},
+		{
+			name:   "not a number",
+			words:  "banana",
+			expErr: "cannot convert \"banana\" to a number: unexpected word \"banana\"",
+		},
+		{
+			name:   "two units in a row",
+			words:  "one two",
+			expErr: "cannot convert \"one two\" to a number: unexpected word \"two\" after \"one\"",
+		},
+		{
+			name:   "two tens in a row",
+			words:  "twenty thirty",
+			expErr: "cannot convert \"twenty thirty\" to a number: unexpected word \"thirty\" after \"twenty\"",
+		},
+		{
+			name:   "teen after tens",
+			words:  "twenty twelve",
+			expErr: "cannot convert \"twenty twelve\" to a number: unexpected word \"twelve\" after \"twenty\"",
+		},
+		{
+			name:   "zero in a larger number",
+			words:  "one hundred zero",
+			expErr: "cannot convert \"one hundred zero\" to a number: unexpected word \"zero\" in a larger number",
+		},
+		{
+			name:   "hundred without a number",
+			words:  "hundred",
+			expErr: "cannot convert \"hundred\" to a number: unexpected word \"hundred\"",
+		},
+		{
+			name:   "hundred hundred",
+			words:  "one hundred hundred",
+			expErr: "cannot convert \"one hundred hundred\" to a number: unexpected word \"hundred\" after \"hundred\"",
+		},
+		{
+			name:   "quantifiers out of order",
+			words:  "one thousand two million",
+			expErr: "cannot convert \"one thousand two million\" to a number: unexpected word \"million\" after \"two\"",
+		},
+		{
+			name:   "repeated quantifier",
+			words:  "one thousand two thousand",
+			expErr: "cannot convert \"one thousand two thousand\" to a number: unexpected word \"thousand\" after \"two\"",
+		},
+		{
+			name:   "a without hundred or quantifier",
+			words:  "a five",
+			expErr: "cannot convert \"a five\" to a number: unexpected word \"a\"",
+		},
+		{
+			name:   "quantifier too large",
+			words:  "one millimillion",
+			expErr: "cannot convert \"one millimillion\" to a number: quantifier \"millimillion\" is too large: the largest allowed is \"millinillion\"",
+		},
+		{
+			name:   "just negative",
+			words:  "negative",
+			expErr: "cannot convert \"negative\" to a number: no number words",
+		},
+		{
+			name:   "nothing after point",
+			words:  "one point",
+			expErr: "cannot convert \"one point\" to a number: no digits after \"point\"",
+		},
+		{
+			name:   "not a digit after point",
+			words:  "one point twelve",
+			expErr: "cannot convert \"one point twelve\" to a number: unexpected word \"twelve\" after \"point\": must be a single digit",
+		},
+		{
+			name:   "invalid scientific base",
+			words:  "bad times ten to the five",
+			expErr: "cannot convert \"bad times ten to the five\" to a number: invalid base: unexpected word \"bad\"",
+		},
+		{
+			name:   "invalid scientific exponent",
+			words:  "five times ten to the bad",
+			expErr: "cannot convert \"five times ten to the bad\" to a number: invalid exponent: unexpected word \"bad\"",
+		},
+		{name: "zero", words: "zero", exp: "0"},
+		{name: "five", words: "five", exp: "5"},
+		{name: "twelve", words: "twelve", exp: "12"},
+		{name: "eighty", words: "eighty", exp: "80"},
+		{name: "forty-three", words: "forty-three", exp: "43"},
+		{name: "forty three", words: "forty three", exp: "43"},
+		{name: "one hundred eleven", words: "one hundred eleven", exp: "111"},
+		{name: "one hundred and five", words: "one hundred and five", exp: "105"},
+		{name: "a hundred", words: "a hundred", exp: "100"},
+		{name: "a thousand and one", words: "a thousand and one", exp: "1001"},
+		{name: "twelve hundred thirty-four", words: "twelve hundred thirty-four", exp: "1234"},
+		{name: "upper case", words: "Fifty-Four THOUSAND", exp: "54000"},
+		{name: "with commas", words: "one million, two thousand, three", exp: "1002003"},
+		{name: "extra whitespace", words: "  one   hundred\ttwo ", exp: "102"},
+		{
+			name:  "fifty-four thousand three hundred twenty-one",
+			words: "fifty-four thousand three hundred twenty-one",
+			exp:   "54321",
+		},
+		{
+			name:  "skipped groups",
+			words: "seven billion eight",
+			exp:   "7000000008",
+		},
+		{
+			name:  "quattuordecillion",
+			words: "one quattuordecillion",
+			exp:   "1000000000000000000000000000000000000000000000",
+		},
+		{
+			name:  "conway-wechsler",
+			words: "two quinquadecillion three",
+			exp:   "2000000000000000000000000000000000000000000000003",
+		},
+		{
+			name:  "centillionth",
+			words: "one centillion fifth",
+			exp:   "1" + strings.Repeat("0", 300) + "005",
+		},
+		{
+			name:  "millinillion",
+			words: "one millinillion",
+			exp:   "1" + strings.Repeat("0", 3003),
+		},
+		{name: "negative twelve", words: "negative twelve", exp: "-12"},
+		{name: "minus twelve", words: "minus twelve", exp: "-12"},
+		{name: "zeroth", words: "zeroth", exp: "0"},
+		{name: "first", words: "first", exp: "1"},
+		{name: "fourth", words: "fourth", exp: "4"},
+		{name: "eighth", words: "eighth", exp: "8"},
+		{name: "twelfth", words: "twelfth", exp: "12"},
+		{name: "twentieth", words: "twentieth", exp: "20"},
+		{name: "thirty-second", words: "thirty-second", exp: "32"},
+		{name: "one hundredth", words: "one hundredth", exp: "100"},
+		{name: "two millionth", words: "two millionth", exp: "2000000"},
+		{name: "negative first", words: "negative first", exp: "-1"},
+		{name: "point five", words: "point five", exp: ".5"},
+		{name: "negative point five", words: "negative point five", exp: "-.5"},
+		{name: "zero point five", words: "zero point five", exp: "0.5"},
+		{name: "trailing zeros", words: "one point five zero", exp: "1.50"},
+		{
+			name:  "negative float",
+			words: "negative ten point seven one three",
+			exp:   "-10.713",
+		},
+		{
+			name:  "scientific",
+			words: "four times ten to the five",
+			exp:   "4e5",
+		},
+		{
+			name:  "scientific with negatives and decimals",
+			words: "negative one point two times ten to the negative three point four",
+			exp:   "-1.2e-3.4",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run("normal: "+tc.name, func(t *testing.T) {
+			var act string
+			var err error
+			testFunc := func() {
+				act, err = WordsToString(tc.words)
+			}
+			require.NotPanics(t, testFunc, "WordsToString(%q)", tc.words)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "WordsToString(%q) error", tc.words)
+			} else {
+				assert.NoError(t, err, "WordsToString(%q) error", tc.words)
+			}
+			assert.Equal(t, tc.exp, act, "WordsToString(%q) result", tc.words)
+		})
+
+		t.Run("must: "+tc.name, func(t *testing.T) {
+			var act string
+			testFunc := func() {
+				act = MustWordsToString(tc.words)
+			}
+			if len(tc.expErr) > 0 {
+				require.PanicsWithError(t, tc.expErr, testFunc, "MustWordsToString(%q)", tc.words)
+			} else {
+				require.NotPanics(t, testFunc, "MustWordsToString(%q)", tc.words)
+			}
+			assert.Equal(t, tc.exp, act, "MustWordsToString(%q) result", tc.words)
+		})
+	}
+}
+
+func TestWordsToStringHugeQuantifier(t *testing.T) {
+	// Without a limit, this one takes several seconds and makes a number with about 30 million digits.
+	words := "one nonillinovenonagintanongentillinovenonagintanongentillion"
+	_, err := WordsToString(words)
+	require.Error(t, err, "WordsToString(%q)", words)
+	assert.Contains(t, err.Error(), "is too large", "WordsToString(%q) error", words)
+}
+
+func TestWordsToInt(t *testing.T) {
+	tests := []struct {
+		name   string
+		words  string
+		exp    int
+		expErr string
+	}{
+		{
+			name:   "not a number",
+			words:  "nope",
+			expErr: "cannot convert \"nope\" to a number: unexpected word \"nope\"",
+		},
+		{
+			name:   "float",
+			words:  "one point five",
+			expErr: "cannot convert \"one point five\" to an int: not a whole number",
+		},
+		{
+			name:   "scientific",
+			words:  "one times ten to the five",
+			expErr: "cannot convert \"one times ten to the five\" to an int: not a whole number",
+		},
+		{
+			name:   "too big",
+			words:  "ten quintillion",
+			expErr: "cannot convert \"ten quintillion\" to an int: value 10000000000000000000 out of range",
+		},
+		{name: "zero", words: "zero", exp: 0},
+		{name: "negative twelve", words: "negative twelve", exp: -12},
+		{name: "thirty-second", words: "thirty-second", exp: 32},
+		{
+			name:  "fifty-four thousand three hundred twenty-one",
+			words: "fifty-four thousand three hundred twenty-one",
+			exp:   54321,
+		},
+		{name: "max int", words: IntToWords(math.MaxInt), exp: math.MaxInt},
+		{name: "min int", words: IntToWords(math.MinInt), exp: math.MinInt},
+	}
+
+	for _, tc := range tests {
+		t.Run("normal: "+tc.name, func(t *testing.T) {
+			var act int
+			var err error
+			testFunc := func() {
+				act, err = WordsToInt(tc.words)
+			}
+			require.NotPanics(t, testFunc, "WordsToInt(%q)", tc.words)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "WordsToInt(%q) error", tc.words)
+			} else {
+				assert.NoError(t, err, "WordsToInt(%q) error", tc.words)
+			}
+			assert.Equal(t, tc.exp, act, "WordsToInt(%q) result", tc.words)
+		})
+
+		t.Run("must: "+tc.name, func(t *testing.T) {
+			var act int
+			testFunc := func() {
+				act = MustWordsToInt(tc.words)
+			}
+			if len(tc.expErr) > 0 {
+				require.PanicsWithError(t, tc.expErr, testFunc, "MustWordsToInt(%q)", tc.words)
+			} else {
+				require.NotPanics(t, testFunc, "MustWordsToInt(%q)", tc.words)
+			}
+			assert.Equal(t, tc.exp, act, "MustWordsToInt(%q) result", tc.words)
+		})
+	}
+}
+
+func TestWordsToIntRoundTrip(t *testing.T) {
+	nums := []int{math.MinInt, -1234567, -1000, -21, 0, 1, 99, 100, 101, 999, 1000, 1001, 20020, 1000000, math.MaxInt}
+	for i := 0; i <= 1200; i++ {
+		nums = append(nums, i)
+	}
+
+	for _, num := range nums {
+		words := IntToWords(num)
+		act, err := WordsToInt(words)
+		if assert.NoError(t, err, "WordsToInt(IntToWords(%d)) error, words = %q", num, words) {
+			assert.Equal(t, num, act, "WordsToInt(IntToWords(%d)), words = %q", num, words)
+		}
+
+		ith := IthWords(num)
+		act, err = WordsToInt(ith)
+		if assert.NoError(t, err, "WordsToInt(IthWords(%d)) error, words = %q", num, ith) {
+			assert.Equal(t, num, act, "WordsToInt(IthWords(%d)), words = %q", num, ith)
+		}
+	}
+}
+
+func TestWordsToStringRoundTrip(t *testing.T) {
+	strs := []string{
+		"0", "-12", "54321", "1000000000000000000000000000000000000000000001",
+		"0.5", ".5", "-.5", "1.50", "-10.713", "54321.987",
+		"1e5", "-3e4", "0.454e15", "-1.2e3.4", "210.567e-7.123",
+	}
+	for i := 0; i < 100; i++ {
+		strs = append(strs, strconv.Itoa(i*7919))
+	}
+
+	for _, str := range strs {
+		words, err := StringToSpoken(str)
+		require.NoError(t, err, "StringToSpoken(%q)", str)
+		act, err := WordsToString(words)
+		if assert.NoError(t, err, "WordsToString(StringToSpoken(%q)) error, words = %q", str, words) {
+			assert.Equal(t, str, act, "WordsToString(StringToSpoken(%q)), words = %q", str, words)
+		}
+	}
+}
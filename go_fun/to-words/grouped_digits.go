@@ -0,0 +1,172 @@
+package to_words
+
+import (
+	"fmt"
+	"strings"
+)
+
+// digitWords are the words used for each digit when reading grouped digits. Zero is read as "oh".
+var digitWords = []string{"oh", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine"}
+
+// splitDigitGroups splits the provided phone or card number into its groups of digits.
+// Groups are separated by spaces, dashes, dots, slashes, and parentheses. A leading "+" is allowed.
+// Returns the groups, whether there was a leading "+", and any error.
+func splitDigitGroups(str string) ([]string, bool, error) {
+	rest, plus := strings.CutPrefix(strings.TrimSpace(str), "+")
+	groups := strings.FieldsFunc(rest, func(r rune) bool {
+		return strings.ContainsRune(" -./()\t", r)
+	})
+	if len(groups) == 0 {
+		return nil, false, fmt.Errorf("cannot read %q as grouped digits: no digits", str)
+	}
+	for _, group := range groups {
+		if strings.Trim(group, "0123456789") != "" {
+			return nil, false, fmt.Errorf("cannot read %q as grouped digits: invalid group %q", str, group)
+		}
+	}
+	return groups, plus, nil
+}
+
+// joinDigitGroups joins the provided words for each group, separating the groups with a comma.
+func joinDigitGroups(groupWords []string, plus bool) string {
+	rv := strings.Join(groupWords, ", ")
+	if plus {
+		return "plus " + rv
+	}
+	return rv
+}
+
+// StringToPhoneDigits converts the provided phone number into English words the way we'd read it out loud.
+// Each digit is read on its own, with zero being "oh". Two of the same digit in a row are read as "double",
+// and three as "triple". Groups of digits (separated by spaces, dashes, dots, slashes, or parentheses)
+// are separated by commas. A leading "+" is read as "plus".
+//
+// Examples:
+//   - "555-1212" => "triple five, one two one two"
+//   - "(800) 555-0100" => "eight double oh, triple five, oh one double oh"
+//   - "+44 20 7946 0018" => "plus double four, two oh, seven nine four six, double oh one eight"
+//   - "1111" => "double one double one"
+//
+// Returns an error if the provided string has characters other than digits and separators, or has no digits.
+// See also: MustStringToPhoneDigits, StringToDigitPairs, StringToDigits.
+func StringToPhoneDigits(str string) (string, error) {
+	groups, plus, err := splitDigitGroups(str)
+	if err != nil {
+		return "", err
+	}
+
+	groupWords := make([]string, len(groups))
+	for i, group := range groups {
+		var words []string
+		for j := 0; j < len(group); {
+			run := 1
+			for j+run < len(group) && group[j+run] == group[j] {
+				run++
+			}
+			word := digitWords[group[j]-'0']
+			j += run
+			// Runs of four or more are broken up into doubles and triples, e.g. 5 => "triple X double X".
+			for run > 0 {
+				switch run {
+				case 1:
+					words = append(words, word)
+					run = 0
+				case 2, 4:
+					words = append(words, "double "+word)
+					run -= 2
+				default:
+					words = append(words, "triple "+word)
+					run -= 3
+				}
+			}
+		}
+		groupWords[i] = strings.Join(words, " ")
+	}
+	return joinDigitGroups(groupWords, plus), nil
+}
+
+// MustStringToPhoneDigits converts the provided phone number into English words the way we'd read it out loud.
+// Each digit is read on its own, with zero being "oh". Two of the same digit in a row are read as "double",
+// and three as "triple". Groups of digits (separated by spaces, dashes, dots, slashes, or parentheses)
+// are separated by commas. A leading "+" is read as "plus".
+//
+// Examples:
+//   - "555-1212" => "triple five, one two one two"
+//   - "(800) 555-0100" => "eight double oh, triple five, oh one double oh"
+//   - "+44 20 7946 0018" => "plus double four, two oh, seven nine four six, double oh one eight"
+//   - "1111" => "double one double one"
+//
+// Panics if the provided string has characters other than digits and separators, or has no digits.
+// See also: StringToPhoneDigits.
+func MustStringToPhoneDigits(str string) string {
+	rv, err := StringToPhoneDigits(str)
+	if err != nil {
+		panic(err)
+	}
+	return rv
+}
+
+// StringToDigitPairs converts the provided number into English words, reading the digits two at a time.
+// Groups of digits (separated by spaces, dashes, dots, slashes, or parentheses) are separated by commas.
+// If a group has an odd number of digits, the first digit is read on its own.
+// Pairs starting with zero are read with an "oh", e.g. "05" => "oh five", and "00" is "double oh".
+// A leading "+" is read as "plus".
+//
+// Examples:
+//   - "1234" => "twelve thirty-four"
+//   - "4111 1111 1111 1111" => "forty-one eleven, eleven eleven, eleven eleven, eleven eleven"
+//   - "12345" => "one twenty-three forty-five"
+//   - "0500" => "oh five double oh"
+//
+// Returns an error if the provided string has characters other than digits and separators, or has no digits.
+// See also: MustStringToDigitPairs, StringToPhoneDigits, StringToDigits.
+func StringToDigitPairs(str string) (string, error) {
+	groups, plus, err := splitDigitGroups(str)
+	if err != nil {
+		return "", err
+	}
+
+	groupWords := make([]string, len(groups))
+	for i, group := range groups {
+		var words []string
+		if len(group)%2 == 1 {
+			words = append(words, digitWords[group[0]-'0'])
+			group = group[1:]
+		}
+		for j := 0; j < len(group); j += 2 {
+			tens, ones := int(group[j]-'0'), int(group[j+1]-'0')
+			switch {
+			case tens == 0 && ones == 0:
+				words = append(words, "double oh")
+			case tens == 0:
+				words = append(words, "oh "+digitWords[ones])
+			default:
+				words = append(words, IntToWords(tens*10+ones))
+			}
+		}
+		groupWords[i] = strings.Join(words, " ")
+	}
+	return joinDigitGroups(groupWords, plus), nil
+}
+
+// MustStringToDigitPairs converts the provided number into English words, reading the digits two at a time.
+// Groups of digits (separated by spaces, dashes, dots, slashes, or parentheses) are separated by commas.
+// If a group has an odd number of digits, the first digit is read on its own.
+// Pairs starting with zero are read with an "oh", e.g. "05" => "oh five", and "00" is "double oh".
+// A leading "+" is read as "plus".
+//
+// Examples:
+//   - "1234" => "twelve thirty-four"
+//   - "4111 1111 1111 1111" => "forty-one eleven, eleven eleven, eleven eleven, eleven eleven"
+//   - "12345" => "one twenty-three forty-five"
+//   - "0500" => "oh five double oh"
+//
+// Panics if the provided string has characters other than digits and separators, or has no digits.
+// See also: StringToDigitPairs.
+func MustStringToDigitPairs(str string) string {
+	rv, err := StringToDigitPairs(str)
+	if err != nil {
+		panic(err)
+	}
+	return rv
+}
@@ -0,0 +1,118 @@
+package to_words
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStringToPhoneDigits(t *testing.T) {
+	tests := []struct {
+		str    string
+		exp    string
+		expErr string
+	}{
+		{str: "", expErr: "cannot read \"\" as grouped digits: no digits"},
+		{str: "+", expErr: "cannot read \"+\" as grouped digits: no digits"},
+		{str: "( ) -", expErr: "cannot read \"( ) -\" as grouped digits: no digits"},
+		{str: "555-CALL", expErr: "cannot read \"555-CALL\" as grouped digits: invalid group \"CALL\""},
+		{str: "1+2", expErr: "cannot read \"1+2\" as grouped digits: invalid group \"1+2\""},
+		{str: "0", exp: "oh"},
+		{str: "7", exp: "seven"},
+		{str: "123", exp: "one two three"},
+		{str: "55", exp: "double five"},
+		{str: "555", exp: "triple five"},
+		{str: "5555", exp: "double five double five"},
+		{str: "55555", exp: "triple five double five"},
+		{str: "555555", exp: "triple five triple five"},
+		{str: "5555555", exp: "triple five double five double five"},
+		{str: "555-1212", exp: "triple five, one two one two"},
+		{str: "(800) 555-0100", exp: "eight double oh, triple five, oh one double oh"},
+		{str: "+44 20 7946 0018", exp: "plus double four, two oh, seven nine four six, double oh one eight"},
+		{str: "867.5309", exp: "eight six seven, five three oh nine"},
+		{str: "01/02", exp: "oh one, oh two"},
+		{str: " 911 ", exp: "nine double one"},
+	}
+
+	for _, tc := range tests {
+		t.Run("normal: "+tc.str, func(t *testing.T) {
+			var act string
+			var err error
+			testFunc := func() {
+				act, err = StringToPhoneDigits(tc.str)
+			}
+			require.NotPanics(t, testFunc, "StringToPhoneDigits(%q)", tc.str)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "StringToPhoneDigits(%q) error", tc.str)
+			} else {
+				assert.NoError(t, err, "StringToPhoneDigits(%q) error", tc.str)
+			}
+			assert.Equal(t, tc.exp, act, "StringToPhoneDigits(%q) result", tc.str)
+		})
+
+		t.Run("must: "+tc.str, func(t *testing.T) {
+			var act string
+			testFunc := func() {
+				act = MustStringToPhoneDigits(tc.str)
+			}
+			if len(tc.expErr) > 0 {
+				require.PanicsWithError(t, tc.expErr, testFunc, "MustStringToPhoneDigits(%q)", tc.str)
+			} else {
+				require.NotPanics(t, testFunc, "MustStringToPhoneDigits(%q)", tc.str)
+			}
+			assert.Equal(t, tc.exp, act, "MustStringToPhoneDigits(%q) result", tc.str)
+		})
+	}
+}
+
+func TestStringToDigitPairs(t *testing.T) {
+	tests := []struct {
+		str    string
+		exp    string
+		expErr string
+	}{
+		{str: "", expErr: "cannot read \"\" as grouped digits: no digits"},
+		{str: "12.5x", expErr: "cannot read \"12.5x\" as grouped digits: invalid group \"5x\""},
+		{str: "0", exp: "oh"},
+		{str: "7", exp: "seven"},
+		{str: "10", exp: "ten"},
+		{str: "1234", exp: "twelve thirty-four"},
+		{str: "12345", exp: "one twenty-three forty-five"},
+		{str: "0500", exp: "oh five double oh"},
+		{str: "2000", exp: "twenty double oh"},
+		{str: "4111 1111 1111 1111", exp: "forty-one eleven, eleven eleven, eleven eleven, eleven eleven"},
+		{str: "3782-822463-10005", exp: "thirty-seven eighty-two, eighty-two twenty-four sixty-three, one double oh oh five"},
+		{str: "+1 (555) 0199", exp: "plus one, five fifty-five, oh one ninety-nine"},
+	}
+
+	for _, tc := range tests {
+		t.Run("normal: "+tc.str, func(t *testing.T) {
+			var act string
+			var err error
+			testFunc := func() {
+				act, err = StringToDigitPairs(tc.str)
+			}
+			require.NotPanics(t, testFunc, "StringToDigitPairs(%q)", tc.str)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "StringToDigitPairs(%q) error", tc.str)
+			} else {
+				assert.NoError(t, err, "StringToDigitPairs(%q) error", tc.str)
+			}
+			assert.Equal(t, tc.exp, act, "StringToDigitPairs(%q) result", tc.str)
+		})
+
+		t.Run("must: "+tc.str, func(t *testing.T) {
+			var act string
+			testFunc := func() {
+				act = MustStringToDigitPairs(tc.str)
+			}
+			if len(tc.expErr) > 0 {
+				require.PanicsWithError(t, tc.expErr, testFunc, "MustStringToDigitPairs(%q)", tc.str)
+			} else {
+				require.NotPanics(t, testFunc, "MustStringToDigitPairs(%q)", tc.str)
+			}
+			assert.Equal(t, tc.exp, act, "MustStringToDigitPairs(%q) result", tc.str)
+		})
+	}
+}
@@ -9,20 +9,26 @@ import (
 // E.g. 1 -> "1st", 2 -> "2nd", 3 -> "3rd"
 func Ith(n int) string {
 	nStr := strconv.Itoa(n)
-	sfx := "th"
+	return nStr + ithSuffix(nStr)
+}
+
+// ithSuffix returns the sequence suffix for the provided number string.
+// E.g. "1" -> "st", "12" -> "th", "22" -> "nd"
+func ithSuffix(nStr string) string {
 	switch {
 	case strings.HasSuffix(nStr, "1") && !strings.HasSuffix(nStr, "11"):
-		sfx = "st"
+		return "st"
 	case strings.HasSuffix(nStr, "2") && !strings.HasSuffix(nStr, "12"):
-		sfx = "nd"
+		return "nd"
 	case strings.HasSuffix(nStr, "3") && !strings.HasSuffix(nStr, "13"):
-		sfx = "rd"
+		return "rd"
 	}
-	return nStr + sfx
+	return "th"
 }
 
-// IthWords converts the provided number to words and makes it a sequence number.
+// IthWords converts the provided number to English words and makes it a sequence number.
 // E.g. 1 -> "first", 32 -> "thirty-second".
+// See also: IthWordsIn.
 func IthWords(n int) string {
 	return WordsToIth(IntToWords(n))
 }
@@ -46,8 +52,9 @@ var seqWords = map[string]string{
 	"ninety":  "ninetieth",
 }
 
-// WordsToIth converts the output of one of the *ToWords functions to a sequence number.
+// WordsToIth converts the output of one of the *ToWords functions to an English sequence number.
 // E.g. "one" -> "first", "thirty-two" -> "thirty-second".
+// See also: WordsToIthIn.
 func WordsToIth(val string) string {
 	if len(val) == 0 {
 		return ""
@@ -0,0 +1,178 @@
+package to_words
+
+import "strings"
+
+// German is the German language. It uses the long scale, e.g. "eine Milliarde" for 10^9 and "eine Billion" for 10^12.
+// Everything below one million is written as a single word, e.g. "zweitausenddreihundertfünfundvierzig".
+// Gender is ignored: German number words don't change with gender, and sequence numbers are given
+// in their uninflected form (e.g. "erste"). Negative numbers start with "minus".
+var German Language = german{}
+
+// german implements the Language interface for German.
+type german struct{}
+
+var _ Language = german{}
+
+// Tag returns the language tag of this language: "de".
+func (german) Tag() string {
+	return "de"
+}
+
+// deUnits are the German words for 0 through 19.
+var deUnits = []string{
+	"null", "eins", "zwei", "drei", "vier", "fünf", "sechs", "sieben", "acht", "neun",
+	"zehn", "elf", "zwölf", "dreizehn", "vierzehn", "fünfzehn", "sechzehn", "siebzehn", "achtzehn", "neunzehn",
+}
+
+// deTens are the German words for the multiples of ten, indexed by the tens digit. Only 2 through 9 are used.
+var deTens = []string{"", "", "zwanzig", "dreißig", "vierzig", "fünfzig", "sechzig", "siebzig", "achtzig", "neunzig"}
+
+// deQuantifiers are the singular and plural quantifiers for each group of three digits, indexed by groupID.
+// The first two are empty because those groups are combined into a single word, e.g. "zweitausendeins".
+var deQuantifiers = [][2]string{
+	{"", ""},
+	{"", ""},
+	{"Million", "Millionen"},
+	{"Milliarde", "Milliarden"},
+	{"Billion", "Billionen"},
+	{"Billiarde", "Billiarden"},
+	{"Trillion", "Trillionen"},
+	{"Trilliarde", "Trilliarden"},
+	{"Quadrillion", "Quadrillionen"},
+	{"Quadrilliarde", "Quadrilliarden"},
+	{"Quintillion", "Quintillionen"},
+	{"Quintilliarde", "Quintilliarden"},
+	{"Sextillion", "Sextillionen"},
+	{"Sextilliarde", "Sextilliarden"},
+	{"Septillion", "Septillionen"},
+	{"Septilliarde", "Septilliarden"},
+}
+
+// GroupsToWords converts a slice of groups to German words as if it were one whole number. Gender is ignored.
+// E.g. [1, 2, 3] => "eine Million zweitausenddrei", [-21] => "minus einundzwanzig".
+// Maximum number of groups is 16 (for Septilliarde).
+func (german) GroupsToWords(groups []int16, _ Gender) (string, error) {
+	if err := checkGroupCount(len(groups), len(deQuantifiers)); err != nil {
+		return "", err
+	}
+	groups, isNeg := splitNegGroups(groups)
+	if isAllZero(groups) {
+		return "null", nil
+	}
+
+	var words []string
+	if isNeg {
+		words = append(words, "minus")
+	}
+	// The thousands and the rest are combined into a single word.
+	var last string
+	for i, group := range groups {
+		groupID := len(groups) - 1 - i
+		val := int(group)
+		switch {
+		case val == 0:
+			continue
+		case groupID == 0:
+			last += deBelowThousand(val, true)
+		case groupID == 1:
+			last += deBelowThousand(val, false) + "tausend"
+		case val == 1:
+			// The quantifiers are feminine nouns.
+			words = append(words, "eine "+deQuantifiers[groupID][0])
+		default:
+			words = append(words, deBelowThousand(val, false)+" "+deQuantifiers[groupID][1])
+		}
+	}
+	if len(last) > 0 {
+		words = append(words, last)
+	}
+	return strings.Join(words, " "), nil
+}
+
+// deBelowThousand converts a number from 1 to 999 into a German word, e.g. 321 => "dreihunderteinundzwanzig".
+// If final is true, a trailing 1 is "eins", otherwise it's "ein" (e.g. before "tausend").
+func deBelowThousand(num int, final bool) string {
+	hundreds, rest := num/100, num%100
+	var rv string
+	if hundreds > 0 {
+		rv = deUnitPrefix(hundreds) + "hundert"
+	}
+	switch {
+	case rest == 0:
+	case rest == 1 && !final:
+		rv += "ein"
+	case rest < 20:
+		rv += deUnits[rest]
+	case rest%10 == 0:
+		rv += deTens[rest/10]
+	default:
+		rv += deUnitPrefix(rest%10) + "und" + deTens[rest/10]
+	}
+	return rv
+}
+
+// deUnitPrefix returns the German word for a number from 1 to 9 as used at the start of a larger word.
+// It's the same as the normal word, except 1 is "ein" instead of "eins".
+func deUnitPrefix(num int) string {
+	if num == 1 {
+		return "ein"
+	}
+	return deUnits[num]
+}
+
+// deIthSuffixes are the endings of German number words that have irregular sequence number forms.
+var deIthSuffixes = [][2]string{
+	{"eins", "erste"},
+	{"drei", "dritte"},
+	{"sieben", "siebte"},
+	{"acht", "achte"},
+}
+
+// WordsToIth converts the output of GroupsToWords to a German sequence number. Gender is ignored.
+// If it ends in a quantifier, it's all one word, e.g. "eine Million" => "einmillionste", "drei Milliarden" => "dreimilliardste".
+// Otherwise, like with the number words, only the part after the last quantifier changes,
+// e.g. "einundzwanzig" => "einundzwanzigste", "drei" => "dritte", "zwei Millionen eins" => "zwei Millionen erste".
+func (german) WordsToIth(val string, _ Gender) string {
+	if len(val) == 0 {
+		return ""
+	}
+	if rest, isNeg := strings.CutPrefix(val, "minus "); isNeg {
+		return "minus " + German.WordsToIth(rest, Masculine)
+	}
+
+	// The quantifiers are the only capitalized words, and they're always after a space.
+	i := strings.LastIndex(val, " ")
+	if last := val[i+1:]; last == strings.ToLower(last) {
+		return val[:i+1] + deIthWord(last)
+	}
+
+	// Only the first "eine" (before a quantifier) becomes "ein" since the others aren't possible.
+	rv := strings.ToLower(val)
+	if strings.HasPrefix(rv, "eine ") {
+		rv = "ein" + strings.TrimPrefix(rv, "eine")
+	}
+	return deIthWord(strings.ReplaceAll(rv, " ", ""))
+}
+
+// deIthWord converts a single (lower-case) German number word to a sequence number,
+// e.g. "einundzwanzig" => "einundzwanzigste", "drei" => "dritte", "zweimillionen" => "zweimillionste".
+func deIthWord(word string) string {
+	for _, sfx := range deIthSuffixes {
+		if strings.HasSuffix(word, sfx[0]) {
+			return strings.TrimSuffix(word, sfx[0]) + sfx[1]
+		}
+	}
+	for _, unit := range deUnits {
+		if strings.HasSuffix(word, unit) {
+			return word + "te"
+		}
+	}
+	// Everything else (e.g. "zwanzig", "hundert", "tausend", "millionen", "milliarde") gets "ste" on the singular form.
+	switch {
+	case strings.HasSuffix(word, "arde"):
+		word = strings.TrimSuffix(word, "e")
+	case strings.HasSuffix(word, "arden"), strings.HasSuffix(word, "onen"):
+		word = strings.TrimSuffix(word, "en")
+	}
+	return word + "ste"
+}
@@ -0,0 +1,55 @@
+package to_words
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGerman(t *testing.T) {
+	tests := []struct {
+		num    int
+		exp    string
+		expIth string
+	}{
+		{num: 0, exp: "null", expIth: "nullte"},
+		{num: 1, exp: "eins", expIth: "erste"},
+		{num: 3, exp: "drei", expIth: "dritte"},
+		{num: 7, exp: "sieben", expIth: "siebte"},
+		{num: 8, exp: "acht", expIth: "achte"},
+		{num: 19, exp: "neunzehn", expIth: "neunzehnte"},
+		{num: 20, exp: "zwanzig", expIth: "zwanzigste"},
+		{num: 21, exp: "einundzwanzig", expIth: "einundzwanzigste"},
+		{num: 100, exp: "einhundert", expIth: "einhundertste"},
+		{num: 101, exp: "einhunderteins", expIth: "einhunderterste"},
+		{num: 1001, exp: "eintausendeins", expIth: "eintausenderste"},
+		{num: 2345, exp: "zweitausenddreihundertfünfundvierzig", expIth: "zweitausenddreihundertfünfundvierzigste"},
+		{num: 1_000_000, exp: "eine Million", expIth: "einmillionste"},
+		{num: 2_000_000, exp: "zwei Millionen", expIth: "zweimillionste"},
+		{num: 1_000_001, exp: "eine Million eins", expIth: "eine Million erste"},
+		{num: 2_000_001, exp: "zwei Millionen eins", expIth: "zwei Millionen erste"},
+		{num: 2_000_020, exp: "zwei Millionen zwanzig", expIth: "zwei Millionen zwanzigste"},
+		{num: 3_001_000, exp: "drei Millionen eintausend", expIth: "drei Millionen eintausendste"},
+		{num: 1_000_000_000, exp: "eine Milliarde", expIth: "einmilliardste"},
+		{num: 3_000_000_000, exp: "drei Milliarden", expIth: "dreimilliardste"},
+		{num: -5, exp: "minus fünf", expIth: "minus fünfte"},
+		{num: -1_000_001, exp: "minus eine Million eins", expIth: "minus eine Million erste"},
+	}
+
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("%d", tc.num), func(t *testing.T) {
+			var act, actIth string
+			var err error
+			testFunc := func() {
+				act, err = German.GroupsToWords(IntToGroups(tc.num), Masculine)
+				actIth = German.WordsToIth(act, Masculine)
+			}
+			require.NotPanics(t, testFunc, "German %d", tc.num)
+			assert.NoError(t, err, "German.GroupsToWords(%d) error", tc.num)
+			assert.Equal(t, tc.exp, act, "German.GroupsToWords(%d) result", tc.num)
+			assert.Equal(t, tc.expIth, actIth, "German.WordsToIth(%q) result", act)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package to_words
+
+import "strings"
+
+// English is the (American) English language, which is what IntToWords, StringToWords, etc. use.
+// Gender is ignored.
+var English Language = english{}
+
+// BritishEnglish is the English language as written in the UK, which adds "and" after hundreds
+// and before a final group under one hundred, e.g. "one thousand and five".
+// Negative numbers start with "minus". Gender is ignored.
+var BritishEnglish Language = britishEnglish{}
+
+// english implements the Language interface for American English.
+type english struct{}
+
+var _ Language = english{}
+
+// Tag returns the language tag of this language: "en-US".
+func (english) Tag() string {
+	return "en-US"
+}
+
+// GroupsToWords converts a slice of groups to words as if it were one whole number. Gender is ignored.
+// This is the same as GroupsToWords.
+func (english) GroupsToWords(groups []int16, _ Gender) (string, error) {
+	return GroupsToWords(groups)
+}
+
+// WordsToIth converts the output of GroupsToWords to a sequence number. Gender is ignored.
+// This is the same as WordsToIth.
+func (english) WordsToIth(val string, _ Gender) string {
+	return WordsToIth(val)
+}
+
+// britishEnglish implements the Language interface for British English.
+type britishEnglish struct{}
+
+var _ Language = britishEnglish{}
+
+// Tag returns the language tag of this language: "en-GB".
+func (britishEnglish) Tag() string {
+	return "en-GB"
+}
+
+// GroupsToWords converts a slice of groups to words as if it were one whole number. Gender is ignored.
+// E.g. [1, 2, 3] => "one million two thousand and three", [-1, 105] => "minus one thousand one hundred and five".
+func (britishEnglish) GroupsToWords(groups []int16, _ Gender) (string, error) {
+	quants, err := GetQuantifiers(len(groups))
+	if err != nil {
+		return "", err
+	}
+	groups, isNeg := splitNegGroups(groups)
+
+	groupWords := make([]string, 0, len(groups)+1)
+	for i, group := range groups {
+		if group == 0 {
+			continue
+		}
+		// A final group under one hundred gets an "and" if there's anything before it, e.g. "one thousand and five".
+		if i == len(groups)-1 && group < 100 && len(groupWords) > 0 {
+			groupWords = append(groupWords, "and")
+		}
+		gw := britishBelowThousand(int(group))
+		if len(quants[i]) > 0 {
+			gw += " " + quants[i]
+		}
+		groupWords = append(groupWords, gw)
+	}
+	if len(groupWords) == 0 {
+		return "zero", nil
+	}
+	if isNeg {
+		return "minus " + strings.Join(groupWords, " "), nil
+	}
+	return strings.Join(groupWords, " "), nil
+}
+
+// britishBelowThousand converts a number from 0 to 999 into British English words, e.g. 105 => "one hundred and five".
+func britishBelowThousand(num int) string {
+	if num < 100 {
+		return IntToWords(num)
+	}
+	rv := IntToWords(num/100) + " hundred"
+	if num%100 != 0 {
+		rv += " and " + IntToWords(num%100)
+	}
+	return rv
+}
+
+// WordsToIth converts the output of GroupsToWords to a sequence number. Gender is ignored.
+// E.g. "one hundred and one" => "one hundred and first".
+func (britishEnglish) WordsToIth(val string, _ Gender) string {
+	return WordsToIth(val)
+}
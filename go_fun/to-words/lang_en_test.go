@@ -0,0 +1,72 @@
+package to_words
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnglish(t *testing.T) {
+	tests := []struct {
+		num    int
+		exp    string
+		expIth string
+	}{
+		{num: 0, exp: "zero", expIth: "zeroth"},
+		{num: 1, exp: "one", expIth: "first"},
+		{num: 21, exp: "twenty-one", expIth: "twenty-first"},
+		{num: 105, exp: "one hundred five", expIth: "one hundred fifth"},
+		{num: 1005, exp: "one thousand five", expIth: "one thousand fifth"},
+		{num: -12, exp: "negative twelve", expIth: "negative twelfth"},
+	}
+
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("%d", tc.num), func(t *testing.T) {
+			var act, actIth string
+			var err error
+			testFunc := func() {
+				act, err = English.GroupsToWords(IntToGroups(tc.num), Masculine)
+				actIth = English.WordsToIth(act, Masculine)
+			}
+			require.NotPanics(t, testFunc, "English %d", tc.num)
+			assert.NoError(t, err, "English.GroupsToWords(%d) error", tc.num)
+			assert.Equal(t, tc.exp, act, "English.GroupsToWords(%d) result", tc.num)
+			assert.Equal(t, tc.expIth, actIth, "English.WordsToIth(%q) result", act)
+		})
+	}
+}
+
+func TestBritishEnglish(t *testing.T) {
+	tests := []struct {
+		num    int
+		exp    string
+		expIth string
+	}{
+		{num: 0, exp: "zero", expIth: "zeroth"},
+		{num: 1, exp: "one", expIth: "first"},
+		{num: 21, exp: "twenty-one", expIth: "twenty-first"},
+		{num: 100, exp: "one hundred", expIth: "one hundredth"},
+		{num: 101, exp: "one hundred and one", expIth: "one hundred and first"},
+		{num: 1005, exp: "one thousand and five", expIth: "one thousand and fifth"},
+		{num: 1100, exp: "one thousand one hundred", expIth: "one thousand one hundredth"},
+		{num: 2_000_020, exp: "two million and twenty", expIth: "two million and twentieth"},
+		{num: -1105, exp: "minus one thousand one hundred and five", expIth: "minus one thousand one hundred and fifth"},
+	}
+
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("%d", tc.num), func(t *testing.T) {
+			var act, actIth string
+			var err error
+			testFunc := func() {
+				act, err = BritishEnglish.GroupsToWords(IntToGroups(tc.num), Masculine)
+				actIth = BritishEnglish.WordsToIth(act, Masculine)
+			}
+			require.NotPanics(t, testFunc, "BritishEnglish %d", tc.num)
+			assert.NoError(t, err, "BritishEnglish.GroupsToWords(%d) error", tc.num)
+			assert.Equal(t, tc.exp, act, "BritishEnglish.GroupsToWords(%d) result", tc.num)
+			assert.Equal(t, tc.expIth, actIth, "BritishEnglish.WordsToIth(%q) result", act)
+		})
+	}
+}
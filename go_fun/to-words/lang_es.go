@@ -0,0 +1,307 @@
+package to_words
+
+import (
+	"math"
+	"strings"
+)
+
+// Spanish is the Spanish language. It uses the long scale, e.g. "mil millones" for 10^9 and "un billón" for 10^12.
+// Gender affects "uno"/"una", the hundreds (e.g. "doscientos"/"doscientas"), and sequence numbers.
+// Negative numbers start with "menos".
+var Spanish Language = spanish{}
+
+// spanish implements the Language interface for Spanish.
+type spanish struct{}
+
+var _ Language = spanish{}
+
+// Tag returns the language tag of this language: "es".
+func (spanish) Tag() string {
+	return "es"
+}
+
+// esUnits are the Spanish words for 0 through 29 (masculine, as used when counting).
+var esUnits = []string{
+	"cero", "uno", "dos", "tres", "cuatro", "cinco", "seis", "siete", "ocho", "nueve",
+	"diez", "once", "doce", "trece", "catorce", "quince", "dieciséis", "diecisiete", "dieciocho", "diecinueve",
+	"veinte", "veintiuno", "veintidós", "veintitrés", "veinticuatro", "veinticinco", "veintiséis", "veintisiete", "veintiocho", "veintinueve",
+}
+
+// esTens are the Spanish words for the multiples of ten, indexed by the tens digit. Only 3 through 9 are used.
+var esTens = []string{"", "", "", "treinta", "cuarenta", "cincuenta", "sesenta", "setenta", "ochenta", "noventa"}
+
+// esHundreds are the Spanish words for the multiples of one hundred (masculine), indexed by the hundreds digit.
+// Note that 100 on its own is "cien"; "ciento" is only used when more follows it.
+var esHundreds = []string{"", "ciento", "doscientos", "trescientos", "cuatrocientos", "quinientos", "seiscientos", "setecientos", "ochocientos", "novecientos"}
+
+// esPeriods are the singular and plural quantifiers for each group of six digits (long scale).
+// The first entry is for the right-most six digits, so they're empty.
+var esPeriods = [][2]string{
+	{"", ""},
+	{"millón", "millones"},
+	{"billón", "billones"},
+	{"trillón", "trillones"},
+	{"cuatrillón", "cuatrillones"},
+	{"quintillón", "quintillones"},
+	{"sextillón", "sextillones"},
+	{"septillón", "septillones"},
+}
+
+// GroupsToWords converts a slice of groups to Spanish words as if it were one whole number.
+// E.g. [1, 2, 3] => "un millón dos mil tres", [-21] => "menos veintiuno", [21] (Feminine) => "veintiuna".
+// Maximum number of groups is 16 (for mil septillones).
+func (spanish) GroupsToWords(groups []int16, gender Gender) (string, error) {
+	if err := checkGroupCount(len(groups), len(esPeriods)*2); err != nil {
+		return "", err
+	}
+	groups, isNeg := splitNegGroups(groups)
+	if isAllZero(groups) {
+		return "cero", nil
+	}
+
+	// Spanish names every six digits (a period), so combine pairs of groups, starting from the right.
+	periodCount := (len(groups) + 1) / 2
+	periods := make([]int, periodCount)
+	for i, group := range groups {
+		groupID := len(groups) - 1 - i
+		if groupID%2 == 1 {
+			periods[groupID/2] += int(group) * 1000
+		} else {
+			periods[groupID/2] += int(group)
+		}
+	}
+
+	var words []string
+	if isNeg {
+		words = append(words, "menos")
+	}
+	for p := periodCount - 1; p >= 0; p-- {
+		val := periods[p]
+		switch {
+		case val == 0:
+			continue
+		case p == 0:
+			words = append(words, esBelowMillion(val, gender, false))
+		case val == 1:
+			words = append(words, "un "+esPeriods[p][0])
+		default:
+			// The quantifier nouns are masculine, so these use the shortened forms, e.g. "veintiún millones".
+			words = append(words, esBelowMillion(val, Masculine, true)+" "+esPeriods[p][1])
+		}
+	}
+	return strings.Join(words, " "), nil
+}
+
+// esBelowMillion converts a number from 1 to 999,999 into Spanish words, e.g. 21,500 => "veintiún mil quinientos".
+// If apocope is true, a trailing "uno" is shortened to "un" (e.g. before a noun).
+func esBelowMillion(num int, gender Gender, apocope bool) string {
+	thousands, rest := num/1000, num%1000
+	var words []string
+	switch {
+	case thousands == 1:
+		words = append(words, "mil")
+	case thousands > 1:
+		// "mil" is treated like a noun, so anything ending in "uno" is shortened.
+		words = append(words, esBelowThousand(thousands, gender, true)+" mil")
+	}
+	if rest > 0 {
+		words = append(words, esBelowThousand(rest, gender, apocope))
+	}
+	return strings.Join(words, " ")
+}
+
+// esBelowThousand converts a number from 1 to 999 into Spanish words, e.g. 121 => "ciento veintiuno".
+// If apocope is true, a trailing "uno" is shortened to "un" (e.g. before a noun).
+func esBelowThousand(num int, gender Gender, apocope bool) string {
+	if num == 100 {
+		return "cien"
+	}
+	hundreds, rest := num/100, num%100
+	var words []string
+	if hundreds > 0 {
+		word := esHundreds[hundreds]
+		if gender == Feminine {
+			word = strings.Replace(word, "ientos", "ientas", 1)
+		}
+		words = append(words, word)
+	}
+	switch {
+	case rest == 0:
+	case rest < 30:
+		words = append(words, esUnitWord(rest, gender, apocope))
+	case rest%10 == 0:
+		words = append(words, esTens[rest/10])
+	default:
+		words = append(words, esTens[rest/10], "y", esUnitWord(rest%10, gender, apocope))
+	}
+	return strings.Join(words, " ")
+}
+
+// esUnitWord returns the Spanish word for a number from 1 to 29 in the provided gender.
+// If apocope is true, and the gender is masculine, "uno" is shortened to "un", and "veintiuno" to "veintiún".
+func esUnitWord(num int, gender Gender, apocope bool) string {
+	rv := esUnits[num]
+	if num == 1 || num == 21 {
+		switch {
+		case gender == Feminine:
+			rv = strings.TrimSuffix(rv, "o") + "a"
+		case apocope && num == 1:
+			rv = "un"
+		case apocope:
+			rv = "veintiún"
+		}
+	}
+	return rv
+}
+
+// esOrdUnits are the Spanish sequence numbers for 1 through 19 (masculine), indexed by value.
+var esOrdUnits = []string{
+	"", "primero", "segundo", "tercero", "cuarto", "quinto", "sexto", "séptimo", "octavo", "noveno",
+	"décimo", "undécimo", "duodécimo", "decimotercero", "decimocuarto", "decimoquinto", "decimosexto", "decimoséptimo", "decimoctavo", "decimonoveno",
+}
+
+// esOrdTens are the Spanish sequence numbers for the multiples of ten (masculine), indexed by the tens digit.
+var esOrdTens = []string{
+	"", "décimo", "vigésimo", "trigésimo", "cuadragésimo", "quincuagésimo", "sexagésimo", "septuagésimo", "octogésimo", "nonagésimo",
+}
+
+// esOrdHundreds are the Spanish sequence numbers for the multiples of one hundred (masculine), indexed by the hundreds digit.
+var esOrdHundreds = []string{
+	"", "centésimo", "ducentésimo", "tricentésimo", "cuadringentésimo", "quingentésimo", "sexcentésimo", "septingentésimo", "octingentésimo", "noningentésimo",
+}
+
+// WordsToIth converts the output of GroupsToWords to a Spanish sequence number in the provided gender.
+// E.g. "veintiuno" => "vigésimo primero", "veintiuna" (Feminine) => "vigésima primera", "dos mil" => "dosmilésimo".
+// Spanish doesn't have a sequence number for zero, so "cero" stays "cero" (as in "el kilómetro cero") in either gender.
+// Values that aren't Spanish number words (or are too large) are returned unchanged.
+func (spanish) WordsToIth(val string, gender Gender) string {
+	num, isNeg, ok := esWordsToUint(val)
+	if !ok {
+		return val
+	}
+	if isNeg {
+		return "menos " + esIth(num, gender)
+	}
+	return esIth(num, gender)
+}
+
+// esIth converts the provided number into a Spanish sequence number.
+func esIth(num uint64, gender Gender) string {
+	if num == 0 {
+		// There isn't an ordinal for zero; the cardinal is used after the noun instead, e.g. "el piso cero".
+		return "cero"
+	}
+
+	// ord applies the gender to one of the (masculine) sequence number words.
+	ord := func(word string) string {
+		if gender == Feminine {
+			return strings.TrimSuffix(word, "o") + "a"
+		}
+		return word
+	}
+
+	var words []string
+	// Larger amounts have the multiplier in front. If the multiplier is a single word, they're written
+	// together (e.g. "dosmilésimo", "veintiunmilésimo"), otherwise they're separate (e.g. "treinta y dos milésimo").
+	addMultiple := func(count uint64, word string) {
+		switch {
+		case count == 0:
+		case count == 1:
+			words = append(words, ord(word))
+		default:
+			prefix := strings.Replace(esBelowMillion(int(count), Masculine, true), "veintiún", "veintiun", 1)
+			if strings.Contains(prefix, " ") {
+				words = append(words, prefix, ord(word))
+			} else {
+				words = append(words, prefix+ord(word))
+			}
+		}
+	}
+	addMultiple(num/1_000_000_000_000_000_000, "trillonésimo")
+	addMultiple(num/1_000_000_000_000%1_000_000, "billonésimo")
+	addMultiple(num/1_000_000%1_000_000, "millonésimo")
+	addMultiple(num/1000%1000, "milésimo")
+
+	rest := num % 1000
+	if rest >= 100 {
+		words = append(words, ord(esOrdHundreds[rest/100]))
+		rest %= 100
+	}
+	switch {
+	case rest == 0:
+	case rest < 20:
+		words = append(words, ord(esOrdUnits[rest]))
+	default:
+		words = append(words, ord(esOrdTens[rest/10]))
+		if rest%10 != 0 {
+			words = append(words, ord(esOrdUnits[rest%10]))
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// esWordValues are the values of the Spanish words that can appear in a number below one thousand.
+var esWordValues = func() map[string]int {
+	rv := map[string]int{"un": 1, "una": 1, "veintiún": 21, "veintiuna": 21, "cien": 100}
+	for i, word := range esUnits {
+		rv[word] = i
+	}
+	for i, word := range esTens {
+		if len(word) > 0 {
+			rv[word] = i * 10
+		}
+	}
+	for i, word := range esHundreds {
+		if len(word) > 0 {
+			rv[word] = i * 100
+			rv[strings.Replace(word, "ientos", "ientas", 1)] = i * 100
+		}
+	}
+	return rv
+}()
+
+// esWordsToUint converts the provided Spanish number words into an unsigned number and whether it's negative.
+// Returns ok = false if the words aren't Spanish number words, or the number doesn't fit in a uint64.
+func esWordsToUint(val string) (num uint64, isNeg bool, ok bool) {
+	words := strings.Fields(strings.ToLower(val))
+	if len(words) == 0 {
+		return 0, false, false
+	}
+	isNeg = words[0] == "menos"
+	if isNeg {
+		words = words[1:]
+	}
+
+	// small is the amount below one thousand, and period is the amount below one million.
+	var total, period, small uint64
+	for _, word := range words {
+		if word == "y" {
+			continue
+		}
+		if v, ok := esWordValues[word]; ok {
+			small += uint64(v)
+			continue
+		}
+		if word == "mil" {
+			period += max(small, 1) * 1000
+			small = 0
+			continue
+		}
+		found := false
+		for p := 1; p < len(esPeriods) && !found; p++ {
+			if word == esPeriods[p][0] || word == esPeriods[p][1] {
+				found = true
+				scale := uint64(math.Pow10(6 * p))
+				if p > 3 || (period+small) > math.MaxUint64/scale {
+					return 0, false, false
+				}
+				total += (period + small) * scale
+				period, small = 0, 0
+			}
+		}
+		if !found {
+			return 0, false, false
+		}
+	}
+	return total + period + small, isNeg, true
+}
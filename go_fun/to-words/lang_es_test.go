@@ -0,0 +1,70 @@
+package to_words
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpanish(t *testing.T) {
+	tests := []struct {
+		num    int
+		gender Gender
+		exp    string
+		expIth string
+	}{
+		{num: 0, exp: "cero", expIth: "cero"},
+		{num: 0, gender: Feminine, exp: "cero", expIth: "cero"},
+		{num: 1, exp: "uno", expIth: "primero"},
+		{num: 1, gender: Feminine, exp: "una", expIth: "primera"},
+		{num: 16, exp: "dieciséis", expIth: "decimosexto"},
+		{num: 21, exp: "veintiuno", expIth: "vigésimo primero"},
+		{num: 21, gender: Feminine, exp: "veintiuna", expIth: "vigésima primera"},
+		{num: 45, exp: "cuarenta y cinco", expIth: "cuadragésimo quinto"},
+		{num: 100, exp: "cien", expIth: "centésimo"},
+		{num: 101, exp: "ciento uno", expIth: "centésimo primero"},
+		{num: 200, gender: Feminine, exp: "doscientas", expIth: "ducentésima"},
+		{num: 1000, exp: "mil", expIth: "milésimo"},
+		{num: 2021, gender: Feminine, exp: "dos mil veintiuna", expIth: "dosmilésima vigésima primera"},
+		{num: 21_000, gender: Feminine, exp: "veintiuna mil", expIth: "veintiunmilésima"},
+		{num: 21_000, exp: "veintiún mil", expIth: "veintiunmilésimo"},
+		{num: 32_000, exp: "treinta y dos mil", expIth: "treinta y dos milésimo"},
+		{num: 1_000_000, exp: "un millón", expIth: "millonésimo"},
+		{num: 2_000_000, exp: "dos millones", expIth: "dosmillonésimo"},
+		{num: 21_000_000, exp: "veintiún millones", expIth: "veintiunmillonésimo"},
+		{num: 1_000_000_000, exp: "mil millones", expIth: "milmillonésimo"},
+		{num: 1_000_000_000_000, exp: "un billón", expIth: "billonésimo"},
+		{num: 2_500_000_000_000, exp: "dos billones quinientos mil millones", expIth: "dosbillonésimo quinientos mil millonésimo"},
+		{num: -3, exp: "menos tres", expIth: "menos tercero"},
+	}
+
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("%d %s", tc.num, tc.gender), func(t *testing.T) {
+			var act, actIth string
+			var err error
+			testFunc := func() {
+				act, err = Spanish.GroupsToWords(IntToGroups(tc.num), tc.gender)
+				actIth = Spanish.WordsToIth(act, tc.gender)
+			}
+			require.NotPanics(t, testFunc, "Spanish %d %s", tc.num, tc.gender)
+			assert.NoError(t, err, "Spanish.GroupsToWords(%d, %s) error", tc.num, tc.gender)
+			assert.Equal(t, tc.exp, act, "Spanish.GroupsToWords(%d, %s) result", tc.num, tc.gender)
+			assert.Equal(t, tc.expIth, actIth, "Spanish.WordsToIth(%q, %s) result", act, tc.gender)
+		})
+	}
+}
+
+func TestSpanish_WordsToIth_NotSpanish(t *testing.T) {
+	for _, val := range []string{"", "twenty", "veinte gatos"} {
+		t.Run(val, func(t *testing.T) {
+			var act string
+			testFunc := func() {
+				act = Spanish.WordsToIth(val, Masculine)
+			}
+			require.NotPanics(t, testFunc, "Spanish.WordsToIth(%q)", val)
+			assert.Equal(t, val, act, "Spanish.WordsToIth(%q) result", val)
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package to_words
+
+import "strings"
+
+// French is the French language. It uses the long scale, e.g. "un milliard" for 10^9 and "un billion" for 10^12.
+// Hyphens are used between tens and units (except with "et"), but not around "cent" or "mille".
+// Gender affects "un"/"une" and "premier"/"première". Negative numbers start with "moins".
+var French Language = french{}
+
+// french implements the Language interface for French.
+type french struct{}
+
+var _ Language = french{}
+
+// Tag returns the language tag of this language: "fr".
+func (french) Tag() string {
+	return "fr"
+}
+
+// frUnits are the French words for 0 through 16 (masculine).
+var frUnits = []string{
+	"zéro", "un", "deux", "trois", "quatre", "cinq", "six", "sept", "huit", "neuf",
+	"dix", "onze", "douze", "treize", "quatorze", "quinze", "seize",
+}
+
+// frTens are the French words for the multiples of ten, indexed by the tens digit. Only 2 through 6 are used.
+// The others are built from these, e.g. 70 = "soixante-dix", 80 = "quatre-vingts", 90 = "quatre-vingt-dix".
+var frTens = []string{"", "", "vingt", "trente", "quarante", "cinquante", "soixante"}
+
+// frQuantifiers are the singular and plural quantifiers for each group of three digits, indexed by groupID.
+var frQuantifiers = [][2]string{
+	{"", ""},
+	{"mille", "mille"},
+	{"million", "millions"},
+	{"milliard", "milliards"},
+	{"billion", "billions"},
+	{"billiard", "billiards"},
+	{"trillion", "trillions"},
+	{"trilliard", "trilliards"},
+	{"quadrillion", "quadrillions"},
+	{"quadrilliard", "quadrilliards"},
+	{"quintillion", "quintillions"},
+	{"quintilliard", "quintilliards"},
+	{"sextillion", "sextillions"},
+	{"sextilliard", "sextilliards"},
+	{"septillion", "septillions"},
+	{"septilliard", "septilliards"},
+}
+
+// GroupsToWords converts a slice of groups to French words as if it were one whole number.
+// E.g. [1, 2, 3] => "un million deux mille trois", [-71] => "moins soixante et onze", [21] (Feminine) => "vingt et une".
+// Maximum number of groups is 16 (for septilliard).
+func (french) GroupsToWords(groups []int16, gender Gender) (string, error) {
+	if err := checkGroupCount(len(groups), len(frQuantifiers)); err != nil {
+		return "", err
+	}
+	groups, isNeg := splitNegGroups(groups)
+	if isAllZero(groups) {
+		return "zéro", nil
+	}
+
+	var words []string
+	if isNeg {
+		words = append(words, "moins")
+	}
+	for i, group := range groups {
+		groupID := len(groups) - 1 - i
+		val := int(group)
+		switch {
+		case val == 0:
+			continue
+		case groupID == 0:
+			words = append(words, frBelowThousand(val, gender, true))
+		case groupID == 1 && val == 1:
+			// It's just "mille", not "un mille".
+			words = append(words, "mille")
+		case groupID == 1:
+			// "mille" is invariable and isn't a noun, so "cents" and "vingts" lose their "s" before it.
+			words = append(words, frBelowThousand(val, Masculine, false)+" mille")
+		case val == 1:
+			words = append(words, "un "+frQuantifiers[groupID][0])
+		default:
+			// The rest of the quantifiers are masculine nouns.
+			words = append(words, frBelowThousand(val, Masculine, true)+" "+frQuantifiers[groupID][1])
+		}
+	}
+	return strings.Join(words, " "), nil
+}
+
+// frBelowThousand converts a number from 1 to 999 into French words, e.g. 181 => "cent quatre-vingt-un".
+// If final is true, a trailing "cent" or "quatre-vingt" is made plural (e.g. "deux cents", "quatre-vingts").
+func frBelowThousand(num int, gender Gender, final bool) string {
+	hundreds, rest := num/100, num%100
+	var words []string
+	switch {
+	case hundreds == 1:
+		words = append(words, "cent")
+	case hundreds > 1 && rest == 0 && final:
+		words = append(words, frUnits[hundreds], "cents")
+	case hundreds > 1:
+		words = append(words, frUnits[hundreds], "cent")
+	}
+	if rest > 0 {
+		words = append(words, frBelowHundred(rest, gender, final))
+	}
+	return strings.Join(words, " ")
+}
+
+// frBelowHundred converts a number from 1 to 99 into French words, e.g. 71 => "soixante et onze".
+// If final is true, 80 is "quatre-vingts" (otherwise "quatre-vingt").
+func frBelowHundred(num int, gender Gender, final bool) string {
+	switch {
+	case num == 1 && gender == Feminine:
+		return "une"
+	case num <= 16:
+		return frUnits[num]
+	case num < 20:
+		return "dix-" + frUnits[num-10]
+	case num == 80 && final:
+		return "quatre-vingts"
+	}
+
+	tens, ones := num/10, num%10
+	// 70s and 90s count from 10 to 19 on top of 60 and 80.
+	if tens == 7 || tens == 9 {
+		tens--
+		ones += 10
+	}
+	base := "quatre-vingt"
+	if tens < 8 {
+		base = frTens[tens]
+	}
+	switch {
+	case ones == 0:
+		return base
+	case (ones == 1 || ones == 11) && tens != 8:
+		// 21, 31, 41, 51, 61, and 71 use "et", but 81 and 91 do not.
+		return base + " et " + frBelowHundred(ones, gender, final)
+	}
+	return base + "-" + frBelowHundred(ones, gender, final)
+}
+
+// WordsToIth converts the output of GroupsToWords to a French sequence number.
+// E.g. "un" => "premier", "une" (Feminine) => "première", "vingt et un" => "vingt et unième", "cinq" => "cinquième",
+// "un million" => "millionième", "zéro" => "zéroième".
+// Gender only affects "premier"/"première".
+func (french) WordsToIth(val string, gender Gender) string {
+	if len(val) == 0 {
+		return ""
+	}
+	if rest, isNeg := strings.CutPrefix(val, "moins "); isNeg {
+		return "moins " + French.WordsToIth(rest, gender)
+	}
+	if val == "zéro" {
+		// Not in every dictionary, but "zéroième" is the form used (e.g. in math) and it follows the normal rule.
+		return "zéroième"
+	}
+	if val == "un" || val == "une" {
+		if gender == Feminine {
+			return "première"
+		}
+		return "premier"
+	}
+	// A lone quantifier doesn't keep the "un", e.g. "un million" => "millionième".
+	if quant, ok := strings.CutPrefix(val, "un "); ok && !strings.Contains(quant, " ") {
+		val = quant
+	}
+
+	// Only the last word changes, e.g. "quatre-vingt-dix" => "quatre-vingt-dixième".
+	i := strings.LastIndexAny(val, " -")
+	lead, last := val[:i+1], val[i+1:]
+	switch last {
+	case "une":
+		last = "un"
+	case "cinq":
+		last = "cinqu"
+	case "neuf":
+		last = "neuv"
+	case "trois":
+	default:
+		// Plurals are dropped (e.g. "cents", "vingts", "millions"), as is a trailing "e" (e.g. "quatre", "mille").
+		last = strings.TrimSuffix(strings.TrimSuffix(last, "s"), "e")
+	}
+	return lead + last + "ième"
+}
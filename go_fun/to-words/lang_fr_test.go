@@ -0,0 +1,58 @@
+package to_words
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFrench(t *testing.T) {
+	tests := []struct {
+		num    int
+		gender Gender
+		exp    string
+		expIth string
+	}{
+		{num: 0, exp: "zéro", expIth: "zéroième"},
+		{num: 0, gender: Feminine, exp: "zéro", expIth: "zéroième"},
+		{num: 1, exp: "un", expIth: "premier"},
+		{num: 1, gender: Feminine, exp: "une", expIth: "première"},
+		{num: 3, exp: "trois", expIth: "troisième"},
+		{num: 5, exp: "cinq", expIth: "cinquième"},
+		{num: 9, exp: "neuf", expIth: "neuvième"},
+		{num: 17, exp: "dix-sept", expIth: "dix-septième"},
+		{num: 21, exp: "vingt et un", expIth: "vingt et unième"},
+		{num: 21, gender: Feminine, exp: "vingt et une", expIth: "vingt et unième"},
+		{num: 71, exp: "soixante et onze", expIth: "soixante et onzième"},
+		{num: 80, exp: "quatre-vingts", expIth: "quatre-vingtième"},
+		{num: 81, exp: "quatre-vingt-un", expIth: "quatre-vingt-unième"},
+		{num: 91, exp: "quatre-vingt-onze", expIth: "quatre-vingt-onzième"},
+		{num: 99, exp: "quatre-vingt-dix-neuf", expIth: "quatre-vingt-dix-neuvième"},
+		{num: 200, exp: "deux cents", expIth: "deux centième"},
+		{num: 280, exp: "deux cent quatre-vingts", expIth: "deux cent quatre-vingtième"},
+		{num: 1000, exp: "mille", expIth: "millième"},
+		{num: 21_000, exp: "vingt et un mille", expIth: "vingt et un millième"},
+		{num: 200_000, exp: "deux cent mille", expIth: "deux cent millième"},
+		{num: 1_000_000, exp: "un million", expIth: "millionième"},
+		{num: 2_000_000, exp: "deux millions", expIth: "deux millionième"},
+		{num: 1_000_000_000, exp: "un milliard", expIth: "milliardième"},
+		{num: -4, exp: "moins quatre", expIth: "moins quatrième"},
+	}
+
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("%d %s", tc.num, tc.gender), func(t *testing.T) {
+			var act, actIth string
+			var err error
+			testFunc := func() {
+				act, err = French.GroupsToWords(IntToGroups(tc.num), tc.gender)
+				actIth = French.WordsToIth(act, tc.gender)
+			}
+			require.NotPanics(t, testFunc, "French %d %s", tc.num, tc.gender)
+			assert.NoError(t, err, "French.GroupsToWords(%d, %s) error", tc.num, tc.gender)
+			assert.Equal(t, tc.exp, act, "French.GroupsToWords(%d, %s) result", tc.num, tc.gender)
+			assert.Equal(t, tc.expIth, actIth, "French.WordsToIth(%q, %s) result", act, tc.gender)
+		})
+	}
+}
@@ -0,0 +1,193 @@
+package to_words
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Gender identifies the grammatical gender to use for the words of a number.
+// Languages without gendered number words ignore it.
+type Gender int
+
+const (
+	// Masculine is the default gender, e.g. "uno" in Spanish, "un" in French.
+	Masculine Gender = iota
+	// Feminine is the feminine gender, e.g. "una" in Spanish, "une" in French.
+	Feminine
+)
+
+// String returns the name of this gender.
+func (g Gender) String() string {
+	switch g {
+	case Masculine:
+		return "masculine"
+	case Feminine:
+		return "feminine"
+	}
+	return fmt.Sprintf("Gender(%d)", int(g))
+}
+
+// Language defines how numbers are converted to words in a specific language.
+type Language interface {
+	// Tag returns the language tag of this language, e.g. "en-US".
+	Tag() string
+	// GroupsToWords converts a slice of groups to words as if it were one whole number.
+	// The groups are the same as those used by GroupsToWords, e.g. [1, 2, 3] for 1,002,003.
+	// Returns an error if there are zero groups or more groups than the language knows how to name.
+	GroupsToWords(groups []int16, gender Gender) (string, error)
+	// WordsToIth converts the output of GroupsToWords (with the same gender) to a sequence number.
+	WordsToIth(val string, gender Gender) string
+}
+
+// Languages are all the known languages, keyed by lower-case language tag.
+// Tags without a region (e.g. "en") are also included for convenience.
+var Languages = map[string]Language{
+	"en":    English,
+	"en-us": English,
+	"en-gb": BritishEnglish,
+	"es":    Spanish,
+	"fr":    French,
+	"de":    German,
+}
+
+// GetLanguage gets the Language with the provided language tag (case insensitive), e.g. "en-GB".
+// If the tag has a region that isn't known, the language without the region is used, e.g. "es-MX" => Spanish.
+// Returns an error if there isn't a known language for the provided tag.
+// See also: MustGetLanguage.
+func GetLanguage(tag string) (Language, error) {
+	key := strings.ToLower(strings.ReplaceAll(tag, "_", "-"))
+	if rv, ok := Languages[key]; ok {
+		return rv, nil
+	}
+	if base, _, hasRegion := strings.Cut(key, "-"); hasRegion {
+		if rv, ok := Languages[base]; ok {
+			return rv, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown language %q", tag)
+}
+
+// MustGetLanguage gets the Language with the provided language tag (case insensitive), e.g. "en-GB".
+// If the tag has a region that isn't known, the language without the region is used, e.g. "es-MX" => Spanish.
+// Panics if there isn't a known language for the provided tag.
+// See also: GetLanguage.
+func MustGetLanguage(tag string) Language {
+	rv, err := GetLanguage(tag)
+	if err != nil {
+		panic(err)
+	}
+	return rv
+}
+
+// IntToWordsIn converts the provided number into words in the provided language.
+//
+// Examples:
+//   - English, 21 => "twenty-one"
+//   - BritishEnglish, 105 => "one hundred and five"
+//   - Spanish, 21, Feminine => "veintiuna"
+//   - French, 80 => "quatre-vingts"
+//   - German, 21 => "einundzwanzig"
+//
+// See also: StringToWordsIn, IntToWords.
+func IntToWordsIn(lang Language, num int, gender Gender) string {
+	// We know GroupsToWords won't return an error because there's no way an int has too many groups.
+	rv, _ := lang.GroupsToWords(IntToGroups(num), gender)
+	return rv
+}
+
+// StringToWordsIn converts the provided number (in string form) into words in the provided language.
+//
+// Examples:
+//   - English, "1234" => "one thousand two hundred thirty-four"
+//   - BritishEnglish, "1005" => "one thousand and five"
+//   - Spanish, "2000000" => "dos millones"
+//   - French, "-71" => "moins soixante et onze"
+//   - German, "1000000" => "eine Million"
+//
+// Returns an error if the provided string is not a number.
+// See also: MustStringToWordsIn, StringToWords.
+func StringToWordsIn(lang Language, str string, gender Gender) (string, error) {
+	groups, err := StringToGroups(str)
+	if err != nil {
+		return "", err
+	}
+	rv, err := lang.GroupsToWords(groups, gender)
+	if err != nil {
+		return "", fmt.Errorf("could not convert %q to %s words: %w", str, lang.Tag(), err)
+	}
+	return rv, nil
+}
+
+// MustStringToWordsIn converts the provided number (in string form) into words in the provided language.
+//
+// Examples:
+//   - English, "1234" => "one thousand two hundred thirty-four"
+//   - BritishEnglish, "1005" => "one thousand and five"
+//   - Spanish, "2000000" => "dos millones"
+//   - French, "-71" => "moins soixante et onze"
+//   - German, "1000000" => "eine Million"
+//
+// Panics if the provided string is not a number.
+// See also: StringToWordsIn.
+func MustStringToWordsIn(lang Language, str string, gender Gender) string {
+	rv, err := StringToWordsIn(lang, str, gender)
+	if err != nil {
+		panic(err)
+	}
+	return rv
+}
+
+// IthWordsIn converts the provided number to words in the provided language and makes it a sequence number.
+//
+// Examples:
+//   - English, 32 => "thirty-second"
+//   - Spanish, 21, Feminine => "vigésima primera"
+//   - French, 1, Feminine => "première"
+//   - German, 3 => "dritte"
+//
+// See also: WordsToIthIn, IthWords.
+func IthWordsIn(lang Language, n int, gender Gender) string {
+	return lang.WordsToIth(IntToWordsIn(lang, n, gender), gender)
+}
+
+// WordsToIthIn converts the output of IntToWordsIn or StringToWordsIn to a sequence number in the provided language.
+//
+// Examples:
+//   - English, "thirty-two" => "thirty-second"
+//   - Spanish, "veintiuna", Feminine => "vigésima primera"
+//   - French, "vingt et un" => "vingt et unième"
+//   - German, "einundzwanzig" => "einundzwanzigste"
+//
+// See also: IthWordsIn, WordsToIth.
+func WordsToIthIn(lang Language, val string, gender Gender) string {
+	return lang.WordsToIth(val, gender)
+}
+
+// checkGroupCount returns an error if the groupCount is negative or more than the provided maximum.
+func checkGroupCount(groupCount, maxGroups int) error {
+	if groupCount <= 0 || groupCount > maxGroups {
+		return fmt.Errorf("cannot get quantifiers for %d groups: must be between 1 and %d", groupCount, maxGroups)
+	}
+	return nil
+}
+
+// splitNegGroups returns a copy of the provided groups with the first one made positive, and whether it was negative.
+func splitNegGroups(groups []int16) ([]int16, bool) {
+	rv := make([]int16, len(groups))
+	copy(rv, groups)
+	if len(rv) > 0 && rv[0] < 0 {
+		rv[0] = -rv[0]
+		return rv, true
+	}
+	return rv, false
+}
+
+// isAllZero returns true if all the provided groups are zero.
+func isAllZero(groups []int16) bool {
+	for _, group := range groups {
+		if group != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,260 @@
+package to_words
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGender_String(t *testing.T) {
+	assert.Equal(t, "masculine", Masculine.String(), "Masculine.String()")
+	assert.Equal(t, "feminine", Feminine.String(), "Feminine.String()")
+	assert.Equal(t, "Gender(5)", Gender(5).String(), "Gender(5).String()")
+}
+
+func TestGetLanguage(t *testing.T) {
+	tests := []struct {
+		tag    string
+		exp    Language
+		expErr string
+	}{
+		{tag: "", expErr: "unknown language \"\""},
+		{tag: "xx", expErr: "unknown language \"xx\""},
+		{tag: "xx-GB", expErr: "unknown language \"xx-GB\""},
+		{tag: "en", exp: English},
+		{tag: "en-US", exp: English},
+		{tag: "en_us", exp: English},
+		{tag: "en-AU", exp: English},
+		{tag: "en-GB", exp: BritishEnglish},
+		{tag: "EN-gb", exp: BritishEnglish},
+		{tag: "es", exp: Spanish},
+		{tag: "es-MX", exp: Spanish},
+		{tag: "fr", exp: French},
+		{tag: "fr-CA", exp: French},
+		{tag: "de", exp: German},
+		{tag: "DE-at", exp: German},
+	}
+
+	for _, tc := range tests {
+		t.Run("normal: "+tc.tag, func(t *testing.T) {
+			var act Language
+			var err error
+			testFunc := func() {
+				act, err = GetLanguage(tc.tag)
+			}
+			require.NotPanics(t, testFunc, "GetLanguage(%q)", tc.tag)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "GetLanguage(%q) error", tc.tag)
+			} else {
+				assert.NoError(t, err, "GetLanguage(%q) error", tc.tag)
+			}
+			assert.Equal(t, tc.exp, act, "GetLanguage(%q) result", tc.tag)
+		})
+
+		t.Run("must: "+tc.tag, func(t *testing.T) {
+			var act Language
+			testFunc := func() {
+				act = MustGetLanguage(tc.tag)
+			}
+			if len(tc.expErr) > 0 {
+				require.PanicsWithError(t, tc.expErr, testFunc, "MustGetLanguage(%q)", tc.tag)
+			} else {
+				require.NotPanics(t, testFunc, "MustGetLanguage(%q)", tc.tag)
+			}
+			assert.Equal(t, tc.exp, act, "MustGetLanguage(%q) result", tc.tag)
+		})
+	}
+}
+
+func TestLanguages(t *testing.T) {
+	for key, lang := range Languages {
+		t.Run(key, func(t *testing.T) {
+			act, err := GetLanguage(lang.Tag())
+			require.NoError(t, err, "GetLanguage(%q) error", lang.Tag())
+			assert.Equal(t, lang, act, "GetLanguage(%q) result", lang.Tag())
+		})
+	}
+}
+
+func TestIntToWordsIn(t *testing.T) {
+	tests := []struct {
+		lang   Language
+		num    int
+		gender Gender
+		exp    string
+	}{
+		{lang: English, num: 21, exp: "twenty-one"},
+		{lang: English, num: 21, gender: Feminine, exp: "twenty-one"},
+		{lang: BritishEnglish, num: 105, exp: "one hundred and five"},
+		{lang: Spanish, num: 21, exp: "veintiuno"},
+		{lang: Spanish, num: 21, gender: Feminine, exp: "veintiuna"},
+		{lang: French, num: 80, exp: "quatre-vingts"},
+		{lang: French, num: 1, gender: Feminine, exp: "une"},
+		{lang: German, num: 21, exp: "einundzwanzig"},
+		{lang: German, num: 21, gender: Feminine, exp: "einundzwanzig"},
+	}
+
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("%s %d %s", tc.lang.Tag(), tc.num, tc.gender), func(t *testing.T) {
+			var act string
+			testFunc := func() {
+				act = IntToWordsIn(tc.lang, tc.num, tc.gender)
+			}
+			require.NotPanics(t, testFunc, "IntToWordsIn(%s, %d, %s)", tc.lang.Tag(), tc.num, tc.gender)
+			assert.Equal(t, tc.exp, act, "IntToWordsIn(%s, %d, %s) result", tc.lang.Tag(), tc.num, tc.gender)
+		})
+	}
+}
+
+func TestStringToWordsIn(t *testing.T) {
+	tests := []struct {
+		name   string
+		lang   Language
+		str    string
+		gender Gender
+		exp    string
+		expErr string
+	}{
+		{
+			name:   "not a number",
+			lang:   Spanish,
+			str:    "nope",
+			expErr: "cannot split \"nope\" into groups: not a number",
+		},
+		{
+			name:   "too many groups",
+			lang:   French,
+			str:    "1" + fmt.Sprintf("%048d", 0),
+			expErr: "could not convert \"1" + fmt.Sprintf("%048d", 0) + "\" to fr words: cannot get quantifiers for 17 groups: must be between 1 and 16",
+		},
+		{
+			name: "english",
+			lang: English,
+			str:  "1234",
+			exp:  "one thousand two hundred thirty-four",
+		},
+		{
+			name: "british english",
+			lang: BritishEnglish,
+			str:  "1005",
+			exp:  "one thousand and five",
+		},
+		{
+			name: "spanish",
+			lang: Spanish,
+			str:  "2000000",
+			exp:  "dos millones",
+		},
+		{
+			name:   "spanish feminine",
+			lang:   Spanish,
+			str:    "201",
+			gender: Feminine,
+			exp:    "doscientas una",
+		},
+		{
+			name: "french",
+			lang: French,
+			str:  "-71",
+			exp:  "moins soixante et onze",
+		},
+		{
+			name: "german",
+			lang: German,
+			str:  "1000000",
+			exp:  "eine Million",
+		},
+		{
+			name: "german max groups",
+			lang: German,
+			str:  "1" + fmt.Sprintf("%045d", 0),
+			exp:  "eine Septilliarde",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run("normal: "+tc.name, func(t *testing.T) {
+			var act string
+			var err error
+			testFunc := func() {
+				act, err = StringToWordsIn(tc.lang, tc.str, tc.gender)
+			}
+			require.NotPanics(t, testFunc, "StringToWordsIn(%s, %q, %s)", tc.lang.Tag(), tc.str, tc.gender)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "StringToWordsIn(%s, %q, %s) error", tc.lang.Tag(), tc.str, tc.gender)
+			} else {
+				assert.NoError(t, err, "StringToWordsIn(%s, %q, %s) error", tc.lang.Tag(), tc.str, tc.gender)
+			}
+			assert.Equal(t, tc.exp, act, "StringToWordsIn(%s, %q, %s) result", tc.lang.Tag(), tc.str, tc.gender)
+		})
+
+		t.Run("must: "+tc.name, func(t *testing.T) {
+			var act string
+			testFunc := func() {
+				act = MustStringToWordsIn(tc.lang, tc.str, tc.gender)
+			}
+			if len(tc.expErr) > 0 {
+				require.PanicsWithError(t, tc.expErr, testFunc, "MustStringToWordsIn(%s, %q, %s)", tc.lang.Tag(), tc.str, tc.gender)
+			} else {
+				require.NotPanics(t, testFunc, "MustStringToWordsIn(%s, %q, %s)", tc.lang.Tag(), tc.str, tc.gender)
+			}
+			assert.Equal(t, tc.exp, act, "MustStringToWordsIn(%s, %q, %s) result", tc.lang.Tag(), tc.str, tc.gender)
+		})
+	}
+}
+
+func TestIthWordsIn(t *testing.T) {
+	tests := []struct {
+		lang   Language
+		num    int
+		gender Gender
+		exp    string
+	}{
+		{lang: English, num: 32, exp: "thirty-second"},
+		{lang: BritishEnglish, num: 101, exp: "one hundred and first"},
+		{lang: Spanish, num: 21, exp: "vigésimo primero"},
+		{lang: Spanish, num: 21, gender: Feminine, exp: "vigésima primera"},
+		{lang: French, num: 1, exp: "premier"},
+		{lang: French, num: 1, gender: Feminine, exp: "première"},
+		{lang: French, num: 21, gender: Feminine, exp: "vingt et unième"},
+		{lang: German, num: 3, exp: "dritte"},
+	}
+
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("%s %d %s", tc.lang.Tag(), tc.num, tc.gender), func(t *testing.T) {
+			var act string
+			testFunc := func() {
+				act = IthWordsIn(tc.lang, tc.num, tc.gender)
+			}
+			require.NotPanics(t, testFunc, "IthWordsIn(%s, %d, %s)", tc.lang.Tag(), tc.num, tc.gender)
+			assert.Equal(t, tc.exp, act, "IthWordsIn(%s, %d, %s) result", tc.lang.Tag(), tc.num, tc.gender)
+		})
+	}
+}
+
+func TestWordsToIthIn(t *testing.T) {
+	tests := []struct {
+		lang   Language
+		val    string
+		gender Gender
+		exp    string
+	}{
+		{lang: English, val: "thirty-two", exp: "thirty-second"},
+		{lang: Spanish, val: "veintiuna", gender: Feminine, exp: "vigésima primera"},
+		{lang: French, val: "vingt et un", exp: "vingt et unième"},
+		{lang: German, val: "einundzwanzig", exp: "einundzwanzigste"},
+	}
+
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("%s %s %s", tc.lang.Tag(), tc.val, tc.gender), func(t *testing.T) {
+			var act string
+			testFunc := func() {
+				act = WordsToIthIn(tc.lang, tc.val, tc.gender)
+			}
+			require.NotPanics(t, testFunc, "WordsToIthIn(%s, %q, %s)", tc.lang.Tag(), tc.val, tc.gender)
+			assert.Equal(t, tc.exp, act, "WordsToIthIn(%s, %q, %s) result", tc.lang.Tag(), tc.val, tc.gender)
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package to_words
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// rewriteRx matches the things in free text that RewriteNumbers replaces.
+// The alternatives are in priority order: scientific notation, ordinals, comma-grouped numbers, then plain numbers.
+//
+// Match groups:
+//
+//  1. Scientific notation, e.g. "1.5e10", "3x10^4".
+//  2. An ordinal, e.g. "21st" or "1,000,000th".
+//  3. The number of the ordinal, e.g. "21" or "1,000,000".
+//  4. A number with comma separators, e.g. "1,234,567.89".
+//  5. A plain number, e.g. "1234.5".
+var rewriteRx = regexp.MustCompile(`-?` +
+	`(?:` +
+	`\b([[:digit:]]+(?:\.[[:digit:]]+)?(?:[eE]|[*x]10(?:\^|\*\*))-?[[:digit:]]+(?:\.[[:digit:]]+)?)\b` +
+	`|\b(([[:digit:]]{1,3}(?:,[[:digit:]]{3})+|[[:digit:]]+)(?:st|nd|rd|th|ST|ND|RD|TH))\b` +
+	`|\b([[:digit:]]{1,3}(?:,[[:digit:]]{3})+(?:\.[[:digit:]]+)?)\b` +
+	`|\b([[:digit:]]+(?:\.[[:digit:]]+)?)\b` +
+	`)`)
+
+// RewriteNumbers finds the numbers in the provided text and replaces them with their spoken forms.
+// Whole numbers, decimals (read using the provided style), comma-grouped numbers, ordinals (e.g. "21st"),
+// and scientific notation (as matched by ScientificToSpoken) are all rewritten.
+// A "-" is only treated as a negative sign if it isn't directly after a letter or digit, e.g. in "3-4", it's left alone.
+// Ordinals with the wrong suffix (e.g. "21th") are left alone.
+// Dotted tokens with more than one dot (e.g. versions like "v1.2.3" or IPs) are left alone too.
+//
+// Examples:
+//   - "I have 3 cats." => "I have three cats."
+//   - "The 21st century" => "The twenty-first century"
+//   - "It cost 1,234.50 today", PlaceValueStyle => "It cost one thousand two hundred thirty-four and fifty hundredths today"
+//   - "About 6.02e23 atoms" => "About six point zero two times ten to the twenty-three atoms"
+//   - "From -5 to 3-4" => "From negative five to three-four"
+//
+// Returns an error if the style is unknown.
+// See also: MustRewriteNumbers, StringToSpoken, FloatToSpokenStyle.
+func RewriteNumbers(text string, style SpokenStyle) (string, error) {
+	if err := style.validate(); err != nil {
+		return "", err
+	}
+	var sb strings.Builder
+	last := 0
+	for _, loc := range rewriteRx.FindAllStringSubmatchIndex(text, -1) {
+		start, end := loc[0], loc[1]
+		if start < last || isPartOfDottedToken(text, start, end) {
+			continue
+		}
+		neg := text[start] == '-'
+		if neg && start > 0 {
+			if prev, _ := utf8.DecodeLastRuneInString(text[:start]); unicode.IsLetter(prev) || unicode.IsDigit(prev) {
+				// It's something like "3-4" or "A-1", so the "-" isn't a negative sign.
+				neg = false
+				start++
+			}
+		}
+
+		var words string
+		var err error
+		switch {
+		case loc[2] >= 0:
+			words, err = ScientificToSpoken(text[loc[2]:loc[3]])
+		case loc[4] >= 0:
+			num := strings.ReplaceAll(text[loc[6]:loc[7]], ",", "")
+			if !strings.EqualFold(text[loc[6]:loc[7]]+ithSuffix(num), text[loc[4]:loc[5]]) {
+				continue
+			}
+			words, err = StringToWords(num)
+			words = WordsToIth(words)
+		case loc[8] >= 0:
+			words, err = FloatToSpokenStyle(strings.ReplaceAll(text[loc[8]:loc[9]], ",", ""), style)
+		default:
+			words, err = FloatToSpokenStyle(text[loc[10]:loc[11]], style)
+		}
+		if err != nil {
+			return "", fmt.Errorf("could not rewrite %q: %w", text[start:end], err)
+		}
+		if neg {
+			words = "negative " + words
+		}
+
+		sb.WriteString(text[last:start])
+		sb.WriteString(words)
+		last = end
+	}
+	sb.WriteString(text[last:])
+	return sb.String(), nil
+}
+
+// isPartOfDottedToken returns true if the text[start:end] match is directly after a "<letter or digit>." or directly before a ".<digit>".
+// That's where a match is only part of something like "v1.2.3" or "10.0.0.1", which shouldn't be rewritten.
+func isPartOfDottedToken(text string, start, end int) bool {
+	if start > 1 && text[start-1] == '.' {
+		if prev, _ := utf8.DecodeLastRuneInString(text[:start-1]); unicode.IsLetter(prev) || unicode.IsDigit(prev) {
+			return true
+		}
+	}
+	return end+1 < len(text) && text[end] == '.' && text[end+1] >= '0' && text[end+1] <= '9'
+}
+
+// MustRewriteNumbers finds the numbers in the provided text and replaces them with their spoken forms.
+// Whole numbers, decimals (read using the provided style), comma-grouped numbers, ordinals (e.g. "21st"),
+// and scientific notation (as matched by ScientificToSpoken) are all rewritten.
+// A "-" is only treated as a negative sign if it isn't directly after a letter or digit, e.g. in "3-4", it's left alone.
+// Ordinals with the wrong suffix (e.g. "21th") are left alone.
+// Dotted tokens with more than one dot (e.g. versions like "v1.2.3" or IPs) are left alone too.
+//
+// Examples:
+//   - "I have 3 cats." => "I have three cats."
+//   - "The 21st century" => "The twenty-first century"
+//   - "It cost 1,234.50 today", PlaceValueStyle => "It cost one thousand two hundred thirty-four and fifty hundredths today"
+//   - "About 6.02e23 atoms" => "About six point zero two times ten to the twenty-three atoms"
+//   - "From -5 to 3-4" => "From negative five to three-four"
+//
+// Panics if the style is unknown.
+// See also: RewriteNumbers.
+func MustRewriteNumbers(text string, style SpokenStyle) string {
+	rv, err := RewriteNumbers(text, style)
+	if err != nil {
+		panic(err)
+	}
+	return rv
+}
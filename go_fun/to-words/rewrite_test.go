@@ -0,0 +1,80 @@
+package to_words
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewriteNumbers(t *testing.T) {
+	tests := []struct {
+		name   string
+		text   string
+		style  SpokenStyle
+		exp    string
+		expErr string
+	}{
+		{name: "unknown style", text: "1", style: SpokenStyle(5), expErr: "unknown spoken style SpokenStyle(5)"},
+		{name: "empty", text: "", exp: ""},
+		{name: "no numbers", text: "Nothing to see here.", exp: "Nothing to see here."},
+		{name: "just a number", text: "42", exp: "forty-two"},
+		{name: "whole number", text: "I have 3 cats.", exp: "I have three cats."},
+		{name: "several numbers", text: "1 2 3", exp: "one two three"},
+		{name: "negative", text: "It was -5 outside", exp: "It was negative five outside"},
+		{name: "negative at start", text: "-12 degrees", exp: "negative twelve degrees"},
+		{name: "negative in parens", text: "(-7)", exp: "(negative seven)"},
+		{name: "range", text: "pages 3-4", exp: "pages three-four"},
+		{name: "letter dash number", text: "Model A-1", exp: "Model A-one"},
+		{name: "in a word", text: "abc123 and 123abc", exp: "abc123 and 123abc"},
+		{name: "decimal digits", text: "Pi is 3.14.", exp: "Pi is three point one four."},
+		{name: "decimal place value", text: "Pi is 3.14.", style: PlaceValueStyle, exp: "Pi is three and fourteen hundredths."},
+		{name: "decimal fraction", text: "It took 2.5 hours", style: FractionStyle, exp: "It took two and a half hours"},
+		{name: "commas", text: "About 1,234,567 people", exp: "About one million two hundred thirty-four thousand five hundred sixty-seven people"},
+		{name: "commas with decimal", text: "$1,234.50", exp: "$one thousand two hundred thirty-four point five zero"},
+		{name: "list with commas", text: "1,2,3", exp: "one,two,three"},
+		{name: "ordinals", text: "The 1st, 2nd, 3rd and 4th.", exp: "The first, second, third and fourth."},
+		{name: "big ordinal", text: "our 21st birthday", exp: "our twenty-first birthday"},
+		{name: "teen ordinals", text: "11th 12th 13th", exp: "eleventh twelfth thirteenth"},
+		{name: "upper case ordinal", text: "THE 22ND", exp: "THE twenty-second"},
+		{name: "comma ordinal", text: "The 1,000,000th visitor", exp: "The one millionth visitor"},
+		{name: "comma ordinal wrong suffix", text: "The 1,000,001th visitor", exp: "The 1,000,001th visitor"},
+		{name: "version", text: "Upgrade to v1.2.3 now", exp: "Upgrade to v1.2.3 now"},
+		{name: "ip address", text: "Ping 10.0.0.1.", exp: "Ping 10.0.0.1."},
+		{name: "wrong ordinal suffix", text: "the 21th and 2st", exp: "the 21th and 2st"},
+		{name: "scientific e", text: "About 6.02e23 atoms", exp: "About six point zero two times ten to the twenty-three atoms"},
+		{name: "scientific x10", text: "got 3x10^4 hits", exp: "got three times ten to the four hits"},
+		{name: "scientific negative exponent", text: "-1.5E-3 m", exp: "negative one point five times ten to the negative three m"},
+		{name: "multiple lines", text: "1\n2nd\n", exp: "one\nsecond\n"},
+	}
+
+	for _, tc := range tests {
+		t.Run("normal: "+tc.name, func(t *testing.T) {
+			var act string
+			var err error
+			testFunc := func() {
+				act, err = RewriteNumbers(tc.text, tc.style)
+			}
+			require.NotPanics(t, testFunc, "RewriteNumbers(%q, %s)", tc.text, tc.style)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "RewriteNumbers(%q, %s) error", tc.text, tc.style)
+			} else {
+				assert.NoError(t, err, "RewriteNumbers(%q, %s) error", tc.text, tc.style)
+			}
+			assert.Equal(t, tc.exp, act, "RewriteNumbers(%q, %s) result", tc.text, tc.style)
+		})
+
+		t.Run("must: "+tc.name, func(t *testing.T) {
+			var act string
+			testFunc := func() {
+				act = MustRewriteNumbers(tc.text, tc.style)
+			}
+			if len(tc.expErr) > 0 {
+				require.PanicsWithError(t, tc.expErr, testFunc, "MustRewriteNumbers(%q, %s)", tc.text, tc.style)
+			} else {
+				require.NotPanics(t, testFunc, "MustRewriteNumbers(%q, %s)", tc.text, tc.style)
+			}
+			assert.Equal(t, tc.exp, act, "MustRewriteNumbers(%q, %s) result", tc.text, tc.style)
+		})
+	}
+}
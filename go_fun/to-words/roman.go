@@ -0,0 +1,117 @@
+package to_words
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MaxRoman is the largest number that can be written as a standard Roman numeral.
+const MaxRoman = 3999
+
+// romanValues are the values of the Roman numeral symbols (and subtractive pairs), largest first.
+var romanValues = []struct {
+	value  int
+	symbol string
+}{
+	{1000, "M"}, {900, "CM"}, {500, "D"}, {400, "CD"},
+	{100, "C"}, {90, "XC"}, {50, "L"}, {40, "XL"},
+	{10, "X"}, {9, "IX"}, {5, "V"}, {4, "IV"}, {1, "I"},
+}
+
+// IntToRoman converts the provided number into a Roman numeral.
+//
+// Examples:
+//   - 1 => "I"
+//   - 4 => "IV"
+//   - 9 => "IX"
+//   - 14 => "XIV"
+//   - 1984 => "MCMLXXXIV"
+//   - 3999 => "MMMCMXCIX"
+//
+// Returns an error if the number is less than 1 or more than 3999.
+// See also: MustIntToRoman, RomanToInt.
+func IntToRoman(num int) (string, error) {
+	if num < 1 || num > MaxRoman {
+		return "", fmt.Errorf("cannot convert %d to a Roman numeral: must be between 1 and %d", num, MaxRoman)
+	}
+	var sb strings.Builder
+	for _, rv := range romanValues {
+		for num >= rv.value {
+			sb.WriteString(rv.symbol)
+			num -= rv.value
+		}
+	}
+	return sb.String(), nil
+}
+
+// MustIntToRoman converts the provided number into a Roman numeral.
+//
+// Examples:
+//   - 1 => "I"
+//   - 4 => "IV"
+//   - 9 => "IX"
+//   - 14 => "XIV"
+//   - 1984 => "MCMLXXXIV"
+//   - 3999 => "MMMCMXCIX"
+//
+// Panics if the number is less than 1 or more than 3999.
+// See also: IntToRoman.
+func MustIntToRoman(num int) string {
+	rv, err := IntToRoman(num)
+	if err != nil {
+		panic(err)
+	}
+	return rv
+}
+
+// RomanToInt converts the provided Roman numeral (case insensitive) into a number.
+// Only standard (subtractive) forms are allowed, e.g. "IV" is okay, but "IIII" and "IIV" are not.
+//
+// Examples:
+//   - "I" => 1
+//   - "iv" => 4
+//   - "XIV" => 14
+//   - "MCMLXXXIV" => 1984
+//   - "MMMCMXCIX" => 3999
+//
+// Returns an error if the provided string is not a standard Roman numeral.
+// See also: MustRomanToInt, IntToRoman.
+func RomanToInt(str string) (int, error) {
+	upper := strings.ToUpper(strings.TrimSpace(str))
+	if len(upper) == 0 {
+		return 0, fmt.Errorf("invalid Roman numeral %q", str)
+	}
+	rv := 0
+	rest := upper
+	for _, rn := range romanValues {
+		for strings.HasPrefix(rest, rn.symbol) {
+			rv += rn.value
+			rest = rest[len(rn.symbol):]
+		}
+	}
+	// Converting it back is the easiest way to make sure it was in the standard form.
+	if len(rest) > 0 || rv > MaxRoman || MustIntToRoman(rv) != upper {
+		return 0, fmt.Errorf("invalid Roman numeral %q", str)
+	}
+	return rv, nil
+}
+
+// MustRomanToInt converts the provided Roman numeral (case insensitive) into a number.
+// Only standard (subtractive) forms are allowed, e.g. "IV" is okay, but "IIII" and "IIV" are not.
+//
+// Examples:
+//   - "I" => 1
+//   - "iv" => 4
+//   - "XIV" => 14
+//   - "MCMLXXXIV" => 1984
+//   - "MMMCMXCIX" => 3999
+//
+// Panics if the provided string is not a standard Roman numeral.
+// See also: RomanToInt.
+func MustRomanToInt(str string) int {
+	rv, err := RomanToInt(str)
+	if err != nil {
+		panic(err)
+	}
+	return rv
+}
@@ -0,0 +1,142 @@
+package to_words
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntToRoman(t *testing.T) {
+	tests := []struct {
+		num    int
+		exp    string
+		expErr string
+	}{
+		{num: -1, expErr: "cannot convert -1 to a Roman numeral: must be between 1 and 3999"},
+		{num: 0, expErr: "cannot convert 0 to a Roman numeral: must be between 1 and 3999"},
+		{num: 1, exp: "I"},
+		{num: 2, exp: "II"},
+		{num: 3, exp: "III"},
+		{num: 4, exp: "IV"},
+		{num: 5, exp: "V"},
+		{num: 6, exp: "VI"},
+		{num: 9, exp: "IX"},
+		{num: 10, exp: "X"},
+		{num: 14, exp: "XIV"},
+		{num: 40, exp: "XL"},
+		{num: 49, exp: "XLIX"},
+		{num: 90, exp: "XC"},
+		{num: 99, exp: "XCIX"},
+		{num: 400, exp: "CD"},
+		{num: 444, exp: "CDXLIV"},
+		{num: 900, exp: "CM"},
+		{num: 1984, exp: "MCMLXXXIV"},
+		{num: 2024, exp: "MMXXIV"},
+		{num: 3888, exp: "MMMDCCCLXXXVIII"},
+		{num: 3999, exp: "MMMCMXCIX"},
+		{num: 4000, expErr: "cannot convert 4000 to a Roman numeral: must be between 1 and 3999"},
+	}
+
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("normal: %d", tc.num), func(t *testing.T) {
+			var act string
+			var err error
+			testFunc := func() {
+				act, err = IntToRoman(tc.num)
+			}
+			require.NotPanics(t, testFunc, "IntToRoman(%d)", tc.num)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "IntToRoman(%d) error", tc.num)
+			} else {
+				assert.NoError(t, err, "IntToRoman(%d) error", tc.num)
+			}
+			assert.Equal(t, tc.exp, act, "IntToRoman(%d) result", tc.num)
+		})
+
+		t.Run(fmt.Sprintf("must: %d", tc.num), func(t *testing.T) {
+			var act string
+			testFunc := func() {
+				act = MustIntToRoman(tc.num)
+			}
+			if len(tc.expErr) > 0 {
+				require.PanicsWithError(t, tc.expErr, testFunc, "MustIntToRoman(%d)", tc.num)
+			} else {
+				require.NotPanics(t, testFunc, "MustIntToRoman(%d)", tc.num)
+			}
+			assert.Equal(t, tc.exp, act, "MustIntToRoman(%d) result", tc.num)
+		})
+	}
+}
+
+func TestRomanToInt(t *testing.T) {
+	tests := []struct {
+		str    string
+		exp    int
+		expErr string
+	}{
+		{str: "", expErr: "invalid Roman numeral \"\""},
+		{str: " ", expErr: "invalid Roman numeral \" \""},
+		{str: "I", exp: 1},
+		{str: "i", exp: 1},
+		{str: " V ", exp: 5},
+		{str: "iv", exp: 4},
+		{str: "IX", exp: 9},
+		{str: "XIV", exp: 14},
+		{str: "xLiX", exp: 49},
+		{str: "CDXLIV", exp: 444},
+		{str: "MCMLXXXIV", exp: 1984},
+		{str: "MMXXIV", exp: 2024},
+		{str: "MMMCMXCIX", exp: 3999},
+		{str: "IIII", expErr: "invalid Roman numeral \"IIII\""},
+		{str: "IIV", expErr: "invalid Roman numeral \"IIV\""},
+		{str: "VV", expErr: "invalid Roman numeral \"VV\""},
+		{str: "IC", expErr: "invalid Roman numeral \"IC\""},
+		{str: "XM", expErr: "invalid Roman numeral \"XM\""},
+		{str: "MMMM", expErr: "invalid Roman numeral \"MMMM\""},
+		{str: "ABC", expErr: "invalid Roman numeral \"ABC\""},
+		{str: "X I", expErr: "invalid Roman numeral \"X I\""},
+		{str: "14", expErr: "invalid Roman numeral \"14\""},
+	}
+
+	for _, tc := range tests {
+		t.Run("normal: "+tc.str, func(t *testing.T) {
+			var act int
+			var err error
+			testFunc := func() {
+				act, err = RomanToInt(tc.str)
+			}
+			require.NotPanics(t, testFunc, "RomanToInt(%q)", tc.str)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "RomanToInt(%q) error", tc.str)
+			} else {
+				assert.NoError(t, err, "RomanToInt(%q) error", tc.str)
+			}
+			assert.Equal(t, tc.exp, act, "RomanToInt(%q) result", tc.str)
+		})
+
+		t.Run("must: "+tc.str, func(t *testing.T) {
+			var act int
+			testFunc := func() {
+				act = MustRomanToInt(tc.str)
+			}
+			if len(tc.expErr) > 0 {
+				require.PanicsWithError(t, tc.expErr, testFunc, "MustRomanToInt(%q)", tc.str)
+			} else {
+				require.NotPanics(t, testFunc, "MustRomanToInt(%q)", tc.str)
+			}
+			assert.Equal(t, tc.exp, act, "MustRomanToInt(%q) result", tc.str)
+		})
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		for num := 1; num <= MaxRoman; num++ {
+			roman := MustIntToRoman(num)
+			act, err := RomanToInt(roman)
+			if assert.NoError(t, err, "RomanToInt(%q) error", roman) {
+				assert.Equal(t, num, act, "RomanToInt(%q) result", roman)
+			}
+		}
+	})
+}
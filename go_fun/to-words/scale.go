@@ -0,0 +1,321 @@
+package to_words
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Scale identifies the naming system to use for the quantifiers of large numbers.
+type Scale int
+
+const (
+	// ShortScale is the default scale where each new "-illion" is 1000 times the previous one,
+	// e.g. "million" = 10^6, "billion" = 10^9, "trillion" = 10^12.
+	ShortScale Scale = iota
+	// LongScale is the scale where each new "-illion" is 1,000,000 times the previous one, and the
+	// "-illiard" names are used in between, e.g. "million" = 10^6, "milliard" = 10^9, "billion" = 10^12.
+	LongScale
+)
+
+// String returns the name of this scale.
+func (s Scale) String() string {
+	switch s {
+	case ShortScale:
+		return "short"
+	case LongScale:
+		return "long"
+	}
+	return fmt.Sprintf("Scale(%d)", int(s))
+}
+
+// cwUnits are the Conway-Wechsler prefixes for the ones digit (when combined with tens or hundreds).
+var cwUnits = []string{"", "un", "duo", "tre", "quattuor", "quinqua", "se", "septe", "octo", "nove"}
+
+// cwTens are the Conway-Wechsler prefixes for the tens digit, and the markers that affect the ones prefix before it.
+var cwTens = [][2]string{
+	{"", ""}, {"deci", "n"}, {"viginti", "ms"}, {"triginta", "ns"}, {"quadraginta", "ns"},
+	{"quinquaginta", "ns"}, {"sexaginta", "n"}, {"septuaginta", "n"}, {"octoginta", "mx"}, {"nonaginta", ""},
+}
+
+// cwHundreds are the Conway-Wechsler prefixes for the hundreds digit, and the markers that affect the ones prefix before it.
+var cwHundreds = [][2]string{
+	{"", ""}, {"centi", "nx"}, {"ducenti", "n"}, {"trecenti", "ns"}, {"quadringenti", "ns"},
+	{"quingenti", "ns"}, {"sescenti", "n"}, {"septingenti", "n"}, {"octingenti", "mx"}, {"nongenti", ""},
+}
+
+// cwSmall are the Conway-Wechsler pieces for 0 through 9, e.g. 2 => "billi".
+var cwSmall = []string{"nilli", "milli", "billi", "trilli", "quadrilli", "quintilli", "sextilli", "septilli", "octilli", "nonilli"}
+
+// cwPiece gets the Conway-Wechsler piece for a number from 0 to 999, e.g. 23 => "tresvigintilli".
+func cwPiece(num int) string {
+	if num < 10 {
+		return cwSmall[num]
+	}
+	ones, tens, hundreds := num%10, num/10%10, num/100
+	marker := cwHundreds[hundreds][1]
+	if tens > 0 {
+		marker = cwTens[tens][1]
+	}
+	unit := cwUnits[ones]
+	switch {
+	case ones == 3 && strings.ContainsAny(marker, "sx"):
+		unit += "s"
+	case ones == 6 && strings.Contains(marker, "s"):
+		unit += "s"
+	case ones == 6 && strings.Contains(marker, "x"):
+		unit += "x"
+	case (ones == 7 || ones == 9) && strings.Contains(marker, "m"):
+		unit += "m"
+	case (ones == 7 || ones == 9) && strings.Contains(marker, "n"):
+		unit += "n"
+	}
+	rv := unit + cwTens[tens][0] + cwHundreds[hundreds][0]
+	// The prefixes all end in a vowel that gets replaced by the "illi".
+	return rv[:len(rv)-1] + "illi"
+}
+
+// ConwayWechsler gets the Conway-Wechsler name of the nth "-illion" (short scale 10^(3n+3)).
+//
+// Examples:
+//   - 1 => "million"
+//   - 2 => "billion"
+//   - 10 => "decillion"
+//   - 23 => "tresvigintillion"
+//   - 100 => "centillion"
+//   - 1000 => "millinillion"
+//
+// Returns an error if n is less than 1.
+// See also: MustConwayWechsler, ScaleQuantifier.
+func ConwayWechsler(n int) (string, error) {
+	if n < 1 {
+		return "", fmt.Errorf("cannot get Conway-Wechsler name for %d: must be at least 1", n)
+	}
+	var pieces []string
+	for ; n > 0; n /= 1000 {
+		pieces = append(pieces, cwPiece(n%1000))
+	}
+	var sb strings.Builder
+	for i := len(pieces) - 1; i >= 0; i-- {
+		sb.WriteString(pieces[i])
+	}
+	sb.WriteString("on")
+	return sb.String(), nil
+}
+
+// MustConwayWechsler gets the Conway-Wechsler name of the nth "-illion" (short scale 10^(3n+3)).
+//
+// Examples:
+//   - 1 => "million"
+//   - 2 => "billion"
+//   - 10 => "decillion"
+//   - 23 => "tresvigintillion"
+//   - 100 => "centillion"
+//   - 1000 => "millinillion"
+//
+// Panics if n is less than 1.
+// See also: ConwayWechsler.
+func MustConwayWechsler(n int) string {
+	rv, err := ConwayWechsler(n)
+	if err != nil {
+		panic(err)
+	}
+	return rv
+}
+
+// cwPieceValues are the numbers for each Conway-Wechsler piece, e.g. "tresvigintilli" => 23.
+var cwPieceValues = func() map[string]int {
+	rv := make(map[string]int, 1000)
+	for i := range 1000 {
+		rv[cwPiece(i)] = i
+	}
+	return rv
+}()
+
+// conwayWechslerIndex is the inverse of ConwayWechsler, e.g. "billion" => 2, "millinillion" => 1000.
+// Returns false if the provided word isn't a Conway-Wechsler name.
+func conwayWechslerIndex(word string) (int, bool) {
+	word, ok := strings.CutSuffix(word, "on")
+	if !ok || !strings.HasSuffix(word, "lli") {
+		return 0, false
+	}
+	// None of the pieces have "lli" anywhere except at their end.
+	parts := strings.SplitAfter(word, "lli")
+	parts = parts[:len(parts)-1]
+	// More pieces than this would overflow an int.
+	if len(parts) > 6 {
+		return 0, false
+	}
+	rv := 0
+	for _, part := range parts {
+		val, known := cwPieceValues[part]
+		if !known {
+			return 0, false
+		}
+		rv = rv*1000 + val
+	}
+	// A leading "nilli" would just be a different way of writing a smaller name.
+	return rv, parts[0] != cwSmall[0]
+}
+
+// ScaleQuantifier gets the quantifier (e.g. "thousand") for the provided groupID in the provided scale.
+// A groupID of 0 is the right-most set of 3 digits in a number, so the quantifier is "".
+// A groupID of 1 returns "thousand". After that, the Conway-Wechsler names are used,
+// e.g. in the short scale, 2 returns "million", 3 "billion", etc., and in the long scale,
+// 2 returns "million", 3 "milliard", 4 "billion", 5 "billiard", etc.
+// Returns an error if the groupID is negative or the scale is unknown.
+// See also: MustScaleQuantifier, GetQuantifier.
+func ScaleQuantifier(groupID int, scale Scale) (string, error) {
+	if groupID < 0 {
+		return "", fmt.Errorf("cannot get quantifiers for group %d: must not be negative", groupID)
+	}
+	switch {
+	case groupID == 0:
+		return "", nil
+	case groupID == 1:
+		return "thousand", nil
+	case scale == ShortScale:
+		if groupID < len(Quantifiers) {
+			return Quantifiers[len(Quantifiers)-1-groupID], nil
+		}
+		return ConwayWechsler(groupID - 1)
+	case scale == LongScale:
+		rv, err := ConwayWechsler(groupID / 2)
+		if err != nil {
+			return "", err
+		}
+		if groupID%2 == 1 {
+			rv = strings.TrimSuffix(rv, "on") + "ard"
+		}
+		return rv, nil
+	}
+	return "", fmt.Errorf("cannot get quantifiers for group %d: unknown scale %s", groupID, scale)
+}
+
+// MustScaleQuantifier gets the quantifier (e.g. "thousand") for the provided groupID in the provided scale.
+// A groupID of 0 is the right-most set of 3 digits in a number, so the quantifier is "".
+// A groupID of 1 returns "thousand". After that, the Conway-Wechsler names are used,
+// e.g. in the short scale, 2 returns "million", 3 "billion", etc., and in the long scale,
+// 2 returns "million", 3 "milliard", 4 "billion", 5 "billiard", etc.
+// Panics if the groupID is negative or the scale is unknown.
+// See also: ScaleQuantifier.
+func MustScaleQuantifier(groupID int, scale Scale) string {
+	rv, err := ScaleQuantifier(groupID, scale)
+	if err != nil {
+		panic(err)
+	}
+	return rv
+}
+
+// ScaleQuantifiers gets the quantifiers to use for the provided number of groups in the provided scale.
+// They are in big-endian order, e.g. if groupCount = 4, this returns ["billion", "million", "thousand", ""]
+// for the short scale, and ["milliard", "million", "thousand", ""] for the long scale.
+// Returns an error if the groupCount is less than one or the scale is unknown.
+// See also: MustScaleQuantifiers, GetQuantifiers.
+func ScaleQuantifiers(groupCount int, scale Scale) ([]string, error) {
+	if groupCount <= 0 {
+		return nil, fmt.Errorf("cannot get quantifiers for %d groups: must be at least 1", groupCount)
+	}
+	rv := make([]string, groupCount)
+	for i := range rv {
+		var err error
+		rv[i], err = ScaleQuantifier(groupCount-1-i, scale)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return rv, nil
+}
+
+// MustScaleQuantifiers gets the quantifiers to use for the provided number of groups in the provided scale.
+// They are in big-endian order, e.g. if groupCount = 4, this returns ["billion", "million", "thousand", ""]
+// for the short scale, and ["milliard", "million", "thousand", ""] for the long scale.
+// Panics if the groupCount is less than one or the scale is unknown.
+// See also: ScaleQuantifiers.
+func MustScaleQuantifiers(groupCount int, scale Scale) []string {
+	rv, err := ScaleQuantifiers(groupCount, scale)
+	if err != nil {
+		panic(err)
+	}
+	return rv
+}
+
+// StringToWordsScale converts the provided number (in string form) into English words using the provided scale.
+// There's no limit on the number of digits.
+//
+// Examples:
+//   - "1000000000", ShortScale => "one billion"
+//   - "1000000000", LongScale => "one milliard"
+//   - "1000000000000", LongScale => "one billion"
+//   - "1" followed by 3003 zeros, ShortScale => "one millinillion"
+//
+// Returns an error if the provided string is not a number or the scale is unknown.
+// See also: MustStringToWordsScale, StringToWords.
+func StringToWordsScale(str string, scale Scale) (string, error) {
+	groups, err := StringToGroups(str)
+	if err != nil {
+		return "", err
+	}
+	rv, err := GroupsToWordsScale(groups, scale)
+	if err != nil {
+		return "", fmt.Errorf("could not convert %q to words: %w", str, err)
+	}
+	return rv, nil
+}
+
+// MustStringToWordsScale converts the provided number (in string form) into English words using the provided scale.
+// There's no limit on the number of digits.
+//
+// Examples:
+//   - "1000000000", ShortScale => "one billion"
+//   - "1000000000", LongScale => "one milliard"
+//   - "1000000000000", LongScale => "one billion"
+//   - "1" followed by 3003 zeros, ShortScale => "one millinillion"
+//
+// Panics if the provided string is not a number or the scale is unknown.
+// See also: StringToWordsScale.
+func MustStringToWordsScale(str string, scale Scale) string {
+	rv, err := StringToWordsScale(str, scale)
+	if err != nil {
+		panic(err)
+	}
+	return rv
+}
+
+// GroupsToWordsScale converts a slice of groups to words as if it were one whole number, using the provided scale.
+// e.g. [1, 2, 3, 4] => "one billion two million three thousand four" (ShortScale)
+// or "one milliard two million three thousand four" (LongScale).
+// If the number is to be negative, only groups[0] should be negative.
+// Returns an error if there are zero groups or the scale is unknown.
+// See also: MustGroupsToWordsScale, GroupsToWords.
+func GroupsToWordsScale(groups []int16, scale Scale) (string, error) {
+	quants, err := ScaleQuantifiers(len(groups), scale)
+	if err != nil {
+		return "", err
+	}
+	groupWords := make([]string, 0, len(groups))
+	for i, group := range groups {
+		if group == 0 && i != 0 {
+			continue
+		}
+		gw := IntToWords(int(group))
+		if len(quants[i]) > 0 {
+			gw += " " + quants[i]
+		}
+		groupWords = append(groupWords, gw)
+	}
+	return strings.Join(groupWords, " "), nil
+}
+
+// MustGroupsToWordsScale converts a slice of groups to words as if it were one whole number, using the provided scale.
+// e.g. [1, 2, 3, 4] => "one billion two million three thousand four" (ShortScale)
+// or "one milliard two million three thousand four" (LongScale).
+// If the number is to be negative, only groups[0] should be negative.
+// Panics if there are zero groups or the scale is unknown.
+// See also: GroupsToWordsScale.
+func MustGroupsToWordsScale(groups []int16, scale Scale) string {
+	rv, err := GroupsToWordsScale(groups, scale)
+	if err != nil {
+		panic(err)
+	}
+	return rv
+}
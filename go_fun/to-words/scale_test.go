@@ -0,0 +1,339 @@
+package to_words
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScale_String(t *testing.T) {
+	assert.Equal(t, "short", ShortScale.String(), "ShortScale.String()")
+	assert.Equal(t, "long", LongScale.String(), "LongScale.String()")
+	assert.Equal(t, "Scale(3)", Scale(3).String(), "Scale(3).String()")
+}
+
+func TestConwayWechsler(t *testing.T) {
+	tests := []struct {
+		n      int
+		exp    string
+		expErr string
+	}{
+		{n: -1, expErr: "cannot get Conway-Wechsler name for -1: must be at least 1"},
+		{n: 0, expErr: "cannot get Conway-Wechsler name for 0: must be at least 1"},
+		{n: 1, exp: "million"},
+		{n: 2, exp: "billion"},
+		{n: 3, exp: "trillion"},
+		{n: 4, exp: "quadrillion"},
+		{n: 5, exp: "quintillion"},
+		{n: 6, exp: "sextillion"},
+		{n: 7, exp: "septillion"},
+		{n: 8, exp: "octillion"},
+		{n: 9, exp: "nonillion"},
+		{n: 10, exp: "decillion"},
+		{n: 11, exp: "undecillion"},
+		{n: 12, exp: "duodecillion"},
+		{n: 13, exp: "tredecillion"},
+		{n: 14, exp: "quattuordecillion"},
+		{n: 15, exp: "quinquadecillion"},
+		{n: 16, exp: "sedecillion"},
+		{n: 17, exp: "septendecillion"},
+		{n: 18, exp: "octodecillion"},
+		{n: 19, exp: "novendecillion"},
+		{n: 20, exp: "vigintillion"},
+		{n: 23, exp: "tresvigintillion"},
+		{n: 26, exp: "sesvigintillion"},
+		{n: 27, exp: "septemvigintillion"},
+		{n: 30, exp: "trigintillion"},
+		{n: 86, exp: "sexoctogintillion"},
+		{n: 99, exp: "novenonagintillion"},
+		{n: 100, exp: "centillion"},
+		{n: 103, exp: "trescentillion"},
+		{n: 106, exp: "sexcentillion"},
+		{n: 107, exp: "septencentillion"},
+		{n: 303, exp: "trestrecentillion"},
+		{n: 999, exp: "novenonagintanongentillion"},
+		{n: 1000, exp: "millinillion"},
+		{n: 1001, exp: "millimillion"},
+		{n: 1023, exp: "millitresvigintillion"},
+		{n: 2000, exp: "billinillion"},
+		{n: 1_000_000, exp: "millinillinillion"},
+	}
+
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("normal: %d", tc.n), func(t *testing.T) {
+			var act string
+			var err error
+			testFunc := func() {
+				act, err = ConwayWechsler(tc.n)
+			}
+			require.NotPanics(t, testFunc, "ConwayWechsler(%d)", tc.n)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ConwayWechsler(%d) error", tc.n)
+			} else {
+				assert.NoError(t, err, "ConwayWechsler(%d) error", tc.n)
+			}
+			assert.Equal(t, tc.exp, act, "ConwayWechsler(%d) result", tc.n)
+		})
+
+		t.Run(fmt.Sprintf("must: %d", tc.n), func(t *testing.T) {
+			var act string
+			testFunc := func() {
+				act = MustConwayWechsler(tc.n)
+			}
+			if len(tc.expErr) > 0 {
+				require.PanicsWithError(t, tc.expErr, testFunc, "MustConwayWechsler(%d)", tc.n)
+			} else {
+				require.NotPanics(t, testFunc, "MustConwayWechsler(%d)", tc.n)
+			}
+			assert.Equal(t, tc.exp, act, "MustConwayWechsler(%d) result", tc.n)
+		})
+	}
+}
+
+func TestConwayWechslerIndex(t *testing.T) {
+	for _, n := range []int{1, 9, 10, 15, 23, 99, 100, 106, 999, 1000, 1001, 12_345, 1_000_000, 987_654_321} {
+		name := MustConwayWechsler(n)
+		t.Run(name, func(t *testing.T) {
+			act, ok := conwayWechslerIndex(name)
+			assert.True(t, ok, "conwayWechslerIndex(%q) ok", name)
+			assert.Equal(t, n, act, "conwayWechslerIndex(%q) result", name)
+		})
+	}
+
+	for _, word := range []string{"", "on", "thousand", "illion", "nillion", "nillimillion", "zillion", "bogolion"} {
+		t.Run(word, func(t *testing.T) {
+			_, ok := conwayWechslerIndex(word)
+			assert.False(t, ok, "conwayWechslerIndex(%q) ok", word)
+		})
+	}
+}
+
+func TestScaleQuantifier(t *testing.T) {
+	tests := []struct {
+		groupID int
+		scale   Scale
+		exp     string
+		expErr  string
+	}{
+		{groupID: -1, scale: ShortScale, expErr: "cannot get quantifiers for group -1: must not be negative"},
+		{groupID: -1, scale: LongScale, expErr: "cannot get quantifiers for group -1: must not be negative"},
+		{groupID: 2, scale: Scale(7), expErr: "cannot get quantifiers for group 2: unknown scale Scale(7)"},
+		{groupID: 0, scale: ShortScale, exp: ""},
+		{groupID: 1, scale: ShortScale, exp: "thousand"},
+		{groupID: 2, scale: ShortScale, exp: "million"},
+		{groupID: 3, scale: ShortScale, exp: "billion"},
+		{groupID: 4, scale: ShortScale, exp: "trillion"},
+		{groupID: 15, scale: ShortScale, exp: "quattuordecillion"},
+		{groupID: 16, scale: ShortScale, exp: "quinquadecillion"},
+		{groupID: 1001, scale: ShortScale, exp: "millinillion"},
+		{groupID: 0, scale: LongScale, exp: ""},
+		{groupID: 1, scale: LongScale, exp: "thousand"},
+		{groupID: 2, scale: LongScale, exp: "million"},
+		{groupID: 3, scale: LongScale, exp: "milliard"},
+		{groupID: 4, scale: LongScale, exp: "billion"},
+		{groupID: 5, scale: LongScale, exp: "billiard"},
+		{groupID: 6, scale: LongScale, exp: "trillion"},
+		{groupID: 7, scale: LongScale, exp: "trilliard"},
+		{groupID: 20, scale: LongScale, exp: "decillion"},
+		{groupID: 21, scale: LongScale, exp: "decilliard"},
+		{groupID: 2000, scale: LongScale, exp: "millinillion"},
+	}
+
+	for _, tc := range tests {
+		name := fmt.Sprintf("%d %s", tc.groupID, tc.scale)
+		t.Run("normal: "+name, func(t *testing.T) {
+			var act string
+			var err error
+			testFunc := func() {
+				act, err = ScaleQuantifier(tc.groupID, tc.scale)
+			}
+			require.NotPanics(t, testFunc, "ScaleQuantifier(%d, %s)", tc.groupID, tc.scale)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ScaleQuantifier(%d, %s) error", tc.groupID, tc.scale)
+			} else {
+				assert.NoError(t, err, "ScaleQuantifier(%d, %s) error", tc.groupID, tc.scale)
+			}
+			assert.Equal(t, tc.exp, act, "ScaleQuantifier(%d, %s) result", tc.groupID, tc.scale)
+		})
+
+		t.Run("must: "+name, func(t *testing.T) {
+			var act string
+			testFunc := func() {
+				act = MustScaleQuantifier(tc.groupID, tc.scale)
+			}
+			if len(tc.expErr) > 0 {
+				require.PanicsWithError(t, tc.expErr, testFunc, "MustScaleQuantifier(%d, %s)", tc.groupID, tc.scale)
+			} else {
+				require.NotPanics(t, testFunc, "MustScaleQuantifier(%d, %s)", tc.groupID, tc.scale)
+			}
+			assert.Equal(t, tc.exp, act, "MustScaleQuantifier(%d, %s) result", tc.groupID, tc.scale)
+		})
+	}
+}
+
+func TestScaleQuantifiers(t *testing.T) {
+	tests := []struct {
+		groupCount int
+		scale      Scale
+		exp        []string
+		expErr     string
+	}{
+		{groupCount: 0, scale: ShortScale, expErr: "cannot get quantifiers for 0 groups: must be at least 1"},
+		{groupCount: 3, scale: Scale(-1), expErr: "cannot get quantifiers for group 2: unknown scale Scale(-1)"},
+		{groupCount: 1, scale: LongScale, exp: []string{""}},
+		{groupCount: 4, scale: ShortScale, exp: []string{"billion", "million", "thousand", ""}},
+		{groupCount: 4, scale: LongScale, exp: []string{"milliard", "million", "thousand", ""}},
+		{groupCount: 6, scale: LongScale, exp: []string{"billiard", "billion", "milliard", "million", "thousand", ""}},
+	}
+
+	for _, tc := range tests {
+		name := fmt.Sprintf("%d %s", tc.groupCount, tc.scale)
+		t.Run("normal: "+name, func(t *testing.T) {
+			var act []string
+			var err error
+			testFunc := func() {
+				act, err = ScaleQuantifiers(tc.groupCount, tc.scale)
+			}
+			require.NotPanics(t, testFunc, "ScaleQuantifiers(%d, %s)", tc.groupCount, tc.scale)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ScaleQuantifiers(%d, %s) error", tc.groupCount, tc.scale)
+			} else {
+				assert.NoError(t, err, "ScaleQuantifiers(%d, %s) error", tc.groupCount, tc.scale)
+			}
+			assert.Equal(t, tc.exp, act, "ScaleQuantifiers(%d, %s) result", tc.groupCount, tc.scale)
+		})
+
+		t.Run("must: "+name, func(t *testing.T) {
+			var act []string
+			testFunc := func() {
+				act = MustScaleQuantifiers(tc.groupCount, tc.scale)
+			}
+			if len(tc.expErr) > 0 {
+				require.PanicsWithError(t, tc.expErr, testFunc, "MustScaleQuantifiers(%d, %s)", tc.groupCount, tc.scale)
+			} else {
+				require.NotPanics(t, testFunc, "MustScaleQuantifiers(%d, %s)", tc.groupCount, tc.scale)
+			}
+			assert.Equal(t, tc.exp, act, "MustScaleQuantifiers(%d, %s) result", tc.groupCount, tc.scale)
+		})
+	}
+}
+
+func TestStringToWordsScale(t *testing.T) {
+	tests := []struct {
+		name   string
+		str    string
+		scale  Scale
+		exp    string
+		expErr string
+	}{
+		{
+			name:   "not a number",
+			str:    "abc",
+			scale:  LongScale,
+			expErr: "cannot split \"abc\" into groups: not a number",
+		},
+		{
+			name:   "unknown scale",
+			str:    "1000000",
+			scale:  Scale(2),
+			expErr: "could not convert \"1000000\" to words: cannot get quantifiers for group 2: unknown scale Scale(2)",
+		},
+		{name: "short small", str: "123", scale: ShortScale, exp: "one hundred twenty-three"},
+		{name: "long small", str: "-123", scale: LongScale, exp: "negative one hundred twenty-three"},
+		{name: "short billion", str: "1000000000", scale: ShortScale, exp: "one billion"},
+		{name: "long milliard", str: "1000000000", scale: LongScale, exp: "one milliard"},
+		{name: "long billion", str: "1000000000000", scale: LongScale, exp: "one billion"},
+		{
+			name:  "long mixed",
+			str:   "2003004005006007",
+			scale: LongScale,
+			exp:   "two billiard three billion four milliard five million six thousand seven",
+		},
+		{name: "short millinillion", str: "1" + strings.Repeat("0", 3003), scale: ShortScale, exp: "one millinillion"},
+		{name: "long millinillion", str: "1" + strings.Repeat("0", 6000), scale: LongScale, exp: "one millinillion"},
+		{
+			name:  "short centillion and one",
+			str:   "1" + strings.Repeat("0", 302) + "1",
+			scale: ShortScale,
+			exp:   "one centillion one",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run("normal: "+tc.name, func(t *testing.T) {
+			var act string
+			var err error
+			testFunc := func() {
+				act, err = StringToWordsScale(tc.str, tc.scale)
+			}
+			require.NotPanics(t, testFunc, "StringToWordsScale(%q, %s)", tc.str, tc.scale)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "StringToWordsScale(%q, %s) error", tc.str, tc.scale)
+			} else {
+				assert.NoError(t, err, "StringToWordsScale(%q, %s) error", tc.str, tc.scale)
+			}
+			assert.Equal(t, tc.exp, act, "StringToWordsScale(%q, %s) result", tc.str, tc.scale)
+		})
+
+		t.Run("must: "+tc.name, func(t *testing.T) {
+			var act string
+			testFunc := func() {
+				act = MustStringToWordsScale(tc.str, tc.scale)
+			}
+			if len(tc.expErr) > 0 {
+				require.PanicsWithError(t, tc.expErr, testFunc, "MustStringToWordsScale(%q, %s)", tc.str, tc.scale)
+			} else {
+				require.NotPanics(t, testFunc, "MustStringToWordsScale(%q, %s)", tc.str, tc.scale)
+			}
+			assert.Equal(t, tc.exp, act, "MustStringToWordsScale(%q, %s) result", tc.str, tc.scale)
+		})
+	}
+}
+
+func TestGroupsToWordsScale(t *testing.T) {
+	tests := []struct {
+		name   string
+		groups []int16
+		scale  Scale
+		exp    string
+		expErr string
+	}{
+		{name: "nil", groups: nil, scale: ShortScale, expErr: "cannot get quantifiers for 0 groups: must be at least 1"},
+		{name: "short", groups: []int16{1, 2, 3, 4}, scale: ShortScale, exp: "one billion two million three thousand four"},
+		{name: "long", groups: []int16{1, 2, 3, 4}, scale: LongScale, exp: "one milliard two million three thousand four"},
+		{name: "long negative", groups: []int16{-5, 0, 0, 0, 0}, scale: LongScale, exp: "negative five billion"},
+	}
+
+	for _, tc := range tests {
+		t.Run("normal: "+tc.name, func(t *testing.T) {
+			var act string
+			var err error
+			testFunc := func() {
+				act, err = GroupsToWordsScale(tc.groups, tc.scale)
+			}
+			require.NotPanics(t, testFunc, "GroupsToWordsScale(%d, %s)", tc.groups, tc.scale)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "GroupsToWordsScale(%d, %s) error", tc.groups, tc.scale)
+			} else {
+				assert.NoError(t, err, "GroupsToWordsScale(%d, %s) error", tc.groups, tc.scale)
+			}
+			assert.Equal(t, tc.exp, act, "GroupsToWordsScale(%d, %s) result", tc.groups, tc.scale)
+		})
+
+		t.Run("must: "+tc.name, func(t *testing.T) {
+			var act string
+			testFunc := func() {
+				act = MustGroupsToWordsScale(tc.groups, tc.scale)
+			}
+			if len(tc.expErr) > 0 {
+				require.PanicsWithError(t, tc.expErr, testFunc, "MustGroupsToWordsScale(%d, %s)", tc.groups, tc.scale)
+			} else {
+				require.NotPanics(t, testFunc, "MustGroupsToWordsScale(%d, %s)", tc.groups, tc.scale)
+			}
+			assert.Equal(t, tc.exp, act, "MustGroupsToWordsScale(%d, %s) result", tc.groups, tc.scale)
+		})
+	}
+}
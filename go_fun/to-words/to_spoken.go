@@ -3,6 +3,7 @@ package to_words
 import (
 	"fmt"
 	"regexp"
+	"strings"
 )
 
 // IntToSpoken converts the provided number into English words as we'd speak them.
@@ -120,6 +121,107 @@ func MustFloatToSpoken(str string) string {
 	return rv
 }
 
+// SpokenStyle identifies how the fractional portion of a number is read.
+type SpokenStyle int
+
+const (
+	// DigitStyle reads the fractional portion digit by digit, e.g. "1.25" => "one point two five".
+	DigitStyle SpokenStyle = iota
+	// PlaceValueStyle reads the fractional portion using place-value names, e.g. "1.25" => "one and twenty-five hundredths".
+	PlaceValueStyle
+	// FractionStyle reads the number as a reduced fraction or mixed number, e.g. "1.25" => "one and a quarter".
+	FractionStyle
+)
+
+// String returns the name of this style.
+func (s SpokenStyle) String() string {
+	switch s {
+	case DigitStyle:
+		return "digit"
+	case PlaceValueStyle:
+		return "place-value"
+	case FractionStyle:
+		return "fraction"
+	}
+	return fmt.Sprintf("SpokenStyle(%d)", int(s))
+}
+
+// validate returns an error if this isn't a known style.
+func (s SpokenStyle) validate() error {
+	if s < DigitStyle || s > FractionStyle {
+		return fmt.Errorf("unknown spoken style %s", s)
+	}
+	return nil
+}
+
+// FloatToSpokenStyle converts the provided floating point number into English words using the provided style.
+// The whole portion is fully expanded as words; the style dictates how the fractional portion is read.
+//
+// Examples:
+//   - "1.5", DigitStyle => "one point five"
+//   - "1.5", PlaceValueStyle => "one and five tenths"
+//   - "1.5", FractionStyle => "one and a half"
+//   - "0.75", PlaceValueStyle => "seventy-five hundredths"
+//   - "0.75", FractionStyle => "three-quarters"
+//   - "-2.001", PlaceValueStyle => "negative two and one thousandth"
+//
+// Returns an error if the provided string is not a number or the style is unknown.
+// See also: MustFloatToSpokenStyle, FloatToSpoken, FractionToWords.
+func FloatToSpokenStyle(str string, style SpokenStyle) (string, error) {
+	if style == DigitStyle {
+		return FloatToSpoken(str)
+	}
+	matches := floatRx.FindAllStringSubmatch(str, 1)
+	if len(matches) == 0 {
+		return "", fmt.Errorf("not a float %q", str)
+	}
+
+	// Either the 2nd or 3rd group has the whole number (if there is one).
+	whole := matches[0][2] + matches[0][3]
+	fract := matches[0][4]
+	isNeg := strings.HasPrefix(whole, "-")
+	whole = strings.TrimPrefix(whole, "-")
+
+	var rv string
+	var err error
+	switch style {
+	case PlaceValueStyle:
+		rv, err = placeValueWords(whole, fract)
+	case FractionStyle:
+		rv, err = reducedFractionWords(whole, fract)
+	default:
+		return "", fmt.Errorf("unknown spoken style %s", style)
+	}
+	if err != nil {
+		return "", fmt.Errorf("invalid float %q: %w", str, err)
+	}
+	if isNeg && trimZeros(whole+fract) != "0" {
+		return "negative " + rv, nil
+	}
+	return rv, nil
+}
+
+// MustFloatToSpokenStyle converts the provided floating point number into English words using the provided style.
+// The whole portion is fully expanded as words; the style dictates how the fractional portion is read.
+//
+// Examples:
+//   - "1.5", DigitStyle => "one point five"
+//   - "1.5", PlaceValueStyle => "one and five tenths"
+//   - "1.5", FractionStyle => "one and a half"
+//   - "0.75", PlaceValueStyle => "seventy-five hundredths"
+//   - "0.75", FractionStyle => "three-quarters"
+//   - "-2.001", PlaceValueStyle => "negative two and one thousandth"
+//
+// Panics if the provided string is not a number or the style is unknown.
+// See also: FloatToSpokenStyle.
+func MustFloatToSpokenStyle(str string, style SpokenStyle) string {
+	rv, err := FloatToSpokenStyle(str, style)
+	if err != nil {
+		panic(err)
+	}
+	return rv
+}
+
 // scientificRx matches a string that ends with a scientific notation as we'd speak them.
 // Matches: "<base>e<exponent>"  "<base>E<exponent>"  "<base>x10^<exponent>"
 // "<base>*10^<exponent>"  "<base>x10**<exponent>"  "<base>*10**<exponent>"
@@ -191,13 +293,14 @@ func MustScientificToSpoken(str string) string {
 }
 
 // StringToSpoken converts the provided number string into English words as we'd speak them.
-// This can be either a whole number, floating point number, or number in scientific notation.
+// This can be either a whole number, floating point number, fraction, or number in scientific notation.
 //
 // Examples:
 //   - "0" => "zero"
 //   - ".1" => "point one"
 //   - "-2.3" => "negative two point three"
 //   - "4e5" => "four times ten to the five"
+//   - "1 1/2" => "one and a half"
 //
 // Returns an error if the provided string is not a convertable number.
 // See also: MustStringToSpoken, IntToSpoken, UintToSpoken, FloatToSpoken, ScientificToSpoken.
@@ -205,18 +308,22 @@ func StringToSpoken(str string) (string, error) {
 	if scientificRx.MatchString(str) {
 		return ScientificToSpoken(str)
 	}
+	if strings.Contains(str, "/") {
+		return FractionToWords(str)
+	}
 
 	return FloatToSpoken(str)
 }
 
 // MustStringToSpoken converts the provided number string into English words as we'd speak them.
-// This can be either a whole number, floating point number, or number in scientific notation.
+// This can be either a whole number, floating point number, fraction, or number in scientific notation.
 //
 // Examples:
 //   - "0" => "zero"
 //   - ".one" => "point one"
 //   - "-2.3" => "negative two point three"
 //   - "4e5" => "four times ten to the five"
+//   - "1 1/2" => "one and a half"
 //
 // Panics if the provided string is not a convertable number.
 // See also: StringToSpoken, IntToSpoken, UintToSpoken, FloatToSpoken, ScientificToSpoken.
@@ -227,3 +334,48 @@ func MustStringToSpoken(str string) string {
 	}
 	return rv
 }
+
+// StringToSpokenStyle converts the provided number string into English words using the provided style.
+// This can be either a whole number, floating point number, fraction, or number in scientific notation.
+// The style only applies to floating point numbers.
+//
+// Examples:
+//   - "0", PlaceValueStyle => "zero"
+//   - ".1", PlaceValueStyle => "one tenth"
+//   - "-2.5", FractionStyle => "negative two and a half"
+//   - "4e5", FractionStyle => "four times ten to the five"
+//   - "1 1/2", DigitStyle => "one and a half"
+//
+// Returns an error if the provided string is not a convertable number or the style is unknown.
+// See also: MustStringToSpokenStyle, StringToSpoken, FloatToSpokenStyle.
+func StringToSpokenStyle(str string, style SpokenStyle) (string, error) {
+	if err := style.validate(); err != nil {
+		return "", err
+	}
+	if scientificRx.MatchString(str) || strings.Contains(str, "/") {
+		return StringToSpoken(str)
+	}
+
+	return FloatToSpokenStyle(str, style)
+}
+
+// MustStringToSpokenStyle converts the provided number string into English words using the provided style.
+// This can be either a whole number, floating point number, fraction, or number in scientific notation.
+// The style only applies to floating point numbers.
+//
+// Examples:
+//   - "0", PlaceValueStyle => "zero"
+//   - ".1", PlaceValueStyle => "one tenth"
+//   - "-2.5", FractionStyle => "negative two and a half"
+//   - "4e5", FractionStyle => "four times ten to the five"
+//   - "1 1/2", DigitStyle => "one and a half"
+//
+// Panics if the provided string is not a convertable number or the style is unknown.
+// See also: StringToSpokenStyle.
+func MustStringToSpokenStyle(str string, style SpokenStyle) string {
+	rv, err := StringToSpokenStyle(str, style)
+	if err != nil {
+		panic(err)
+	}
+	return rv
+}
@@ -928,20 +928,14 @@ func TestFloatToSpoken(t *testing.T) {
 			expErr: "not a float \"not a float\"",
 		},
 		{
-			name: "invalid whole number",
-			// There are 16 quantifiers. 16x3 = 48 digits max, so this one is 49.
-			str: "1234567890123456789012345678901234567890123456789",
-			expErr: "could not convert \"1234567890123456789012345678901234567890123456789\" to words: " +
-				"cannot get quantifiers for 17 groups: must be between 1 and 16",
+			name: "seventeen groups",
+			str:  "1000000000000000000000000000000000000000000000000",
+			exp:  "one quinquadecillion",
 		},
 		{
-			name: "invalid whole part",
-			// There are 16 quantifiers. 16x3 = 48 digits max, so this one is 49.
-			str: "1234567890123456789012345678901234567890123456789.123",
-			expErr: "invalid float \"1234567890123456789012345678901234567890123456789.123\": " +
-				"invalid whole part: " +
-				"could not convert \"1234567890123456789012345678901234567890123456789\" to words: " +
-				"cannot get quantifiers for 17 groups: must be between 1 and 16",
+			name: "seventeen groups with fraction",
+			str:  "1000000000000000000000000000000000000000000000000.123",
+			exp:  "one quinquadecillion point one two three",
 		},
 		{
 			name:   "just a decimal",
@@ -1011,6 +1005,84 @@ func TestFloatToSpoken(t *testing.T) {
 	}
 }
 
+func TestSpokenStyle_String(t *testing.T) {
+	assert.Equal(t, "digit", DigitStyle.String(), "DigitStyle.String()")
+	assert.Equal(t, "place-value", PlaceValueStyle.String(), "PlaceValueStyle.String()")
+	assert.Equal(t, "fraction", FractionStyle.String(), "FractionStyle.String()")
+	assert.Equal(t, "SpokenStyle(9)", SpokenStyle(9).String(), "SpokenStyle(9).String()")
+}
+
+func TestFloatToSpokenStyle(t *testing.T) {
+	tests := []struct {
+		str    string
+		style  SpokenStyle
+		exp    string
+		expErr string
+	}{
+		{str: "", style: PlaceValueStyle, expErr: "not a float \"\""},
+		{str: "1/2", style: FractionStyle, expErr: "not a float \"1/2\""},
+		{str: "1.5", style: SpokenStyle(9), expErr: "unknown spoken style SpokenStyle(9)"},
+		{str: "1.5", style: DigitStyle, exp: "one point five"},
+		{str: "1.5", style: PlaceValueStyle, exp: "one and five tenths"},
+		{str: "1.5", style: FractionStyle, exp: "one and a half"},
+		{str: "0", style: PlaceValueStyle, exp: "zero"},
+		{str: "0", style: FractionStyle, exp: "zero"},
+		{str: "-0.0", style: PlaceValueStyle, exp: "zero tenths"},
+		{str: "-0.0", style: FractionStyle, exp: "zero"},
+		{str: "12.", style: PlaceValueStyle, exp: "twelve"},
+		{str: "12.", style: FractionStyle, exp: "twelve"},
+		{str: "1.25", style: PlaceValueStyle, exp: "one and twenty-five hundredths"},
+		{str: "1.25", style: FractionStyle, exp: "one and a quarter"},
+		{str: "0.75", style: PlaceValueStyle, exp: "seventy-five hundredths"},
+		{str: "0.75", style: FractionStyle, exp: "three-quarters"},
+		{str: ".1", style: PlaceValueStyle, exp: "one tenth"},
+		{str: ".1", style: FractionStyle, exp: "one-tenth"},
+		{str: "-.05", style: PlaceValueStyle, exp: "negative five hundredths"},
+		{str: "-.05", style: FractionStyle, exp: "negative one-twentieth"},
+		{str: "-2.001", style: PlaceValueStyle, exp: "negative two and one thousandth"},
+		{str: "-2.001", style: FractionStyle, exp: "negative two and a thousandth"},
+		{str: "3.1250", style: PlaceValueStyle, exp: "three and one thousand two hundred fifty ten-thousandths"},
+		{str: "3.1250", style: FractionStyle, exp: "three and an eighth"},
+		{str: "0.00007", style: PlaceValueStyle, exp: "seven hundred-thousandths"},
+		{str: "0.000003", style: PlaceValueStyle, exp: "three millionths"},
+		{str: "0.3333", style: FractionStyle, exp: "three thousand three hundred thirty-three ten-thousandths"},
+		{str: "0.6", style: FractionStyle, exp: "three-fifths"},
+		{str: "4.002", style: FractionStyle, exp: "four and a five hundredth"},
+		{str: "7.0909", style: FractionStyle, exp: "seven and nine hundred nine ten-thousandths"},
+	}
+
+	for _, tc := range tests {
+		name := tc.style.String() + " " + tc.str
+		t.Run("normal: "+name, func(t *testing.T) {
+			var act string
+			var err error
+			testFunc := func() {
+				act, err = FloatToSpokenStyle(tc.str, tc.style)
+			}
+			require.NotPanics(t, testFunc, "FloatToSpokenStyle(%q, %s)", tc.str, tc.style)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "FloatToSpokenStyle(%q, %s) error", tc.str, tc.style)
+			} else {
+				assert.NoError(t, err, "FloatToSpokenStyle(%q, %s) error", tc.str, tc.style)
+			}
+			assert.Equal(t, tc.exp, act, "FloatToSpokenStyle(%q, %s) result", tc.str, tc.style)
+		})
+
+		t.Run("must: "+name, func(t *testing.T) {
+			var act string
+			testFunc := func() {
+				act = MustFloatToSpokenStyle(tc.str, tc.style)
+			}
+			if len(tc.expErr) > 0 {
+				require.PanicsWithError(t, tc.expErr, testFunc, "MustFloatToSpokenStyle(%q, %s)", tc.str, tc.style)
+			} else {
+				require.NotPanics(t, testFunc, "MustFloatToSpokenStyle(%q, %s)", tc.str, tc.style)
+			}
+			assert.Equal(t, tc.exp, act, "MustFloatToSpokenStyle(%q, %s) result", tc.str, tc.style)
+		})
+	}
+}
+
 func TestScientificRx(t *testing.T) {
 	tests := []struct {
 		str string
@@ -1279,6 +1351,16 @@ func TestStringToSpoken(t *testing.T) {
 			str:  "4e5",
 			exp:  "four times ten to the five",
 		},
+		{
+			name: "1 1/2",
+			str:  "1 1/2",
+			exp:  "one and a half",
+		},
+		{
+			name:   "1/0",
+			str:    "1/0",
+			expErr: "invalid fraction \"1/0\": denominator cannot be zero",
+		},
 	}
 
 	for _, tc := range tests {
@@ -1311,3 +1393,54 @@ func TestStringToSpoken(t *testing.T) {
 		})
 	}
 }
+
+func TestStringToSpokenStyle(t *testing.T) {
+	tests := []struct {
+		str    string
+		style  SpokenStyle
+		exp    string
+		expErr string
+	}{
+		{str: "1", style: SpokenStyle(4), expErr: "unknown spoken style SpokenStyle(4)"},
+		{str: "4e5", style: SpokenStyle(4), expErr: "unknown spoken style SpokenStyle(4)"},
+		{str: "nope", style: FractionStyle, expErr: "not a float \"nope\""},
+		{str: "0", style: PlaceValueStyle, exp: "zero"},
+		{str: ".1", style: DigitStyle, exp: "point one"},
+		{str: ".1", style: PlaceValueStyle, exp: "one tenth"},
+		{str: "-2.5", style: FractionStyle, exp: "negative two and a half"},
+		{str: "4e5", style: FractionStyle, exp: "four times ten to the five"},
+		{str: "1 1/2", style: DigitStyle, exp: "one and a half"},
+		{str: "3/4", style: PlaceValueStyle, exp: "three-quarters"},
+	}
+
+	for _, tc := range tests {
+		name := tc.style.String() + " " + tc.str
+		t.Run("normal: "+name, func(t *testing.T) {
+			var act string
+			var err error
+			testFunc := func() {
+				act, err = StringToSpokenStyle(tc.str, tc.style)
+			}
+			require.NotPanics(t, testFunc, "StringToSpokenStyle(%q, %s)", tc.str, tc.style)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "StringToSpokenStyle(%q, %s) error", tc.str, tc.style)
+			} else {
+				assert.NoError(t, err, "StringToSpokenStyle(%q, %s) error", tc.str, tc.style)
+			}
+			assert.Equal(t, tc.exp, act, "StringToSpokenStyle(%q, %s) result", tc.str, tc.style)
+		})
+
+		t.Run("must: "+name, func(t *testing.T) {
+			var act string
+			testFunc := func() {
+				act = MustStringToSpokenStyle(tc.str, tc.style)
+			}
+			if len(tc.expErr) > 0 {
+				require.PanicsWithError(t, tc.expErr, testFunc, "MustStringToSpokenStyle(%q, %s)", tc.str, tc.style)
+			} else {
+				require.NotPanics(t, testFunc, "MustStringToSpokenStyle(%q, %s)", tc.str, tc.style)
+			}
+			assert.Equal(t, tc.exp, act, "MustStringToSpokenStyle(%q, %s) result", tc.str, tc.style)
+		})
+	}
+}
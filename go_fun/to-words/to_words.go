@@ -187,34 +187,19 @@ func MustStringToWords(str string) string {
 // e.g. [1, 2, 3] => "one million two thousand three".
 // If the number is to be negative, only groups[0] should be negative. Making any other
 // entries negative will cause the word "negative" to appear in weird places.
-// Maximum number of groups is 16 (for quattuordecillion).
-// Returns an error if there are zero groups or more groups than there are quantifiers.
-// See also: MustGroupsToWords.
+// There's no maximum number of groups; Conway-Wechsler names are used after quattuordecillion.
+// Returns an error if there are zero groups.
+// See also: MustGroupsToWords, GroupsToWordsScale.
 func GroupsToWords(groups []int16) (string, error) {
-	quants, err := GetQuantifiers(len(groups))
-	if err != nil {
-		return "", err
-	}
-	groupWords := make([]string, 0, len(groups))
-	for i, group := range groups {
-		if group == 0 && i != 0 {
-			continue
-		}
-		gw := IntToWords(int(group))
-		if len(quants[i]) > 0 {
-			gw += " " + quants[i]
-		}
-		groupWords = append(groupWords, gw)
-	}
-	return strings.Join(groupWords, " "), nil
+	return GroupsToWordsScale(groups, ShortScale)
 }
 
 // MustGroupsToWords converts a slice of groups to words as if it were one whole number.
 // e.g. [1, 2, 3] => "one million two thousand three".
 // If the number is to be negative, only groups[0] should be negative. Making any other
 // entries negative will cause the word "negative" to appear in weird places.
-// Maximum number of groups is 16 (for quattuordecillion).
-// Panics if there are zero groups or more groups than there are quantifiers.
+// There's no maximum number of groups; Conway-Wechsler names are used after quattuordecillion.
+// Panics if there are zero groups.
 // See also: GroupsToWords.
 func MustGroupsToWords(groups []int16) string {
 	rv, err := GroupsToWords(groups)
@@ -331,7 +316,8 @@ func MustStringToGroups(str string) []int16 {
 	return rv
 }
 
-// Quantifiers are the words we add to groups of three digits to differentiate them.
+// Quantifiers are the traditional words we add to groups of three digits to differentiate them.
+// Groups beyond these use Conway-Wechsler names, e.g. "quinquadecillion".
 var Quantifiers = []string{
 	"quattuordecillion",
 	"tredecillion",
@@ -351,20 +337,17 @@ var Quantifiers = []string{
 	"",
 }
 
-// GetQuantifiers gets the quantifiers to use for the provided number of groups.
+// GetQuantifiers gets the (short scale) quantifiers to use for the provided number of groups.
 // They are in big-endian order, e.g. if groupCount = 3, this returns ["million", "thousand", ""].
-// Returns an error if the groupCount is negative or more than the number of known quantifiers.
-// See also: MustGetQuantifiers.
+// Returns an error if the groupCount is less than one.
+// See also: MustGetQuantifiers, ScaleQuantifiers.
 func GetQuantifiers(groupCount int) ([]string, error) {
-	if groupCount <= 0 || groupCount > len(Quantifiers) {
-		return nil, fmt.Errorf("cannot get quantifiers for %d groups: must be between 1 and %d", groupCount, len(Quantifiers))
-	}
-	return Quantifiers[len(Quantifiers)-groupCount:], nil
+	return ScaleQuantifiers(groupCount, ShortScale)
 }
 
-// MustGetQuantifiers gets the quantifiers to use for the provided number of groups.
+// MustGetQuantifiers gets the (short scale) quantifiers to use for the provided number of groups.
 // They are in big-endian order, e.g. if groupCount = 3, this returns ["million", "thousand", ""].
-// Panics if the groupCount is negative or more than the number of known quantifiers.
+// Panics if the groupCount is less than one.
 // See also: GetQuantifiers.
 func MustGetQuantifiers(groupCount int) []string {
 	rv, err := GetQuantifiers(groupCount)
@@ -374,22 +357,19 @@ func MustGetQuantifiers(groupCount int) []string {
 	return rv
 }
 
-// GetQuantifier gets the quantifier (e,g, "thousand") for the provided groupID.
+// GetQuantifier gets the (short scale) quantifier (e,g, "thousand") for the provided groupID.
 // A groupID of 0 is the right-most set of 3 digits in a number, so the quantifier is "".
 // A groupID of 1 returns "thousand", 2 returns "million" etc.
-// Returns an error if the groupID is negative or more than the number of known quantifiers.
-// See also: MustGetQuantifier.
+// Returns an error if the groupID is negative.
+// See also: MustGetQuantifier, ScaleQuantifier.
 func GetQuantifier(groupID int) (string, error) {
-	if groupID < 0 || groupID >= len(Quantifiers) {
-		return "", fmt.Errorf("cannot get quantifiers for group %d: must be between 0 and %d", groupID, len(Quantifiers)-1)
-	}
-	return Quantifiers[len(Quantifiers)-1-groupID], nil
+	return ScaleQuantifier(groupID, ShortScale)
 }
 
-// MustGetQuantifier gets the quantifier (e.g. "thousand") for the provided groupID.
+// MustGetQuantifier gets the (short scale) quantifier (e.g. "thousand") for the provided groupID.
 // A groupID of 0 is the right-most set of 3 digits in a number, so the quantifier is "".
 // A groupID of 1 returns "thousand", 2 returns "million" etc.
-// Panics if the groupID is negative or more than the number of known quantifiers.
+// Panics if the groupID is negative.
 // See also: GetQuantifier.
 func MustGetQuantifier(groupID int) string {
 	rv, err := GetQuantifier(groupID)
@@ -1409,9 +1409,9 @@ func TestStringToWords(t *testing.T) {
 				"nine hundred ninety-nine billion nine hundred ninety-nine million " +
 				"nine hundred ninety-nine thousand nine hundred ninety-nine"},
 		{str: "1000000000000000000000000000000000000000000000000",
-			expErr: "could not convert \"1000000000000000000000000000000000000000000000000\" to words: cannot get quantifiers for 17 groups: must be between 1 and 16"},
+			exp: "one quinquadecillion"},
 		{str: "-1000000000000000000000000000000000000000000000000",
-			expErr: "could not convert \"-1000000000000000000000000000000000000000000000000\" to words: cannot get quantifiers for 17 groups: must be between 1 and 16"},
+			exp: "negative one quinquadecillion"},
 		{str: "12E45", expErr: "cannot split \"12E45\" into groups: not a number"},
 		{str: "", expErr: "cannot split \"\" into groups: not a number"},
 		{str: "--3", expErr: "cannot split \"--3\" into groups: not a number"},
@@ -1764,16 +1764,23 @@ func TestGroupsToWords(t *testing.T) {
 			expErr: true,
 		},
 		{
-			name:   "too many groups",
+			name:   "seventeen groups",
 			groups: []int16{123, 234, 345, 456, 567, 678, 789, 890, 901, 12, 135, 246, 357, 468, 579, 680, 791},
-			expErr: true,
+			exp: "one hundred twenty-three quinquadecillion two hundred thirty-four quattuordecillion " +
+				"three hundred forty-five tredecillion four hundred fifty-six duodecillion " +
+				"five hundred sixty-seven undecillion six hundred seventy-eight decillion " +
+				"seven hundred eighty-nine nonillion eight hundred ninety octillion " +
+				"nine hundred one septillion twelve sextillion one hundred thirty-five quintillion " +
+				"two hundred forty-six quadrillion three hundred fifty-seven trillion " +
+				"four hundred sixty-eight billion five hundred seventy-nine million " +
+				"six hundred eighty thousand seven hundred ninety-one",
 		},
 	}
 
 	for _, tc := range tests {
 		var expErr string
 		if tc.expErr {
-			expErr = fmt.Sprintf("cannot get quantifiers for %d groups: must be between 1 and 16", len(tc.groups))
+			expErr = fmt.Sprintf("cannot get quantifiers for %d groups: must be at least 1", len(tc.groups))
 		}
 
 		t.Run("normal: "+tc.name, func(t *testing.T) {
@@ -2049,13 +2056,16 @@ func TestGetQuantifiers(t *testing.T) {
 			exp: []string{"quattuordecillion", "tredecillion", "duodecillion", "undecillion", "decillion",
 				"nonillion", "octillion", "septillion", "sextillion", "quintillion", "quadrillion",
 				"trillion", "billion", "million", "thousand", ""}},
-		{name: "seventeen", groupCount: 17, expErr: true},
+		{name: "seventeen", groupCount: 17,
+			exp: []string{"quinquadecillion", "quattuordecillion", "tredecillion", "duodecillion", "undecillion",
+				"decillion", "nonillion", "octillion", "septillion", "sextillion", "quintillion", "quadrillion",
+				"trillion", "billion", "million", "thousand", ""}},
 	}
 
 	for _, tc := range tests {
 		var expErr string
 		if tc.expErr {
-			expErr = fmt.Sprintf("cannot get quantifiers for %d groups: must be between 1 and 16", tc.groupCount)
+			expErr = fmt.Sprintf("cannot get quantifiers for %d groups: must be at least 1", tc.groupCount)
 		}
 
 		t.Run("normal: "+tc.name, func(t *testing.T) {
@@ -2112,7 +2122,9 @@ func TestGetQuantifier(t *testing.T) {
 		{name: "thirteen", groupID: 13, exp: "duodecillion"},
 		{name: "fourteen", groupID: 14, exp: "tredecillion"},
 		{name: "fifteen", groupID: 15, exp: "quattuordecillion"},
-		{name: "sixteen", groupID: 16, expPanic: true},
+		{name: "sixteen", groupID: 16, exp: "quinquadecillion"},
+		{name: "seventeen", groupID: 17, exp: "sedecillion"},
+		{name: "one hundred one", groupID: 101, exp: "centillion"},
 	}
 
 	for _, tc := range tests {
@@ -2122,7 +2134,7 @@ func TestGetQuantifier(t *testing.T) {
 				act = MustGetQuantifier(tc.groupID)
 			}
 			if tc.expPanic {
-				expPanic := fmt.Sprintf("cannot get quantifiers for group %d: must be between 0 and 15", tc.groupID)
+				expPanic := fmt.Sprintf("cannot get quantifiers for group %d: must not be negative", tc.groupID)
 				require.PanicsWithError(t, expPanic, testFunc, "MustGetQuantifier(%d)", tc.groupID)
 			} else {
 				require.NotPanics(t, testFunc, "MustGetQuantifier(%d)", tc.groupID)
@@ -0,0 +1,94 @@
+package to_words
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// IntToYear converts the provided number into English words the way we'd read it as a year.
+// Four digit years are read as two pairs, except for those that would need an "oh" in the
+// first decade of a millennium, which are read like normal numbers.
+// Other numbers are read the same way as IntToWords.
+//
+// Examples:
+//   - 1066 => "ten sixty-six"
+//   - 1900 => "nineteen hundred"
+//   - 1905 => "nineteen oh five"
+//   - 1984 => "nineteen eighty-four"
+//   - 2000 => "two thousand"
+//   - 2005 => "two thousand five"
+//   - 2024 => "twenty twenty-four"
+//   - 476 => "four hundred seventy-six"
+//   - -44 => "negative forty-four"
+//
+// See also: StringToYear, IntToWords.
+func IntToYear(num int) string {
+	if num < 0 && num != math.MinInt {
+		return "negative " + IntToYear(-num)
+	}
+	if num < 1000 || num > 9999 {
+		return IntToWords(num)
+	}
+
+	century, rest := num/100, num%100
+	switch {
+	case num%1000 == 0, century%10 == 0 && rest < 10:
+		// E.g. 2000 => "two thousand", 2005 => "two thousand five".
+		return IntToWords(num)
+	case rest == 0:
+		return IntToWords(century) + " hundred"
+	case rest < 10:
+		return IntToWords(century) + " oh " + IntToWords(rest)
+	}
+	return IntToWords(century) + " " + IntToWords(rest)
+}
+
+// StringToYear converts the provided number (in string form) into English words the way we'd read it as a year.
+// Four digit years are read as two pairs, except for those that would need an "oh" in the
+// first decade of a millennium, which are read like normal numbers.
+// Other numbers are read the same way as StringToWords.
+//
+// Examples:
+//   - "1066" => "ten sixty-six"
+//   - "1900" => "nineteen hundred"
+//   - "1905" => "nineteen oh five"
+//   - "1984" => "nineteen eighty-four"
+//   - "2000" => "two thousand"
+//   - "2005" => "two thousand five"
+//   - "2024" => "twenty twenty-four"
+//
+// Returns an error if the provided string is not a whole number.
+// See also: MustStringToYear, IntToYear.
+func StringToYear(str string) (string, error) {
+	num, err := strconv.Atoi(strings.TrimSpace(str))
+	if err != nil {
+		// It might just be too big for an int, in which case it's not really a year, but it's still a number.
+		return StringToWords(str)
+	}
+	return IntToYear(num), nil
+}
+
+// MustStringToYear converts the provided number (in string form) into English words the way we'd read it as a year.
+// Four digit years are read as two pairs, except for those that would need an "oh" in the
+// first decade of a millennium, which are read like normal numbers.
+// Other numbers are read the same way as StringToWords.
+//
+// Examples:
+//   - "1066" => "ten sixty-six"
+//   - "1900" => "nineteen hundred"
+//   - "1905" => "nineteen oh five"
+//   - "1984" => "nineteen eighty-four"
+//   - "2000" => "two thousand"
+//   - "2005" => "two thousand five"
+//   - "2024" => "twenty twenty-four"
+//
+// Panics if the provided string is not a whole number.
+// See also: StringToYear.
+func MustStringToYear(str string) string {
+	rv, err := StringToYear(str)
+	if err != nil {
+		panic(err)
+	}
+	return rv
+}
@@ -0,0 +1,108 @@
+package to_words
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntToYear(t *testing.T) {
+	tests := []struct {
+		num int
+		exp string
+	}{
+		{num: 0, exp: "zero"},
+		{num: 7, exp: "seven"},
+		{num: 476, exp: "four hundred seventy-six"},
+		{num: 999, exp: "nine hundred ninety-nine"},
+		{num: 1000, exp: "one thousand"},
+		{num: 1001, exp: "one thousand one"},
+		{num: 1009, exp: "one thousand nine"},
+		{num: 1010, exp: "ten ten"},
+		{num: 1066, exp: "ten sixty-six"},
+		{num: 1100, exp: "eleven hundred"},
+		{num: 1492, exp: "fourteen ninety-two"},
+		{num: 1776, exp: "seventeen seventy-six"},
+		{num: 1900, exp: "nineteen hundred"},
+		{num: 1901, exp: "nineteen oh one"},
+		{num: 1905, exp: "nineteen oh five"},
+		{num: 1910, exp: "nineteen ten"},
+		{num: 1984, exp: "nineteen eighty-four"},
+		{num: 1999, exp: "nineteen ninety-nine"},
+		{num: 2000, exp: "two thousand"},
+		{num: 2001, exp: "two thousand one"},
+		{num: 2005, exp: "two thousand five"},
+		{num: 2010, exp: "twenty ten"},
+		{num: 2024, exp: "twenty twenty-four"},
+		{num: 2100, exp: "twenty-one hundred"},
+		{num: 2105, exp: "twenty-one oh five"},
+		{num: 9999, exp: "ninety-nine ninety-nine"},
+		{num: 10000, exp: "ten thousand"},
+		{num: 12345, exp: "twelve thousand three hundred forty-five"},
+		{num: -44, exp: "negative forty-four"},
+		{num: -1984, exp: "negative nineteen eighty-four"},
+	}
+
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("%d", tc.num), func(t *testing.T) {
+			var act string
+			testFunc := func() {
+				act = IntToYear(tc.num)
+			}
+			require.NotPanics(t, testFunc, "IntToYear(%d)", tc.num)
+			assert.Equal(t, tc.exp, act, "IntToYear(%d) result", tc.num)
+		})
+	}
+}
+
+func TestStringToYear(t *testing.T) {
+	tests := []struct {
+		str    string
+		exp    string
+		expErr string
+	}{
+		{str: "", expErr: "cannot split \"\" into groups: not a number"},
+		{str: "abc", expErr: "cannot split \"abc\" into groups: not a number"},
+		{str: "0", exp: "zero"},
+		{str: "476", exp: "four hundred seventy-six"},
+		{str: "1066", exp: "ten sixty-six"},
+		{str: " 1984 ", exp: "nineteen eighty-four"},
+		{str: "1905", exp: "nineteen oh five"},
+		{str: "2005", exp: "two thousand five"},
+		{str: "2024", exp: "twenty twenty-four"},
+		{str: "-1984", exp: "negative nineteen eighty-four"},
+		{str: "100000000000000000000", exp: "one hundred quintillion"},
+	}
+
+	for _, tc := range tests {
+		t.Run("normal: "+tc.str, func(t *testing.T) {
+			var act string
+			var err error
+			testFunc := func() {
+				act, err = StringToYear(tc.str)
+			}
+			require.NotPanics(t, testFunc, "StringToYear(%q)", tc.str)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "StringToYear(%q) error", tc.str)
+			} else {
+				assert.NoError(t, err, "StringToYear(%q) error", tc.str)
+			}
+			assert.Equal(t, tc.exp, act, "StringToYear(%q) result", tc.str)
+		})
+
+		t.Run("must: "+tc.str, func(t *testing.T) {
+			var act string
+			testFunc := func() {
+				act = MustStringToYear(tc.str)
+			}
+			if len(tc.expErr) > 0 {
+				require.PanicsWithError(t, tc.expErr, testFunc, "MustStringToYear(%q)", tc.str)
+			} else {
+				require.NotPanics(t, testFunc, "MustStringToYear(%q)", tc.str)
+			}
+			assert.Equal(t, tc.exp, act, "MustStringToYear(%q) result", tc.str)
+		})
+	}
+}